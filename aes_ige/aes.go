@@ -3,80 +3,159 @@ package ige
 import (
 	"bytes"
 	"crypto/aes"
+	"crypto/sha256"
 	"errors"
 	"math/big"
 
 	"github.com/xelaj/go-dry"
 )
 
-func MessageKey(msg []byte) []byte {
-	return dry.Sha1(string(msg))[4:20]
+const (
+	// https://core.telegram.org/mtproto/description#defining-aes-key-and-initialization-vector
+	// паддинг в MTProto 2.0 от 12 до 1024 байт, итоговая длина должна делиться на 16
+	minPaddingLen = 12
+	maxPaddingLen = 1024
+)
+
+// MessageKey вычисляет msg_key по схеме MTProto 2.0: средние 128 бит от
+// SHA256(substr(auth_key, 88+x, 32) + plaintext + padding). x = 0 для сообщений
+// от клиента к серверу и x = 8 для сообщений от сервера клиенту.
+func MessageKey(authKey, msgPadded []byte, decode bool) []byte {
+	x := xValue(decode)
+
+	h := sha256.New()
+	h.Write(authKey[88+x : 88+x+32])
+	h.Write(msgPadded)
+
+	return h.Sum(nil)[8:24]
 }
 
-func Encrypt(msg, key []byte) ([]byte, error) {
-	msgKey := MessageKey(msg)
-	aesKey, aesIV := generateAESIGE(msgKey, key, false)
+// Encrypt добивает сообщение случайными байтами и шифрует его ключом авторизации.
+// возвращает шифротекст и msg_key, по которому сервер расшифрует сообщение.
+func Encrypt(msg, authKey []byte) (encrypted, msgKey []byte, err error) {
+	padded := append(append([]byte{}, msg...), dry.RandomBytes(paddingLen(len(msg)))...)
 
-	y := make([]byte, len(msg)+((16-(len(msg)%16))&15)) // СУДЯ ПО ВСЕМУ вообще не уверен, но это видимо паддинг для добива блока, чтоб он делился на 256 бит
-	copy(y, msg)
-	return doAES256IGEencrypt(y, aesKey, aesIV)
+	msgKey = MessageKey(authKey, padded, false)
+	aesKey, aesIV := generateAESIGE(msgKey, authKey, false)
 
+	encrypted, err = doAES256IGEencrypt(padded, aesKey, aesIV)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return encrypted, msgKey, nil
 }
 
-// checkData это msgkey в понятиях мтпрото, нужно что бы проверить, успешно ли прошла расшифровка
-func Decrypt(msg, key, checkData []byte) ([]byte, error) {
-	aesKey, aesIV := generateAESIGE(checkData, key, true)
+// Decrypt расшифровывает сообщение от сервера. msgKey нужен что бы получить
+// aes ключи и проверить, успешно ли прошла расшифровка. возвращается сообщение
+// вместе с паддингом, отрезать его должен тот, кто знает длину сообщения.
+func Decrypt(msg, authKey, msgKey []byte) ([]byte, error) {
+	aesKey, aesIV := generateAESIGE(msgKey, authKey, true)
 	result, err := doAES256IGEdecrypt(msg, aesKey, aesIV)
 	if err != nil {
 		return nil, err
 	}
+
+	if !bytes.Equal(MessageKey(authKey, result, true), msgKey) {
+		return nil, errors.New("wrong message key, can't trust to sender")
+	}
+
 	return result, nil
 }
 
-// generateAESIGE ЭТО ЕБАНАЯ МАГИЧЕСКАЯ ФУНКЦИЯ ОНА НАХУЙ РАБОТАЕТ ПРОСТО БЛЯТЬ НЕ ТРОГАЙ ШАКАЛ ЕБАНЫЙ
-// TODO: порезать себе вены
-func generateAESIGE(msg_key, auth_key []byte, decode bool) ([]byte, []byte) {
-	var x int
+// EncryptV1 шифрует сообщение по схеме MTProto 1.0: msg_key это SHA1 от самого
+// сообщения, паддинг от 0 до 15 байт. нужно только для сообщения о привязке
+// временного ключа (auth.bindTempAuthKey), все остальное шифруется через Encrypt
+func EncryptV1(msg, authKey []byte) (encrypted, msgKey []byte, err error) {
+	msgKey = dry.Sha1Byte(msg)[4:20]
+	padding := (aes.BlockSize - len(msg)%aes.BlockSize) % aes.BlockSize
+	padded := append(append([]byte{}, msg...), dry.RandomBytes(padding)...)
+
+	aesKey, aesIV := generateAESIGEv1(msgKey, authKey, false)
+	encrypted, err = doAES256IGEencrypt(padded, aesKey, aesIV)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return encrypted, msgKey, nil
+}
+
+// EncryptIGE шифрует data в AES-256-IGE произвольным ключом. нужно для RSA_PAD
+// при обмене ключами, где ключ случайный, а iv нулевой
+func EncryptIGE(data, key, iv []byte) ([]byte, error) {
+	return doAES256IGEencrypt(data, key, iv)
+}
+
+// DecryptIGE обратная операция к EncryptIGE
+func DecryptIGE(data, key, iv []byte) ([]byte, error) {
+	return doAES256IGEdecrypt(data, key, iv)
+}
+
+// paddingLen выбирает длину паддинга: не меньше 12 байт, так, что бы итог делился
+// на 16, плюс несколько случайных блоков сверху, но не больше 1024 байт.
+func paddingLen(msgLen int) int {
+	padding := minPaddingLen + (aes.BlockSize-(msgLen+minPaddingLen)%aes.BlockSize)%aes.BlockSize
+	extraBlocks := int(dry.RandomBytes(1)[0] % 4)
+	if padding+extraBlocks*aes.BlockSize <= maxPaddingLen {
+		padding += extraBlocks * aes.BlockSize
+	}
+
+	return padding
+}
+
+func xValue(decode bool) int {
 	if decode {
-		x = 8
-	} else {
-		x = 0
+		return 8
 	}
-	aes_key := make([]byte, 0, 32)
-	aes_iv := make([]byte, 0, 32)
-	t_a := make([]byte, 0, 48)
-	t_b := make([]byte, 0, 48)
-	t_c := make([]byte, 0, 48)
-	t_d := make([]byte, 0, 48)
+	return 0
+}
 
-	t_a = append(t_a, msg_key...)
-	t_a = append(t_a, auth_key[x:x+32]...)
+// generateAESIGE вычисляет aes_key и aes_iv из msg_key и ключа авторизации
+// https://core.telegram.org/mtproto/description#defining-aes-key-and-initialization-vector
+func generateAESIGE(msgKey, authKey []byte, decode bool) (aesKey, aesIV []byte) {
+	x := xValue(decode)
 
-	t_b = append(t_b, auth_key[32+x:32+x+16]...)
-	t_b = append(t_b, msg_key...)
-	t_b = append(t_b, auth_key[48+x:48+x+16]...)
+	hashA := sha256.New() // SHA256(msg_key + substr(auth_key, x, 36))
+	hashA.Write(msgKey)
+	hashA.Write(authKey[x : x+36])
+	sha256A := hashA.Sum(nil)
 
-	t_c = append(t_c, auth_key[64+x:64+x+32]...)
-	t_c = append(t_c, msg_key...)
+	hashB := sha256.New() // SHA256(substr(auth_key, 40+x, 36) + msg_key)
+	hashB.Write(authKey[40+x : 40+x+36])
+	hashB.Write(msgKey)
+	sha256B := hashB.Sum(nil)
 
-	t_d = append(t_d, msg_key...)
-	t_d = append(t_d, auth_key[96+x:96+x+32]...)
+	aesKey = make([]byte, 0, 32) // substr(sha256_a, 0, 8) + substr(sha256_b, 8, 16) + substr(sha256_a, 24, 8)
+	aesKey = append(aesKey, sha256A[0:8]...)
+	aesKey = append(aesKey, sha256B[8:24]...)
+	aesKey = append(aesKey, sha256A[24:32]...)
 
-	sha1_a := dry.Sha1Byte(t_a)
-	sha1_b := dry.Sha1Byte(t_b)
-	sha1_c := dry.Sha1Byte(t_c)
-	sha1_d := dry.Sha1Byte(t_d)
+	aesIV = make([]byte, 0, 32) // substr(sha256_b, 0, 8) + substr(sha256_a, 8, 16) + substr(sha256_b, 24, 8)
+	aesIV = append(aesIV, sha256B[0:8]...)
+	aesIV = append(aesIV, sha256A[8:24]...)
+	aesIV = append(aesIV, sha256B[24:32]...)
+
+	return aesKey, aesIV
+}
 
-	aes_key = append(aes_key, sha1_a[0:8]...)
-	aes_key = append(aes_key, sha1_b[8:8+12]...)
-	aes_key = append(aes_key, sha1_c[4:4+12]...)
+// generateAESIGEv1 вычисляет aes_key и aes_iv по схеме MTProto 1.0
+// https://core.telegram.org/mtproto/description_v1#defining-aes-key-and-initialization-vector
+func generateAESIGEv1(msgKey, authKey []byte, decode bool) (aesKey, aesIV []byte) {
+	x := xValue(decode)
 
-	aes_iv = append(aes_iv, sha1_a[8:8+12]...)
-	aes_iv = append(aes_iv, sha1_b[0:8]...)
-	aes_iv = append(aes_iv, sha1_c[16:16+4]...)
-	aes_iv = append(aes_iv, sha1_d[0:8]...)
+	sha1A := dry.Sha1Byte(concat(msgKey, authKey[x:x+32]))                              // SHA1(msg_key + substr(auth_key, x, 32))
+	sha1B := dry.Sha1Byte(concat(authKey[32+x:32+x+16], msgKey, authKey[48+x:48+x+16])) // SHA1(substr(auth_key, 32+x, 16) + msg_key + substr(auth_key, 48+x, 16))
+	sha1C := dry.Sha1Byte(concat(authKey[64+x:64+x+32], msgKey))                        // SHA1(substr(auth_key, 64+x, 32) + msg_key)
+	sha1D := dry.Sha1Byte(concat(msgKey, authKey[96+x:96+x+32]))                        // SHA1(msg_key + substr(auth_key, 96+x, 32))
+
+	aesKey = concat(sha1A[0:8], sha1B[8:20], sha1C[4:16])
+	aesIV = concat(sha1A[8:20], sha1B[0:8], sha1C[16:20], sha1D[0:8])
+
+	return aesKey, aesIV
+}
 
-	return aes_key, aes_iv
+func concat(parts ...[]byte) []byte {
+	return bytes.Join(parts, nil)
 }
 
 func doAES256IGEencrypt(data, key, iv []byte) ([]byte, error) {
@@ -117,6 +117,66 @@ func TestDecryptMessageWithTempKeys(t *testing.T) {
 	}
 }
 
+func TestEncryptMessage(t *testing.T) {
+	authKey := dry.RandomBytes(256)
+
+	for _, msgLen := range []int{4, 16, 20, 32, 1000, 4096} {
+		msg := dry.RandomBytes(msgLen)
+
+		encrypted, msgKey, err := Encrypt(msg, authKey)
+		assert.NoError(t, err)
+		assert.Len(t, msgKey, 16)
+		assert.Zero(t, len(encrypted)%16)
+
+		paddingLen := len(encrypted) - len(msg)
+		assert.True(t, paddingLen >= 12 && paddingLen <= 1024, "padding length is %v", paddingLen)
+
+		// клиент шифрует с x = 0, поэтому и расшифровываем здесь так же, как это сделает сервер
+		aesKey, aesIV := generateAESIGE(msgKey, authKey, false)
+		decrypted, err := doAES256IGEdecrypt(encrypted, aesKey, aesIV)
+		assert.NoError(t, err)
+		assert.Equal(t, msg, decrypted[:msgLen])
+		assert.Equal(t, msgKey, MessageKey(authKey, decrypted, false))
+	}
+}
+
+func TestDecryptMessage(t *testing.T) {
+	authKey := dry.RandomBytes(256)
+	msg := dry.RandomBytes(64)
+
+	// сервер шифрует с x = 8
+	msgKey := MessageKey(authKey, msg, true)
+	aesKey, aesIV := generateAESIGE(msgKey, authKey, true)
+	encrypted, err := doAES256IGEencrypt(msg, aesKey, aesIV)
+	assert.NoError(t, err)
+
+	decrypted, err := Decrypt(encrypted, authKey, msgKey)
+	assert.NoError(t, err)
+	assert.Equal(t, msg, decrypted)
+
+	// msg_key от клиентской стороны не должен подходить
+	_, err = Decrypt(encrypted, authKey, MessageKey(authKey, msg, false))
+	assert.Error(t, err)
+}
+
+func TestEncryptV1(t *testing.T) {
+	authKey := dry.RandomBytes(256)
+	for _, msgLen := range []int{16, 40, 100} {
+		msg := dry.RandomBytes(msgLen)
+
+		encrypted, msgKey, err := EncryptV1(msg, authKey)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(encrypted)%16)
+		assert.True(t, len(encrypted)-msgLen < 16, "padding must be less than 16 bytes")
+		assert.Equal(t, dry.Sha1Byte(msg)[4:20], msgKey)
+
+		aesKey, aesIV := generateAESIGEv1(msgKey, authKey, false)
+		decrypted, err := doAES256IGEdecrypt(encrypted, aesKey, aesIV)
+		assert.NoError(t, err)
+		assert.Equal(t, msg, decrypted[:msgLen])
+	}
+}
+
 func Hexed(in string) []byte {
 	res, err := hex.DecodeString(in)
 	dry.PanicIfErr(err)
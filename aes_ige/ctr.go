@@ -0,0 +1,26 @@
+package ige
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"errors"
+)
+
+// NewCTRStream создает потоковый шифр AES-256-CTR. используется для обфускации
+// соединения (obfuscated2), шифрует и расшифровывает одинаково
+// https://core.telegram.org/mtproto/mtproto-transports#transport-obfuscation
+func NewCTRStream(key, iv []byte) (cipher.Stream, error) {
+	if len(key) != 32 {
+		return nil, errors.New("AES256CTR: key must be 32 bytes")
+	}
+	if len(iv) != aes.BlockSize {
+		return nil, errors.New("AES256CTR: iv must be 16 bytes")
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewCTR(block, iv), nil
+}
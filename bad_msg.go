@@ -0,0 +1,134 @@
+package mtproto
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/xelaj/mtproto/serialize"
+	"github.com/xelaj/mtproto/utils"
+)
+
+// https://core.telegram.org/mtproto/service_messages_about_messages#notice-of-ignored-error-message
+// коды ошибок bad_msg_notification
+const (
+	badMsgIDTooLow         = 16
+	badMsgIDTooHigh        = 17
+	badMsgIDInvalidBits    = 18
+	badMsgIDDuplicate      = 19
+	badMsgTooOld           = 20
+	badMsgSeqNoTooLow      = 32
+	badMsgSeqNoTooHigh     = 33
+	badMsgSeqNoNotEven     = 34
+	badMsgSeqNoNotOdd      = 35
+	badMsgServerSalt       = 48
+	badMsgInvalidContainer = 64
+)
+
+var badMsgDescriptions = map[int32]string{
+	badMsgIDTooLow:         "msg_id too low",
+	badMsgIDTooHigh:        "msg_id too high",
+	badMsgIDInvalidBits:    "incorrect two lower order msg_id bits",
+	badMsgIDDuplicate:      "container msg_id is the same as msg_id of a previously received message",
+	badMsgTooOld:           "message too old",
+	badMsgSeqNoTooLow:      "msg_seqno too low",
+	badMsgSeqNoTooHigh:     "msg_seqno too high",
+	badMsgSeqNoNotEven:     "an even msg_seqno expected, but odd received",
+	badMsgSeqNoNotOdd:      "odd msg_seqno expected, but even received",
+	badMsgServerSalt:       "incorrect server salt",
+	badMsgInvalidContainer: "invalid container",
+}
+
+// BadMsgError возвращается запросу, который сервер отверг через
+// bad_msg_notification, и который нет смысла отправлять заново
+type BadMsgError struct {
+	Code int32
+}
+
+func (e *BadMsgError) Error() string {
+	desc, ok := badMsgDescriptions[e.Code]
+	if !ok {
+		desc = "unknown error"
+	}
+
+	return "bad message: " + desc + " (code " + strconv.Itoa(int(e.Code)) + ")"
+}
+
+// processBadMsgNotification исправляет то, на что пожаловался сервер, и
+// отправляет отвергнутые сообщения заново. msgID это id самого уведомления,
+// по нему можно узнать время сервера
+func (m *MTProto) processBadMsgNotification(msgID int64, n *serialize.BadMsgNotification) error {
+	switch n.ErrorCode {
+	case badMsgIDTooLow, badMsgIDTooHigh:
+		// часы разъехались с серверными, дальше генерируем msg_id по времени сервера
+		m.seq.SetTimeOffset(time.Until(utils.MessageIdTime(msgID)))
+		m.resendMessage(n.BadMsgID)
+
+	case badMsgSeqNoTooLow, badMsgSeqNoTooHigh:
+		// seqno разошелся с сервером, проще всего начать новую сессию, в
+		// ней seqno считается с нуля. в новую сессию переезжают все запросы
+		m.resetSession()
+		m.replayPending()
+
+	case badMsgTooOld, badMsgServerSalt, badMsgInvalidContainer:
+		// новая соль приходит отдельно в bad_server_salt, тут достаточно
+		// отправить сообщение еще раз с новым msg_id
+		m.resendMessage(n.BadMsgID)
+
+	default:
+		err := &BadMsgError{Code: n.ErrorCode}
+		if !m.failMessage(n.BadMsgID, err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resendMessage заставляет запрос (или все запросы в контейнере) отправиться
+// заново: makeRequest получит ErrorSessionConfigsChanged и повторит запрос с
+// новым msg_id. служебные сообщения (ack и пр.) заново не отправляются
+func (m *MTProto) resendMessage(msgID int64) {
+	m.writeToPending(msgID, &serialize.ErrorSessionConfigsChanged{})
+}
+
+// failMessage отдает err запросу (или всем запросам в контейнере) вместо ответа.
+// возвращает false, если ни одного такого запроса не ждет ответа
+func (m *MTProto) failMessage(msgID int64, err error) bool {
+	return m.writeToPending(msgID, &errorResponse{err: err})
+}
+
+func (m *MTProto) writeToPending(msgID int64, resp serialize.TL) bool {
+	ids := m.sendQueue.containerItems(msgID)
+	if ids == nil {
+		ids = []int64{msgID}
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	found := false
+	for _, id := range ids {
+		ch, ok := m.responseChannels[id]
+		if !ok {
+			continue
+		}
+
+		select {
+		case ch <- resp:
+		default:
+		}
+		delete(m.responseChannels, id)
+		found = true
+	}
+
+	return found
+}
+
+// resetSession начинает новую сессию с тем же ключом авторизации
+func (m *MTProto) resetSession() {
+	m.mutex.Lock()
+	m.sessionId = utils.GenerateSessionID()
+	m.seq.ResetSeqNo()
+	m.resetAck()
+	m.mutex.Unlock()
+}
@@ -0,0 +1,62 @@
+package mtproto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/xelaj/mtproto/serialize"
+	"github.com/xelaj/mtproto/utils"
+)
+
+func TestProcessBadMsgNotification(t *testing.T) {
+	m := newTestMTProto()
+	m.idsToAck = make(map[int64]struct{})
+	pending := func(ids ...int64) []chan serialize.TL {
+		chans := make([]chan serialize.TL, len(ids))
+		for i, id := range ids {
+			chans[i] = make(chan serialize.TL, 1)
+			m.responseChannels[id] = chans[i]
+		}
+		return chans
+	}
+
+	// часы сервера спешат на час
+	resp := pending(1)
+	serverMsgID := utils.GenerateMessageId(time.Hour)
+	err := m.processBadMsgNotification(serverMsgID, &serialize.BadMsgNotification{BadMsgID: 1, ErrorCode: badMsgIDTooLow})
+	assert.NoError(t, err)
+	assert.InDelta(t, time.Hour, m.seq.TimeOffset(), float64(2*time.Second))
+	assert.IsType(t, &serialize.ErrorSessionConfigsChanged{}, <-resp[0])
+	assert.True(t, utils.MessageIdTime(m.newQueuedMessage(&serialize.MsgsAck{}, false).msgID).After(time.Now().Add(59*time.Minute)))
+
+	// битый контейнер: заново отправляется все, что в нем было
+	resp = pending(2, 3)
+	m.sendQueue.rememberContainer(10, []int64{2, 3})
+	err = m.processBadMsgNotification(serverMsgID, &serialize.BadMsgNotification{BadMsgID: 10, ErrorCode: badMsgInvalidContainer})
+	assert.NoError(t, err)
+	assert.IsType(t, &serialize.ErrorSessionConfigsChanged{}, <-resp[0])
+	assert.IsType(t, &serialize.ErrorSessionConfigsChanged{}, <-resp[1])
+
+	// seqno разъехался: новая сессия и все запросы заново
+	resp = pending(4, 5)
+	m.seq.Next(true)
+	sessionID := m.sessionId
+	err = m.processBadMsgNotification(serverMsgID, &serialize.BadMsgNotification{BadMsgID: 4, ErrorCode: badMsgSeqNoTooHigh})
+	assert.NoError(t, err)
+	assert.NotEqual(t, sessionID, m.sessionId)
+	assert.Equal(t, int32(0), m.seq.LastSeqNo())
+	assert.IsType(t, &serialize.ErrorSessionConfigsChanged{}, <-resp[0])
+	assert.IsType(t, &serialize.ErrorSessionConfigsChanged{}, <-resp[1])
+
+	// то, что исправить нельзя, возвращается ошибкой
+	resp = pending(6)
+	err = m.processBadMsgNotification(serverMsgID, &serialize.BadMsgNotification{BadMsgID: 6, ErrorCode: badMsgSeqNoNotOdd})
+	assert.NoError(t, err)
+	assert.Equal(t, &errorResponse{err: &BadMsgError{Code: badMsgSeqNoNotOdd}}, <-resp[0])
+
+	err = m.processBadMsgNotification(serverMsgID, &serialize.BadMsgNotification{BadMsgID: 7, ErrorCode: badMsgIDInvalidBits})
+	assert.Equal(t, &BadMsgError{Code: badMsgIDInvalidBits}, err)
+	assert.Empty(t, m.responseChannels)
+}
@@ -140,11 +140,7 @@ func GenerateInterfaces(file *jen.File, data *FileStructure) error {
 
 			// Ecncode() []byte
 			calls := make([]jen.Code, 0)
-			calls = append(calls,
-				jen.Id("err").Op(":=").Qual("github.com/go-playground/validator", "New").Call().Dot("Struct").Call(jen.Id("e")),
-				jen.Qual("github.com/xelaj/go-dry", "PanicIfErr").Call(jen.Id("err")),
-				jen.Line(),
-			)
+			// валидация делается в методе клиента, тут паниковать нельзя
 
 			if atLeastOneFieldOptional {
 				// string это fieldname
@@ -146,14 +146,8 @@ func GenerateMethods(file *jen.File, data *FileStructure) error {
 		file.Add(jen.Line())
 
 		// Ecncode() []byte
+		// параметры валидируются в методе клиента, тут паниковать нельзя
 		calls := make([]jen.Code, 0)
-		if len(method.Arguments) > 0 {
-			calls = append(calls,
-				jen.Id("err").Op(":=").Qual("github.com/go-playground/validator", "New").Call().Dot("Struct").Call(jen.Id("e")),
-				jen.Qual("github.com/xelaj/go-dry", "PanicIfErr").Call(jen.Id("err")),
-				jen.Line(),
-			)
-		}
 
 		if atLeastOneFieldOptional {
 			// string это fieldname
@@ -235,7 +229,8 @@ func GenerateMethods(file *jen.File, data *FileStructure) error {
 			parameters[arg] = arg
 		}
 
-		requestStruct := jen.Op("&").Id(typeName).Values(parameters)
+		calls = make([]jen.Code, 0)
+		requestStruct := jen.Code(jen.Op("&").Id(typeName).Values(parameters))
 		if argsAsSingleItem {
 			requestStruct = jen.Id("params")
 		}
@@ -260,21 +255,36 @@ func GenerateMethods(file *jen.File, data *FileStructure) error {
 			// firstErrorReturn = jen.Lit(0)
 		}
 
-		calls = make([]jen.Code, 0)
+		if len(method.Arguments) > 0 {
+			if !argsAsSingleItem {
+				calls = append(calls, jen.Id("params").Op(":=").Add(requestStruct))
+				requestStruct = jen.Id("params")
+			}
+			calls = append(calls,
+				jen.If(jen.Err().Op(":=").Qual("github.com/go-playground/validator", "New").Call().Dot("Struct").Call(jen.Id("params")), jen.Err().Op("!=").Nil()).Block(
+					jen.Return(firstErrorReturn, jen.Qual("github.com/pkg/errors", "Wrap").Call(jen.Err(), jen.Lit("validating "+typeName))),
+				),
+				jen.Line(),
+			)
+		}
+
 		calls = append(calls,
-			jen.List(jen.Id("data"), jen.Err()).Op(":=").Id("c.MakeRequest").Call(requestStruct),
+			jen.List(jen.Id("data"), jen.Err()).Op(":=").Id("c.MakeRequestContext").Call(jen.Id("ctx"), requestStruct),
 			jen.If(jen.Err().Op("!=").Nil()).Block(
 				jen.Return(firstErrorReturn, jen.Qual("github.com/pkg/errors", "Wrap").Call(jen.Err(), jen.Lit("sedning "+methodName))),
 			),
 			jen.Line(),
 			jen.List(jen.Id("resp"), jen.Id("ok")).Op(":=").Id("data").Assert(jen.Id(assertedType)),
 			jen.If(jen.Op("!").Id("ok")).Block(
-				jen.Panic(jen.Lit("got invalid response type: ").Op("+").Qual("reflect", "TypeOf").Call(jen.Id("data")).Dot("String").Call()),
+				jen.Return(firstErrorReturn, jen.Qual("github.com/pkg/errors", "New").Call(jen.Lit("got invalid response type: ").Op("+").Qual("reflect", "TypeOf").Call(jen.Id("data")).Dot("String").Call())),
 			),
 			jen.Line(),
 			jen.Return(jen.Id("resp"), jen.Nil()),
 		)
 
+		// первым аргументом всегда идет контекст, что бы запрос можно было отменить
+		funcParameters = append([]jen.Code{jen.Id("ctx").Qual("context", "Context")}, funcParameters...)
+
 		f = jen.Func().Params(jen.Id("c").Id("*Client")).Id(methodName).Params(funcParameters...).Params(jen.Id(assertedType), jen.Error()).Block(
 			calls...,
 		)
@@ -309,8 +319,8 @@ func (t *AuthSendCodeParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AuthSendCode(PhoneNumber string, ApiID int, ApiHash string, Settings *CodeSettings) (*AuthSentCode, error) {
-	data, err := c.MakeRequest(&AuthSendCodeParams{
+func (c *Client) AuthSendCode(ctx context.Context, PhoneNumber string, ApiID int, ApiHash string, Settings *CodeSettings) (*AuthSentCode, error) {
+	data, err := c.MakeRequestContext(ctx, &AuthSendCodeParams{
 		PhoneNumber: PhoneNumber,
 		ApiID:       ApiID,
 		ApiHash:     ApiHash,
@@ -29,14 +29,14 @@ func (t *ReqPQParams) DecodeFrom(d *serialize.Decoder) {
 
 func (m *MTProto) ReqPQ(nonce *serialize.Int128) (*serialize.ResPQ, error) {
 	println("sending ReqPQ")
-	data, err := m.makeRequest(&ReqPQParams{Nonce: nonce})
+	data, err := m.makeServiceRequest(&ReqPQParams{Nonce: nonce})
 	if err != nil {
 		return nil, errors.Wrap(err, "sending ReqPQ")
 	}
 
 	resp, ok := data.(*serialize.ResPQ)
 	if !ok {
-		panic(errors.New("got invalid response type: " + reflect.TypeOf(data).String()))
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -77,7 +77,7 @@ func (t *ReqDHParamsParams) DecodeFrom(d *serialize.Decoder) {
 }
 
 func (m *MTProto) ReqDHParams(nonce, serverNonce *serialize.Int128, p, q []byte, publicKeyFingerprint int64, encryptedData []byte) (serialize.ServerDHParams, error) {
-	data, err := m.makeRequest(&ReqDHParamsParams{
+	data, err := m.makeServiceRequest(&ReqDHParamsParams{
 		Nonce:                nonce,
 		ServerNonce:          serverNonce,
 		P:                    p,
@@ -91,7 +91,7 @@ func (m *MTProto) ReqDHParams(nonce, serverNonce *serialize.Int128, p, q []byte,
 
 	resp, ok := data.(serialize.ServerDHParams)
 	if !ok {
-		panic(errors.New("got invalid response type: " + reflect.TypeOf(data).String()))
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -123,7 +123,7 @@ func (t *SetClientDHParamsParams) DecodeFrom(d *serialize.Decoder) {
 }
 
 func (m *MTProto) SetClientDHParams(nonce, serverNonce *serialize.Int128, encryptedData []byte) (serialize.SetClientDHParamsAnswer, error) {
-	data, err := m.makeRequest(&SetClientDHParamsParams{
+	data, err := m.makeServiceRequest(&SetClientDHParamsParams{
 		Nonce:         nonce,
 		ServerNonce:   serverNonce,
 		EncryptedData: encryptedData,
@@ -134,14 +134,82 @@ func (m *MTProto) SetClientDHParams(nonce, serverNonce *serialize.Int128, encryp
 
 	resp, ok := data.(serialize.SetClientDHParamsAnswer)
 	if !ok {
-		panic(errors.New("got invalid response type: " + reflect.TypeOf(data).String()))
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
 }
 
-// rpc_drop_answer
-// get_future_salts
+type RpcDropAnswerParams struct {
+	ReqMsgID int64
+}
+
+func (_ *RpcDropAnswerParams) CRC() uint32 {
+	return 0x58e4a740
+}
+
+func (t *RpcDropAnswerParams) Encode() []byte {
+	buf := serialize.NewEncoder()
+	buf.PutCRC(t.CRC())
+	buf.PutLong(t.ReqMsgID)
+	return buf.Result()
+}
+
+func (t *RpcDropAnswerParams) DecodeFrom(d *serialize.Decoder) {
+	t.ReqMsgID = d.PopLong()
+}
+
+// DropAnswer просит сервер не присылать ответ на запрос reqMsgID
+func (m *MTProto) DropAnswer(reqMsgID int64) (serialize.RpcDropAnswer, error) {
+	data, err := m.makeRequest(&RpcDropAnswerParams{
+		ReqMsgID: reqMsgID,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "sending DropAnswer")
+	}
+
+	resp, ok := data.(serialize.RpcDropAnswer)
+	if !ok {
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
+	}
+
+	return resp, nil
+}
+
+type GetFutureSaltsParams struct {
+	Num int32
+}
+
+func (_ *GetFutureSaltsParams) CRC() uint32 {
+	return 0xb921bd04
+}
+
+func (t *GetFutureSaltsParams) Encode() []byte {
+	buf := serialize.NewEncoder()
+	buf.PutCRC(t.CRC())
+	buf.PutInt(t.Num)
+	return buf.Result()
+}
+
+func (t *GetFutureSaltsParams) DecodeFrom(d *serialize.Decoder) {
+	t.Num = d.PopInt()
+}
+
+func (m *MTProto) GetFutureSalts(num int32) (*serialize.FutureSalts, error) {
+	data, err := m.makeRequest(&GetFutureSaltsParams{
+		Num: num,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "sending GetFutureSalts")
+	}
+
+	resp, ok := data.(*serialize.FutureSalts)
+	if !ok {
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
+	}
+
+	return resp, nil
+}
 
 type PingParams struct {
 	PingID int64
@@ -172,15 +240,161 @@ func (m *MTProto) Ping(pingID int64) (*serialize.Pong, error) {
 
 	resp, ok := data.(*serialize.Pong)
 	if !ok {
-		panic(errors.New("got invalid response type: " + reflect.TypeOf(data).String()))
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
+	}
+
+	return resp, nil
+}
+
+type PingDelayDisconnectParams struct {
+	PingID          int64
+	DisconnectDelay int32
+}
+
+func (_ *PingDelayDisconnectParams) CRC() uint32 {
+	return 0xf3427b8c
+}
+
+func (t *PingDelayDisconnectParams) Encode() []byte {
+	buf := serialize.NewEncoder()
+	buf.PutCRC(t.CRC())
+	buf.PutLong(t.PingID)
+	buf.PutInt(t.DisconnectDelay)
+	return buf.Result()
+}
+
+func (t *PingDelayDisconnectParams) DecodeFrom(d *serialize.Decoder) {
+	t.PingID = d.PopLong()
+	t.DisconnectDelay = d.PopInt()
+}
+
+// PingDelayDisconnect то же самое, что Ping, но сервер закроет соединение, если
+// следующий пинг не придет через disconnectDelay секунд
+func (m *MTProto) PingDelayDisconnect(pingID int64, disconnectDelay int32) (*serialize.Pong, error) {
+	data, err := m.makeRequest(&PingDelayDisconnectParams{
+		PingID:          pingID,
+		DisconnectDelay: disconnectDelay,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "sending PingDelayDisconnect")
+	}
+
+	resp, ok := data.(*serialize.Pong)
+	if !ok {
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
 }
 
-// ping_delay_disconnect
-// destroy_session
-// http_wait
+type DestroySessionParams struct {
+	SessionID int64
+}
+
+func (_ *DestroySessionParams) CRC() uint32 {
+	return 0xe7512126
+}
+
+func (t *DestroySessionParams) Encode() []byte {
+	buf := serialize.NewEncoder()
+	buf.PutCRC(t.CRC())
+	buf.PutLong(t.SessionID)
+	return buf.Result()
+}
+
+func (t *DestroySessionParams) DecodeFrom(d *serialize.Decoder) {
+	t.SessionID = d.PopLong()
+}
+
+// DestroySession уничтожает на сервере другую сессию с тем же ключом
+// авторизации. ответ приходит без RpcResult, поэтому ждем его по session_id
+func (m *MTProto) DestroySession(sessionID int64) (serialize.DestroySessionRes, error) {
+	resp := make(chan serialize.TL, 1)
+	m.mutex.Lock()
+	m.destroySessionChannels[sessionID] = resp
+	m.mutex.Unlock()
+	defer func() {
+		m.mutex.Lock()
+		delete(m.destroySessionChannels, sessionID)
+		m.mutex.Unlock()
+	}()
+
+	_, err := m.makeRequest(&DestroySessionParams{
+		SessionID: sessionID,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "sending DestroySession")
+	}
+
+	var data serialize.TL
+	select {
+	case data = <-resp:
+	case <-m.closedChan():
+		return nil, ErrDisconnected
+	}
+
+	res, ok := data.(serialize.DestroySessionRes)
+	if !ok {
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
+	}
+
+	return res, nil
+}
+
+// BindTempAuthKeyParams это auth.bindTempAuthKey. формально метод API, но нужен
+// для PFS еще до того, как можно отправлять любые другие запросы
+type BindTempAuthKeyParams struct {
+	PermAuthKeyID    int64
+	Nonce            int64
+	ExpiresAt        int32
+	EncryptedMessage []byte
+}
+
+func (_ *BindTempAuthKeyParams) CRC() uint32 {
+	return 0xcdd42a05
+}
+
+func (t *BindTempAuthKeyParams) Encode() []byte {
+	buf := serialize.NewEncoder()
+	buf.PutCRC(t.CRC())
+	buf.PutLong(t.PermAuthKeyID)
+	buf.PutLong(t.Nonce)
+	buf.PutInt(t.ExpiresAt)
+	buf.PutMessage(t.EncryptedMessage)
+	return buf.Result()
+}
+
+func (t *BindTempAuthKeyParams) DecodeFrom(d *serialize.Decoder) {
+	t.PermAuthKeyID = d.PopLong()
+	t.Nonce = d.PopLong()
+	t.ExpiresAt = d.PopInt()
+	t.EncryptedMessage = d.PopMessage()
+}
+
+type HttpWaitParams struct {
+	MaxDelay  int32
+	WaitAfter int32
+	MaxWait   int32
+}
+
+func (_ *HttpWaitParams) CRC() uint32 {
+	return 0x9299359f
+}
+
+func (t *HttpWaitParams) Encode() []byte {
+	buf := serialize.NewEncoder()
+	buf.PutCRC(t.CRC())
+	buf.PutInt(t.MaxDelay)
+	buf.PutInt(t.WaitAfter)
+	buf.PutInt(t.MaxWait)
+	return buf.Result()
+}
+
+func (t *HttpWaitParams) DecodeFrom(d *serialize.Decoder) {
+	t.MaxDelay = d.PopInt()
+	t.WaitAfter = d.PopInt()
+	t.MaxWait = d.PopInt()
+}
 
 // set_client_DH_params#f5045f1f nonce:int128 server_nonce:int128 encrypted_data:bytes = Set_client_DH_params_answer;
 
@@ -0,0 +1,235 @@
+package mtproto
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/xelaj/mtproto/serialize"
+)
+
+// ConnState это состояние соединения с сервером
+type ConnState int
+
+const (
+	// ConnStateDisconnected соединения нет: еще не подключались или вызван Disconnect
+	ConnStateDisconnected ConnState = iota
+	// ConnStateConnecting идет подключение или переподключение после обрыва
+	ConnStateConnecting
+	// ConnStateConnected соединение установлено, запросы отправляются
+	ConnStateConnected
+)
+
+func (s ConnState) String() string {
+	switch s {
+	case ConnStateDisconnected:
+		return "disconnected"
+	case ConnStateConnecting:
+		return "connecting"
+	case ConnStateConnected:
+		return "connected"
+	default:
+		return "unknown"
+	}
+}
+
+const (
+	defaultReconnectMinDelay = 500 * time.Millisecond
+	defaultReconnectMaxDelay = 30 * time.Second
+	defaultReconnectJitter   = 0.2
+
+	// как часто пингуем сервер
+	pingInterval = 60 * time.Second
+	// сколько ждем pong, прежде чем считать соединение мертвым
+	pingTimeout = 30 * time.Second
+	// через сколько сервер закроет соединение, если не дождется следующего пинга
+	pingDisconnectDelay = pingInterval + 15*time.Second
+)
+
+// backoff считает задержку перед очередной попыткой подключения: каждая
+// попытка ждет в два раза дольше предыдущей, но не больше max. jitter это
+// доля, на которую задержка случайно отклоняется, что бы много клиентов не
+// ломились на сервер одновременно
+type backoff struct {
+	min    time.Duration
+	max    time.Duration
+	jitter float64
+}
+
+func (b *backoff) delay(attempt int) time.Duration {
+	d := b.min
+	for i := 0; i < attempt && d < b.max; i++ {
+		d *= 2
+	}
+	if d > b.max {
+		d = b.max
+	}
+
+	if b.jitter > 0 {
+		d += time.Duration(float64(d) * b.jitter * (rand.Float64()*2 - 1))
+	}
+
+	return d
+}
+
+// errorResponse кладется в канал ответа вместо ответа сервера, если ответа уже
+// не будет. на сервер не отправляется
+type errorResponse struct {
+	err error
+}
+
+func (*errorResponse) CRC() uint32 {
+	panic("not acceptable")
+}
+
+func (*errorResponse) Encode() []byte {
+	panic("not acceptable")
+}
+
+func (*errorResponse) DecodeFrom(d *serialize.Decoder) {
+	panic("not acceptable")
+}
+
+func (m *MTProto) setState(state ConnState) {
+	m.stateMutex.Lock()
+	changed := m.state != state
+	m.state = state
+	m.stateMutex.Unlock()
+
+	if changed {
+		m.notifyState(state)
+	}
+}
+
+// swapState меняет состояние, только если текущее равно from
+func (m *MTProto) swapState(from, to ConnState) bool {
+	m.stateMutex.Lock()
+	if m.state != from {
+		m.stateMutex.Unlock()
+		return false
+	}
+	m.state = to
+	m.stateMutex.Unlock()
+
+	m.notifyState(to)
+	return true
+}
+
+func (m *MTProto) notifyState(state ConnState) {
+	if m.onStateChange != nil {
+		m.onStateChange(state)
+	}
+}
+
+// closedChan возвращает канал, который закроется при Disconnect
+func (m *MTProto) closedChan() <-chan struct{} {
+	m.stateMutex.Lock()
+	defer m.stateMutex.Unlock()
+	return m.closed
+}
+
+// State возвращает текущее состояние соединения
+func (m *MTProto) State() ConnState {
+	m.stateMutex.Lock()
+	defer m.stateMutex.Unlock()
+	return m.state
+}
+
+// reconnect вызывается, когда соединение оборвалось: закрывает старое соединение,
+// подключается заново (с задержками, пока не получится), и заново отправляет все
+// запросы, которые ждут ответа. одновременно может идти только одно переподключение
+func (m *MTProto) reconnect(reason error) {
+	// канал берем один раз: если после Disconnect снова вызовут
+	// CreateConnection, это переподключение все равно должно остановиться
+	closed := m.closedChan()
+	if !m.swapState(ConnStateConnected, ConnStateConnecting) {
+		// уже переподключаемся или отключились
+		return
+	}
+
+	m.closeConnection()
+
+	if !m.reconnectEnabled {
+		m.setState(ConnStateDisconnected)
+		m.failPending(errors.Wrap(reason, "connection lost"))
+		return
+	}
+
+	for attempt := 0; ; attempt++ {
+		select {
+		case <-closed:
+			return
+		case <-time.After(m.backoff.delay(attempt)):
+		}
+
+		err := m.connect()
+		if err == nil {
+			err = m.startRoutines()
+		}
+		if err == nil {
+			break
+		}
+		m.closeConnection()
+	}
+
+	if !m.swapState(ConnStateConnecting, ConnStateConnected) {
+		// пока подключались, вызвали Disconnect
+		m.closeConnection()
+		return
+	}
+	m.replayPending()
+}
+
+// closeConnection останавливает горутины и закрывает соединение, не трогая
+// ожидающие ответа запросы
+func (m *MTProto) closeConnection() {
+	m.connMutex.Lock()
+	defer m.connMutex.Unlock()
+	if m.stopRoutines != nil {
+		m.stopRoutines()
+	}
+	if t, ok := m.transport.(*httpTransport); ok {
+		t.Close()
+	}
+	if m.conn != nil {
+		m.conn.Close()
+	}
+}
+
+// replayPending заставляет все запросы, которые ждут ответа, отправиться заново.
+// makeRequest получит ErrorSessionConfigsChanged и повторит запрос с новым msg_id
+func (m *MTProto) replayPending() {
+	m.failPending(&serialize.ErrorSessionConfigsChanged{})
+}
+
+// failPending отдает всем ожидающим запросам err вместо ответа
+func (m *MTProto) failPending(err error) {
+	var resp serialize.TL = &errorResponse{err: err}
+	if e, ok := err.(*serialize.ErrorSessionConfigsChanged); ok {
+		resp = e
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	for id, ch := range m.responseChannels {
+		// каналы буферизированные, поэтому не блокируемся
+		select {
+		case ch <- resp:
+		default:
+		}
+		delete(m.responseChannels, id)
+	}
+}
+
+// connectionLost вызывается горутинами, которые заметили, что соединение
+// умерло, и запускает переподключение
+func (m *MTProto) connectionLost(ctx context.Context, err error) {
+	if ctx.Err() != nil {
+		// соединение закрыли сами
+		return
+	}
+
+	go m.reconnect(err)
+}
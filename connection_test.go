@@ -0,0 +1,98 @@
+package mtproto
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/xelaj/mtproto/serialize"
+	"github.com/xelaj/mtproto/utils"
+)
+
+func TestBackoffDelay(t *testing.T) {
+	b := &backoff{min: 100 * time.Millisecond, max: time.Second}
+	assert.Equal(t, 100*time.Millisecond, b.delay(0))
+	assert.Equal(t, 200*time.Millisecond, b.delay(1))
+	assert.Equal(t, 800*time.Millisecond, b.delay(3))
+	assert.Equal(t, time.Second, b.delay(4))
+	assert.Equal(t, time.Second, b.delay(100))
+
+	b.jitter = 0.5
+	for i := 0; i < 100; i++ {
+		d := b.delay(1)
+		assert.True(t, d >= 100*time.Millisecond && d <= 300*time.Millisecond, d.String())
+	}
+}
+
+func newTestMTProto() *MTProto {
+	return &MTProto{
+		mutex:                  &sync.Mutex{},
+		responseChannels:       make(map[int64]chan serialize.TL),
+		destroySessionChannels: make(map[int64]chan serialize.TL),
+		sendQueue:              newSendQueue(),
+		seq:                    utils.NewMessageSequence(),
+		replay:                 newReplayWindow(),
+		closed:                 make(chan struct{}),
+	}
+}
+
+func TestReplayPending(t *testing.T) {
+	m := newTestMTProto()
+	resp := make(chan serialize.TL, 1)
+	m.responseChannels[1] = resp
+
+	m.replayPending()
+	assert.Empty(t, m.responseChannels)
+	assert.IsType(t, &serialize.ErrorSessionConfigsChanged{}, <-resp)
+}
+
+func TestDisconnectFailsPending(t *testing.T) {
+	m := newTestMTProto()
+	var states []ConnState
+	m.onStateChange = func(s ConnState) { states = append(states, s) }
+	m.state = ConnStateConnected
+
+	resp := make(chan serialize.TL, 1)
+	m.responseChannels[1] = resp
+
+	assert.NoError(t, m.Disconnect())
+	assert.Empty(t, m.responseChannels)
+	assert.Equal(t, &errorResponse{err: ErrDisconnected}, <-resp)
+	assert.Equal(t, []ConnState{ConnStateDisconnected}, states)
+
+	// после Disconnect переподключаться уже не надо
+	m.reconnect(nil)
+	assert.Equal(t, ConnStateDisconnected, m.State())
+}
+
+func TestReconnectStopsAfterDisconnect(t *testing.T) {
+	m := newTestMTProto()
+	m.reconnectEnabled = true
+	m.backoff = backoff{min: time.Millisecond, max: time.Millisecond}
+	m.dialer = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return nil, errors.New("network is unreachable")
+	}
+	m.state = ConnStateConnected
+
+	done := make(chan struct{})
+	go func() {
+		m.reconnect(errors.New("connection lost"))
+		close(done)
+	}()
+
+	// новое соединение после Disconnect не должно оживить старое переподключение
+	time.Sleep(5 * time.Millisecond)
+	assert.NoError(t, m.Disconnect())
+	assert.Error(t, m.CreateConnection())
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Error("reconnect didn't stop after Disconnect")
+	}
+}
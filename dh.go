@@ -0,0 +1,163 @@
+package mtproto
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/xelaj/go-dry"
+
+	"github.com/xelaj/mtproto/serialize"
+)
+
+// https://core.telegram.org/mtproto/security_guidelines#g-a-and-g-b-validation
+// все, что сервер присылает для обмена ключами, нужно проверить: если dh_prime
+// не простое, или g_a слишком маленькое, ключ будет легко подобрать
+
+const (
+	dhPrimeBits = 2048
+	// g_a и g_b должны отстоять от 1 и dh_prime-1 хотя бы на 2^(2048-64)
+	dhSafetyMarginBits = dhPrimeBits - 64
+	// сколько раз проверяем число на простоту (Миллер-Рабин)
+	primalityRounds = 64
+	// сколько раз сервер может ответить dh_gen_retry, прежде чем сдаемся
+	maxDHGenRetries = 5
+)
+
+// knownDHPrime это dh_prime, который сейчас присылает сервер. его проверять не
+// нужно, все остальные проверяются на безопасную простоту
+var knownDHPrime, _ = new(big.Int).SetString(""+
+	"C71CAEB9C6B1C9048E6C522F70F13F73980D40238E3E21C14934D037563D930F"+
+	"48198A0AA7C14058229493D22530F4DBFA336F6E0AC925139543AED44CCE7C37"+
+	"20FD51F69458705AC68CD4FE6B6B13ABDC9746512969328454F18FAF8C595F64"+
+	"2477FE96BB2A941D5BCD1D4AC8CC49880708FA9B378E3C4F3A9060BEE67CF9A4"+
+	"A4A695811051907E162753B56B0F6B410DBA74D8A84B2A14B3144E0EF1284754"+
+	"FD17ED950D5965B4B9DD46582DB1178D169C6BC465B0D6FF9CA3928FEF5B9AE4"+
+	"E418FC15E83EBEA0F87FA9FF5EED70050DED2849F47BF959D956850CE929851F"+
+	"0D8115F635B105EE2E4E15D04B2454BF6F4FADF034B10403119CD8E3B92FCC5B", 16)
+
+// проверка на простоту долгая, поэтому запоминаем уже проверенные dh_prime
+var (
+	checkedPrimesMutex sync.Mutex
+	checkedPrimes      = make(map[string]bool)
+)
+
+// checkDHParams проверяет g, dh_prime и g_a из server_DH_inner_data
+func checkDHParams(g int32, dhPrime, gA *big.Int) error {
+	err := checkDHPrime(dhPrime)
+	if err != nil {
+		return err
+	}
+
+	err = checkDHGenerator(g, dhPrime)
+	if err != nil {
+		return err
+	}
+
+	return errors.Wrap(checkDHValue(gA, dhPrime), "checking g_a")
+}
+
+// checkDHPrime проверяет, что dh_prime это безопасное простое число на 2048
+// бит: и p, и (p-1)/2 простые
+func checkDHPrime(p *big.Int) error {
+	if p.BitLen() != dhPrimeBits {
+		return errors.Errorf("dh_prime must be %v bits, got %v", dhPrimeBits, p.BitLen())
+	}
+	if p.Cmp(knownDHPrime) == 0 {
+		return nil
+	}
+
+	key := p.Text(16)
+	checkedPrimesMutex.Lock()
+	safe, ok := checkedPrimes[key]
+	checkedPrimesMutex.Unlock()
+
+	if !ok {
+		safe = isSafePrime(p)
+		checkedPrimesMutex.Lock()
+		checkedPrimes[key] = safe
+		checkedPrimesMutex.Unlock()
+	}
+
+	if !safe {
+		return errors.New("dh_prime is not a safe prime")
+	}
+
+	return nil
+}
+
+func isSafePrime(p *big.Int) bool {
+	if !p.ProbablyPrime(primalityRounds) {
+		return false
+	}
+
+	q := new(big.Int).Rsh(p, 1) // (p-1)/2, p нечетное
+	return q.ProbablyPrime(primalityRounds)
+}
+
+// checkDHGenerator проверяет, что g порождает циклическую подгруппу простого
+// порядка (p-1)/2. для каждого g это сводится к простому условию на p
+func checkDHGenerator(g int32, p *big.Int) error {
+	mod := func(n int64) int64 {
+		return new(big.Int).Mod(p, big.NewInt(n)).Int64()
+	}
+
+	var ok bool
+	switch g {
+	case 2:
+		ok = mod(8) == 7
+	case 3:
+		ok = mod(3) == 2
+	case 4:
+		ok = true
+	case 5:
+		r := mod(5)
+		ok = r == 1 || r == 4
+	case 6:
+		r := mod(24)
+		ok = r == 19 || r == 23
+	case 7:
+		r := mod(7)
+		ok = r == 3 || r == 5 || r == 6
+	default:
+		return errors.Errorf("g must be between 2 and 7, got %v", g)
+	}
+
+	if !ok {
+		return errors.Errorf("g = %v is not a valid generator for dh_prime", g)
+	}
+
+	return nil
+}
+
+// checkDHValue проверяет g_a или g_b: 1 < v < dh_prime-1, и при этом
+// 2^(2048-64) <= v <= dh_prime - 2^(2048-64)
+func checkDHValue(v, p *big.Int) error {
+	one := big.NewInt(1)
+	if v.Cmp(one) <= 0 || v.Cmp(new(big.Int).Sub(p, one)) >= 0 {
+		return errors.New("value is out of range (1, dh_prime-1)")
+	}
+
+	margin := new(big.Int).Lsh(one, dhSafetyMarginBits)
+	if v.Cmp(margin) < 0 || v.Cmp(new(big.Int).Sub(p, margin)) > 0 {
+		return errors.New("value is too close to 1 or dh_prime")
+	}
+
+	return nil
+}
+
+// newNonceHash считает new_nonce_hash1, 2 или 3 (number) для ответа на
+// set_client_DH_params: младшие 128 бит SHA1(new_nonce + number + auth_key_aux_hash)
+func newNonceHash(newNonce *serialize.Int256, number byte, authKey []byte) []byte {
+	data := make([]byte, 0, serialize.Int256Len+1+serialize.LongLen)
+	data = append(data, dry.BigIntBytes(newNonce.Int, 256)...)
+	data = append(data, number)
+	data = append(data, authKeyAuxHash(authKey)...)
+
+	return dry.Sha1Byte(data)[4:20]
+}
+
+// authKeyAuxHash это старшие 64 бита SHA1 от ключа
+func authKeyAuxHash(authKey []byte) []byte {
+	return dry.Sha1Byte(authKey)[0:8]
+}
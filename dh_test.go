@@ -0,0 +1,69 @@
+package mtproto
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xelaj/go-dry"
+
+	"github.com/xelaj/mtproto/serialize"
+)
+
+func TestCheckDHPrime(t *testing.T) {
+	assert.True(t, isSafePrime(knownDHPrime))
+	assert.NoError(t, checkDHPrime(knownDHPrime))
+
+	// простое, но не безопасное: (p-1)/2 делится на 2
+	notSafe := new(big.Int).Add(knownDHPrime, big.NewInt(2))
+	for !notSafe.ProbablyPrime(20) {
+		notSafe.Add(notSafe, big.NewInt(2))
+	}
+	assert.Error(t, checkDHPrime(notSafe))
+	// результат запомнился
+	assert.Contains(t, checkedPrimes, notSafe.Text(16))
+
+	assert.Error(t, checkDHPrime(big.NewInt(23)), "too short")
+}
+
+func TestCheckDHGenerator(t *testing.T) {
+	// для известного dh_prime сервер присылает g = 3
+	assert.NoError(t, checkDHGenerator(3, knownDHPrime))
+	assert.NoError(t, checkDHGenerator(4, knownDHPrime))
+	assert.Error(t, checkDHGenerator(1, knownDHPrime))
+	assert.Error(t, checkDHGenerator(8, knownDHPrime))
+
+	assert.NoError(t, checkDHGenerator(2, big.NewInt(23)))
+	assert.Error(t, checkDHGenerator(2, big.NewInt(19)))
+	assert.NoError(t, checkDHGenerator(7, big.NewInt(19)))
+}
+
+func TestCheckDHValue(t *testing.T) {
+	p := knownDHPrime
+	margin := new(big.Int).Lsh(big.NewInt(1), dhSafetyMarginBits)
+
+	assert.Error(t, checkDHValue(big.NewInt(1), p))
+	assert.Error(t, checkDHValue(new(big.Int).Sub(p, big.NewInt(1)), p))
+	assert.Error(t, checkDHValue(new(big.Int).Sub(margin, big.NewInt(1)), p))
+	assert.Error(t, checkDHValue(new(big.Int).Add(new(big.Int).Sub(p, margin), big.NewInt(1)), p))
+	assert.NoError(t, checkDHValue(margin, p))
+	assert.NoError(t, checkDHValue(new(big.Int).Rsh(p, 1), p))
+
+	_, gB, _ := makeGAB(3, margin, p)
+	assert.NoError(t, checkDHValue(gB, p))
+}
+
+func TestCheckDHGenAnswer(t *testing.T) {
+	nonce := serialize.RandomInt128()
+	serverNonce := serialize.RandomInt128()
+	newNonce := serialize.RandomInt256()
+	authKey := dry.RandomBytes(256)
+
+	hash := newNonceHash(newNonce, 1, authKey)
+	gotHash := &serialize.Int128{Int: new(big.Int).SetBytes(hash)}
+	assert.NoError(t, checkDHGenAnswer(nonce, serverNonce, nonce, serverNonce, hash, gotHash))
+
+	// хеш для dh_gen_retry не подходит к dh_gen_ok
+	assert.Error(t, checkDHGenAnswer(nonce, serverNonce, nonce, serverNonce, newNonceHash(newNonce, 2, authKey), gotHash))
+	assert.Error(t, checkDHGenAnswer(nonce, serverNonce, serverNonce, serverNonce, hash, gotHash))
+}
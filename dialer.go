@@ -0,0 +1,281 @@
+package mtproto
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// DialFunc открывает соединение до addr. сигнатура совпадает с net.Dialer.DialContext,
+// поэтому туда можно передать как стандартный диалер, так и что-то свое (прокси,
+// соединение в памяти для тестов и т.п.)
+type DialFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
+// defaultDialer используется, если в конфиге диалер не указан
+func defaultDialer() DialFunc {
+	return (&net.Dialer{}).DialContext
+}
+
+// closeOnCancel закрывает соединение, если контекст отменили, пока идет
+// рукопожатие с прокси. возвращаемую функцию надо вызвать по окончании рукопожатия
+func closeOnCancel(ctx context.Context, conn net.Conn) (stop func()) {
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	return func() { close(done) }
+}
+
+//----------------------------------------------------------------------------
+
+// https://tools.ietf.org/html/rfc1928
+// https://tools.ietf.org/html/rfc1929
+const (
+	socks5Version = 0x05
+
+	socks5AuthNone     = 0x00
+	socks5AuthPassword = 0x02
+	socks5AuthNoAccept = 0xff
+
+	socks5CmdConnect = 0x01
+
+	socks5AddrIPv4   = 0x01
+	socks5AddrDomain = 0x03
+	socks5AddrIPv6   = 0x04
+)
+
+// SOCKS5Dialer возвращает диалер, который подключается к addr через SOCKS5 прокси.
+// если username пустой, авторизация не используется. forward это диалер, которым
+// открывается соединение до самого прокси, если nil, то используется стандартный
+func SOCKS5Dialer(proxyAddr, username, password string, forward DialFunc) DialFunc {
+	if forward == nil {
+		forward = defaultDialer()
+	}
+
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		conn, err := forward(ctx, network, proxyAddr)
+		if err != nil {
+			return nil, errors.Wrap(err, "dialing socks5 proxy")
+		}
+
+		stop := closeOnCancel(ctx, conn)
+		err = socks5Handshake(conn, addr, username, password)
+		stop()
+		if err != nil {
+			conn.Close()
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, errors.Wrap(err, "socks5 handshake")
+		}
+
+		return conn, nil
+	}
+}
+
+func socks5Handshake(conn io.ReadWriter, addr, username, password string) error {
+	method := byte(socks5AuthNone)
+	if username != "" {
+		method = socks5AuthPassword
+	}
+
+	_, err := conn.Write([]byte{socks5Version, 1, method})
+	if err != nil {
+		return errors.Wrap(err, "writing greeting")
+	}
+
+	resp := make([]byte, 2)
+	_, err = io.ReadFull(conn, resp)
+	if err != nil {
+		return errors.Wrap(err, "reading greeting")
+	}
+	if resp[0] != socks5Version {
+		return errors.New("unexpected socks version: " + strconv.Itoa(int(resp[0])))
+	}
+
+	switch resp[1] {
+	case socks5AuthNone:
+	case socks5AuthPassword:
+		if len(username) > 255 || len(password) > 255 {
+			return errors.New("username or password is too long")
+		}
+
+		req := []byte{0x01, byte(len(username))}
+		req = append(req, username...)
+		req = append(req, byte(len(password)))
+		req = append(req, password...)
+		_, err = conn.Write(req)
+		if err != nil {
+			return errors.Wrap(err, "writing credentials")
+		}
+
+		_, err = io.ReadFull(conn, resp)
+		if err != nil {
+			return errors.Wrap(err, "reading auth response")
+		}
+		if resp[1] != 0x00 {
+			return errors.New("authentication failed")
+		}
+	case socks5AuthNoAccept:
+		return errors.New("no acceptable authentication methods")
+	default:
+		return errors.New("unsupported authentication method: " + strconv.Itoa(int(resp[1])))
+	}
+
+	req, err := socks5ConnectRequest(addr)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Write(req)
+	if err != nil {
+		return errors.Wrap(err, "writing connect request")
+	}
+
+	header := make([]byte, 4)
+	_, err = io.ReadFull(conn, header)
+	if err != nil {
+		return errors.Wrap(err, "reading connect response")
+	}
+	if header[1] != 0x00 {
+		return errors.New("proxy refused connection, code " + strconv.Itoa(int(header[1])))
+	}
+
+	// адрес, к которому привязался прокси, нам не нужен, но его надо вычитать
+	var boundLen int
+	switch header[3] {
+	case socks5AddrIPv4:
+		boundLen = net.IPv4len
+	case socks5AddrIPv6:
+		boundLen = net.IPv6len
+	case socks5AddrDomain:
+		_, err = io.ReadFull(conn, resp[:1])
+		if err != nil {
+			return errors.Wrap(err, "reading bound address")
+		}
+		boundLen = int(resp[0])
+	default:
+		return errors.New("unknown address type: " + strconv.Itoa(int(header[3])))
+	}
+
+	_, err = io.ReadFull(conn, make([]byte, boundLen+2))
+	if err != nil {
+		return errors.Wrap(err, "reading bound address")
+	}
+
+	return nil
+}
+
+func socks5ConnectRequest(addr string) ([]byte, error) {
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing address")
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing port")
+	}
+
+	req := []byte{socks5Version, socks5CmdConnect, 0x00}
+	if ip := net.ParseIP(host); ip == nil {
+		if len(host) > 255 {
+			return nil, errors.New("host name is too long")
+		}
+		req = append(req, socks5AddrDomain, byte(len(host)))
+		req = append(req, host...)
+	} else if ip4 := ip.To4(); ip4 != nil {
+		req = append(req, socks5AddrIPv4)
+		req = append(req, ip4...)
+	} else {
+		req = append(req, socks5AddrIPv6)
+		req = append(req, ip.To16()...)
+	}
+
+	portBuf := make([]byte, 2)
+	binary.BigEndian.PutUint16(portBuf, uint16(port))
+	return append(req, portBuf...), nil
+}
+
+//----------------------------------------------------------------------------
+
+// HTTPConnectDialer возвращает диалер, который открывает туннель до addr через
+// HTTP прокси методом CONNECT. если username пустой, заголовок Proxy-Authorization
+// не отправляется
+func HTTPConnectDialer(proxyAddr, username, password string, forward DialFunc) DialFunc {
+	if forward == nil {
+		forward = defaultDialer()
+	}
+
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		conn, err := forward(ctx, network, proxyAddr)
+		if err != nil {
+			return nil, errors.Wrap(err, "dialing http proxy")
+		}
+
+		stop := closeOnCancel(ctx, conn)
+		conn, err = httpConnectHandshake(conn, addr, username, password)
+		stop()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, errors.Wrap(err, "http connect")
+		}
+
+		return conn, nil
+	}
+}
+
+func httpConnectHandshake(conn net.Conn, addr, username, password string) (net.Conn, error) {
+	req := "CONNECT " + addr + " HTTP/1.1\r\nHost: " + addr + "\r\n"
+	if username != "" {
+		credentials := base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+		req += "Proxy-Authorization: Basic " + credentials + "\r\n"
+	}
+	req += "\r\n"
+
+	_, err := conn.Write([]byte(req))
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "writing request")
+	}
+
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, &http.Request{Method: http.MethodConnect})
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "reading response")
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, errors.New("proxy responded with " + resp.Status)
+	}
+
+	// прокси мог прислать данные сразу за ответом, они остались в буфере
+	if reader.Buffered() > 0 {
+		return &bufferedConn{Conn: conn, reader: reader}, nil
+	}
+
+	return conn, nil
+}
+
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
@@ -0,0 +1,108 @@
+package mtproto
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// pipeDialer отдает заранее созданный конец net.Pipe вместо настоящего соединения
+func pipeDialer(conn net.Conn) DialFunc {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return conn, nil
+	}
+}
+
+func TestSOCKS5Dialer(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		buf := make([]byte, 3)
+		_, err := io.ReadFull(server, buf)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{socks5Version, 1, socks5AuthPassword}, buf)
+		server.Write([]byte{socks5Version, socks5AuthPassword})
+
+		auth := make([]byte, 2+len("user")+1+len("pass"))
+		_, err = io.ReadFull(server, auth)
+		assert.NoError(t, err)
+		assert.Equal(t, append(append([]byte{0x01, 4}, "user"...), append([]byte{4}, "pass"...)...), auth)
+		server.Write([]byte{0x01, 0x00})
+
+		req := make([]byte, 10)
+		_, err = io.ReadFull(server, req)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{socks5Version, socks5CmdConnect, 0x00, socks5AddrIPv4, 149, 154, 167, 50, 0x01, 0xbb}, req)
+		server.Write([]byte{socks5Version, 0x00, 0x00, socks5AddrIPv4, 127, 0, 0, 1, 0x04, 0x38})
+
+		server.Write([]byte("hello"))
+	}()
+
+	dial := SOCKS5Dialer("proxy:1080", "user", "pass", pipeDialer(client))
+	conn, err := dial(context.Background(), "tcp", "149.154.167.50:443")
+	assert.NoError(t, err)
+
+	got := make([]byte, 5)
+	_, err = io.ReadFull(conn, got)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", string(got))
+	<-done
+}
+
+func TestSOCKS5DialerAuthFailed(t *testing.T) {
+	client, server := net.Pipe()
+	defer server.Close()
+
+	go func() {
+		io.ReadFull(server, make([]byte, 3))
+		server.Write([]byte{socks5Version, socks5AuthPassword})
+		io.ReadFull(server, make([]byte, 2+4+1+4))
+		server.Write([]byte{0x01, 0x01})
+	}()
+
+	dial := SOCKS5Dialer("proxy:1080", "user", "pass", pipeDialer(client))
+	_, err := dial(context.Background(), "tcp", "149.154.167.50:443")
+	assert.Error(t, err)
+}
+
+func TestHTTPConnectDialer(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		req, err := http.ReadRequest(bufio.NewReader(server))
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, http.MethodConnect, req.Method)
+		assert.Equal(t, "149.154.167.50:443", req.Host)
+		assert.Equal(t, "Basic dXNlcjpwYXNz", req.Header.Get("Proxy-Authorization"))
+
+		// данные сразу за ответом должны дойти до клиента
+		server.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\nhello"))
+	}()
+
+	dial := HTTPConnectDialer("proxy:3128", "user", "pass", pipeDialer(client))
+	conn, err := dial(context.Background(), "tcp", "149.154.167.50:443")
+	assert.NoError(t, err)
+
+	got := make([]byte, 5)
+	_, err = io.ReadFull(conn, got)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", string(got))
+	<-done
+}
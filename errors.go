@@ -3,9 +3,14 @@ package mtproto
 import (
 	"strconv"
 
+	"github.com/pkg/errors"
+
 	"github.com/xelaj/mtproto/serialize"
 )
 
+// ErrDisconnected возвращается запросам, которые ждали ответа, когда вызвали Disconnect
+var ErrDisconnected = errors.New("disconnected")
+
 type ErrResponseCode struct {
 	Code        int
 	Message     string
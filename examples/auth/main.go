@@ -2,6 +2,7 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -11,21 +12,15 @@ import (
 	"github.com/xelaj/go-dry"
 
 	"github.com/xelaj/mtproto"
-	"github.com/xelaj/mtproto/keys"
 	"github.com/xelaj/mtproto/telegram"
 )
 
 var client *telegram.Client
 
 func main() {
-	keyfile := "/home/r0ck3t/go/src/github.com/xelaj/mtproto/keys/keys.pem"
-	TelegramPublicKeys, err := keys.ReadFromFile(keyfile)
-	dry.PanicIfErr(err)
-
 	m, err := mtproto.NewMTProto(mtproto.Config{
 		AuthKeyFile: "~/.local/var/lib/mtproto/session.json.lol",
 		ServerHost:  "149.154.167.50:443",
-		PublicKey:   TelegramPublicKeys[0],
 		AppID:       94575,
 		AppHash:     "a3406de8d171bb422bb6ddf3bbd800e2",
 	})
@@ -39,7 +34,9 @@ func main() {
 		panic(errors.Wrap(err, "Connect failed"))
 	}
 
-	resp, err := client.InvokeWithLayer(117, &telegram.InitConnectionParams{
+	ctx := context.Background()
+
+	resp, err := client.InvokeWithLayer(ctx, 117, &telegram.InitConnectionParams{
 		ApiID:          94575,
 		DeviceModel:    "Unknown",
 		SystemVersion:  "linux/amd64",
@@ -61,7 +58,7 @@ func main() {
 
 	phoneNumber := os.Args[1]
 
-	setCode, err := client.AuthSendCode(&telegram.AuthSendCodeParams{
+	setCode, err := client.AuthSendCode(ctx, &telegram.AuthSendCodeParams{
 		phoneNumber, 94575, "a3406de8d171bb422bb6ddf3bbd800e2", &telegram.CodeSettings{},
 	})
 	dry.PanicIfErr(err)
@@ -72,7 +69,7 @@ func main() {
 	code = strings.Replace(code, "\n", "", -1)
 
 	pp.Println(os.Args[2], setCode.PhoneCodeHash, code)
-	pp.Println(client.AuthSignIn(&telegram.AuthSignInParams{
+	pp.Println(client.AuthSignIn(ctx, &telegram.AuthSignInParams{
 		phoneNumber, setCode.PhoneCodeHash, code,
 	}))
 }
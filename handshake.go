@@ -6,143 +6,255 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/big"
+	"reflect"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/xelaj/go-dry"
 
 	ige "github.com/xelaj/mtproto/aes_ige"
-	"github.com/xelaj/mtproto/keys"
 	"github.com/xelaj/mtproto/serialize"
 )
 
 // TODO: сюда обмен ключами запихать
 
+// makeAuthKey создает постоянный ключ авторизации и сохраняет его в сессию
+func (m *MTProto) makeAuthKey() error {
+	authKey, salt, err := m.exchangeKeys(0)
+	if err != nil {
+		return err
+	}
+
+	m.SetAuthKey(authKey)
+	m.setServerSalt(salt)
+	// старый временный ключ был привязан к старому постоянному
+	m.permAuthKey = nil
+	m.tempKeyExpiresAt = time.Time{}
+
+	// (all ok)
+	err = m.SaveSession()
+	if err != nil {
+		return errors.Wrap(err, "saving session")
+	}
+
+	return nil
+}
+
+// setServiceMode включает и выключает режим обмена ключами: пока он включен,
+// незашифрованные сообщения от сервера отдаются в serviceChannel
+func (m *MTProto) setServiceMode(on bool) {
+	m.mutex.Lock()
+	m.serviceModeActivated = on
+	m.mutex.Unlock()
+}
+
+func (m *MTProto) serviceMode() bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.serviceModeActivated
+}
+
+// handshakeDcID возвращает номер датацентра для p_q_inner_data_dc: у тестовых
+// серверов он больше на 10000
+func (m *MTProto) handshakeDcID() int32 {
+	const testServerDcOffset = 10000
+
+	if m.testServer {
+		return int32(m.dcID + testServerDcOffset)
+	}
+	return int32(m.dcID)
+}
+
+// exchangeKeys создает новый ключ авторизации обменом ключами Диффи-Хеллмана.
+// если expiresIn больше нуля, то ключ временный и живет expiresIn секунд.
+// возвращает сам ключ и первую соль для него
 // https://tlgrm.ru/docs/mtproto/auth_key
 // https://core.telegram.org/mtproto/auth_key
-func (m *MTProto) makeAuthKey() error {
-	m.serviceModeActivated = true
+func (m *MTProto) exchangeKeys(expiresIn int32) (authKey []byte, salt int64, err error) {
+	m.setServiceMode(true)
+	defer m.setServiceMode(false)
+
 	nonceFirst := serialize.RandomInt128()
 	res, err := m.ReqPQ(nonceFirst)
 	if err != nil {
-		return errors.Wrap(err, "requesting first pq")
+		return nil, 0, errors.Wrap(err, "requesting first pq")
 	}
 
 	if nonceFirst.Cmp(res.Nonce.Int) != 0 {
-		return errors.New("Handshake: Wrong nonce")
-	}
-	found := false
-	for _, b := range res.Fingerprints {
-		if uint64(b) == binary.LittleEndian.Uint64(keys.RSAFingerprint(m.publicKey)) {
-			found = true
-			break
-		}
+		return nil, 0, errors.New("Handshake: Wrong nonce")
 	}
+	publicKey, keyFingerprint, found := m.publicKeys.Find(res.Fingerprints)
 	if !found {
-		return errors.New("Handshake: Can't find fingerprint")
+		return nil, 0, errors.New("Handshake: Can't find fingerprint")
 	}
 
 	// (encoding) p_q_inner_data
 	pq := big.NewInt(0).SetBytes(res.Pq)
-	p, q := splitPQ(pq)
+	p, q, err := splitPQ(pq)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "factorizing pq")
+	}
 	nonceSecond := serialize.RandomInt256()
 	nonceServer := res.ServerNonce
 
-	message := (&serialize.PQInnerData{
-		Pq:          res.Pq,
-		P:           p.Bytes(),
-		Q:           q.Bytes(),
-		Nonce:       nonceFirst,
-		ServerNonce: nonceServer,
-		NewNonce:    nonceSecond,
-	}).Encode()
-
-	hashAndMsg := make([]byte, 255)
-	copy(hashAndMsg, append(dry.Sha1(string(message)), message...))
+	var innerData serialize.TL
+	switch {
+	case expiresIn > 0:
+		innerData = &serialize.PQInnerDataTempDc{
+			Pq:          res.Pq,
+			P:           p.Bytes(),
+			Q:           q.Bytes(),
+			Nonce:       nonceFirst,
+			ServerNonce: nonceServer,
+			NewNonce:    nonceSecond,
+			Dc:          m.handshakeDcID(),
+			ExpiresIn:   expiresIn,
+		}
+	case m.dcID != 0:
+		innerData = &serialize.PQInnerDataDc{
+			Pq:          res.Pq,
+			P:           p.Bytes(),
+			Q:           q.Bytes(),
+			Nonce:       nonceFirst,
+			ServerNonce: nonceServer,
+			NewNonce:    nonceSecond,
+			Dc:          m.handshakeDcID(),
+		}
+	default:
+		// номер датацентра неизвестен, сервер примет и старый формат
+		innerData = &serialize.PQInnerData{
+			Pq:          res.Pq,
+			P:           p.Bytes(),
+			Q:           q.Bytes(),
+			Nonce:       nonceFirst,
+			ServerNonce: nonceServer,
+			NewNonce:    nonceSecond,
+		}
+	}
 
-	encryptedMessage := doRSAencrypt(hashAndMsg, m.publicKey)
+	encryptedMessage, err := rsaPadEncrypt(innerData.Encode(), publicKey)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "encrypting p_q_inner_data")
+	}
 
-	keyFingerprint := int64(binary.LittleEndian.Uint64(keys.RSAFingerprint(m.publicKey)))
 	dhResponse, err := m.ReqDHParams(nonceFirst, nonceServer, p.Bytes(), q.Bytes(), keyFingerprint, encryptedMessage)
-	dry.PanicIfErr(errors.Wrap(err, "sending ReqDHParams"))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "sending ReqDHParams")
+	}
+	if fail, ok := dhResponse.(*serialize.ServerDHParamsFail); ok {
+		// new_nonce_hash доказывает, что отказ пришел от того, кто расшифровал p_q_inner_data
+		if !bytes.Equal(dry.Sha1Byte(dry.BigIntBytes(nonceSecond.Int, 256))[4:20], dry.BigIntBytes(fail.NewNonceHash.Int, 128)) {
+			return nil, 0, errors.New("Handshake: Wrong new_nonce_hash in server_DH_params_fail")
+		}
+		return nil, 0, errors.New("Handshake: server refused to send DH params")
+	}
 	dhParams, ok := dhResponse.(*serialize.ServerDHParamsOk)
 	if !ok {
-		panic("Handshake: Need ServerDHParamsOk")
+		return nil, 0, errors.New("Handshake: Need ServerDHParamsOk")
 	}
 
 	if nonceFirst.Cmp(dhParams.Nonce.Int) != 0 {
-		panic("Handshake: Wrong nonce")
+		return nil, 0, errors.New("Handshake: Wrong nonce")
 	}
 	if nonceServer.Cmp(dhParams.ServerNonce.Int) != 0 {
-		panic("Handshake: Wrong server_nonce")
+		return nil, 0, errors.New("Handshake: Wrong server_nonce")
 	}
 
 	// проверку по хешу, удаление рандомных байт происходит в этой функции
 	decodedMessage := ige.DecryptMessageWithTempKeys(dhParams.EncryptedAnswer, nonceSecond.Int, nonceServer.Int)
 	buf := serialize.NewDecoder(decodedMessage)
 	data := buf.PopObj()
+	if buf.Err() != nil {
+		return nil, 0, errors.Wrap(buf.Err(), "decoding server_DH_inner_data")
+	}
 
 	dhi, ok := data.(*serialize.ServerDHInnerData)
 	if !ok {
-		return errors.New("Handshake: Need server_DH_inner_data")
+		return nil, 0, errors.New("Handshake: Need server_DH_inner_data")
 	}
 	if nonceFirst.Cmp(dhi.Nonce.Int) != 0 {
-		return errors.New("Handshake: Wrong nonce")
+		return nil, 0, errors.New("Handshake: Wrong nonce")
 	}
 	if nonceServer.Cmp(dhi.ServerNonce.Int) != 0 {
-		return errors.New("Handshake: Wrong server_nonce")
+		return nil, 0, errors.New("Handshake: Wrong server_nonce")
 	}
 
-	// вот это видимо как раз и есть часть диффи хеллмана, поэтому просто оставим как есть надеюсь сработает
-	_, g_b, g_ab := makeGAB(dhi.G, big.NewInt(0).SetBytes(dhi.GA), big.NewInt(0).SetBytes(dhi.DhPrime))
+	// сразу подстраиваемся под часы сервера, что бы первые же сообщения не
+	// получили bad_msg_notification
+	m.seq.SetTimeOffset(time.Until(time.Unix(int64(dhi.ServerTime), 0)))
 
-	authKey := g_ab.Bytes()
-	if authKey[0] == 0 {
-		authKey = authKey[1:]
+	dhPrime := big.NewInt(0).SetBytes(dhi.DhPrime)
+	gA := big.NewInt(0).SetBytes(dhi.GA)
+	err = checkDHParams(dhi.G, dhPrime, gA)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Handshake: invalid DH params")
 	}
 
-	m.SetAuthKey(authKey)
+	saltBytes := make([]byte, serialize.LongLen)
+	copy(saltBytes, dry.BigIntBytes(nonceSecond.Int, 256)[:8])
+	xor(saltBytes, dry.BigIntBytes(nonceServer.Int, 128)[:8])
+	salt = int64(binary.LittleEndian.Uint64(saltBytes))
 
-	// что это я пока не знаю, видимо какой то очень специфичный способ сгенерить ключи
-	t4 := make([]byte, 32+1+8)
-	copy(t4[0:], nonceSecond.Bytes())
-	t4[32] = 1
-	copy(t4[33:], dry.Sha1Byte(m.GetAuthKey())[0:8])
-	nonceHash1 := dry.Sha1Byte(t4)[4:20]
-	salt := make([]byte, serialize.LongLen)
-	copy(salt, nonceSecond.Bytes()[:8])
-	xor(salt, nonceServer.Bytes()[:8])
-	m.serverSalt = int64(binary.LittleEndian.Uint64(salt))
+	// если сервер ответит dh_gen_retry, то повторяем с новым b, а в retry_id
+	// отправляем auth_key_aux_hash ключа из прошлой попытки
+	var retryID int64
+	for attempt := 0; ; attempt++ {
+		_, g_b, g_ab := makeGAB(dhi.G, gA, dhPrime)
+		authKey = dry.BigIntBytes(g_ab, dhPrimeBits)
 
-	// (encoding) client_DH_inner_data
-	clientDHData := &serialize.ClientDHInnerData{nonceFirst, nonceServer, 0, g_b.Bytes()}
+		// (encoding) client_DH_inner_data
+		clientDHData := &serialize.ClientDHInnerData{nonceFirst, nonceServer, retryID, g_b.Bytes()}
 
-	encryptedMessage = ige.EncryptMessageWithTempKeys(clientDHData.Encode(), nonceSecond.Int, nonceServer.Int)
+		encryptedMessage = ige.EncryptMessageWithTempKeys(clientDHData.Encode(), nonceSecond.Int, nonceServer.Int)
 
-	dhGenStatus, err := m.SetClientDHParams(nonceFirst, nonceServer, encryptedMessage)
-	dry.PanicIfErr(err)
+		dhGenStatus, err := m.SetClientDHParams(nonceFirst, nonceServer, encryptedMessage)
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "sending SetClientDHParams")
+		}
 
-	dhg, ok := dhGenStatus.(*serialize.DHGenOk)
-	if !ok {
-		return errors.New("Handshake: Need DHGenOk")
+		switch dhg := dhGenStatus.(type) {
+		case *serialize.DHGenOk:
+			err = checkDHGenAnswer(nonceFirst, nonceServer, dhg.Nonce, dhg.ServerNonce, newNonceHash(nonceSecond, 1, authKey), dhg.NewNonceHash1)
+			if err != nil {
+				return nil, 0, errors.Wrap(err, "Handshake: dh_gen_ok")
+			}
+			return authKey, salt, nil
+
+		case *serialize.DHGenRetry:
+			err = checkDHGenAnswer(nonceFirst, nonceServer, dhg.Nonce, dhg.ServerNonce, newNonceHash(nonceSecond, 2, authKey), dhg.NewNonceHash2)
+			if err != nil {
+				return nil, 0, errors.Wrap(err, "Handshake: dh_gen_retry")
+			}
+			if attempt >= maxDHGenRetries {
+				return nil, 0, errors.New("Handshake: too many dh_gen_retry")
+			}
+			retryID = int64(binary.LittleEndian.Uint64(authKeyAuxHash(authKey)))
+
+		case *serialize.DHGenFail:
+			err = checkDHGenAnswer(nonceFirst, nonceServer, dhg.Nonce, dhg.ServerNonce, newNonceHash(nonceSecond, 3, authKey), dhg.NewNonceHash3)
+			if err != nil {
+				return nil, 0, errors.Wrap(err, "Handshake: dh_gen_fail")
+			}
+			return nil, 0, errors.New("Handshake: server failed to generate auth key")
+
+		default:
+			return nil, 0, errors.New("Handshake: got invalid response type: " + reflect.TypeOf(dhGenStatus).String())
+		}
 	}
-	if nonceFirst.Cmp(dhg.Nonce.Int) != 0 {
-		panic(fmt.Sprintf("Handshake: Wrong nonce: %v, %v", nonceFirst, dhg.Nonce))
+}
+
+// checkDHGenAnswer проверяет nonce, server_nonce и new_nonce_hash в ответе на
+// set_client_DH_params
+func checkDHGenAnswer(nonce, serverNonce, gotNonce, gotServerNonce *serialize.Int128, nonceHash []byte, gotNonceHash *serialize.Int128) error {
+	if nonce.Cmp(gotNonce.Int) != 0 {
+		return fmt.Errorf("wrong nonce: %v, %v", nonce, gotNonce)
 	}
-	if nonceServer.Cmp(dhg.ServerNonce.Int) != 0 {
-		panic(fmt.Sprintf("Handshake: Wrong server_nonce: %v, %v", nonceServer, dhg.ServerNonce))
+	if serverNonce.Cmp(gotServerNonce.Int) != 0 {
+		return fmt.Errorf("wrong server_nonce: %v, %v", serverNonce, gotServerNonce)
 	}
-	if !bytes.Equal(nonceHash1, dhg.NewNonceHash1.Bytes()) {
-		panic(fmt.Sprintf(
-			"Handshake: Wrong new_nonce_hash1: %v, %v",
-			hex.EncodeToString(nonceHash1),
-			hex.EncodeToString(dhg.NewNonceHash1.Bytes()),
-		))
+	if got := dry.BigIntBytes(gotNonceHash.Int, 128); !bytes.Equal(nonceHash, got) {
+		return fmt.Errorf("wrong new_nonce_hash: %v, %v", hex.EncodeToString(nonceHash), hex.EncodeToString(got))
 	}
-	m.serviceModeActivated = false
-
-	// (all ok)
-	err = m.SaveSession()
-	dry.PanicIfErr(err)
 
 	return nil
 }
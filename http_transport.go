@@ -0,0 +1,188 @@
+package mtproto
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// https://core.telegram.org/mtproto/transports#http
+// каждый пакет отправляется отдельным POST запросом, а в теле ответа сервер
+// присылает накопившиеся для нас сообщения. что бы сервер мог прислать что-то
+// сам, клиент держит открытым запрос с http_wait (long polling)
+
+const (
+	// путь, по которому сервер принимает запросы
+	httpTransportPath = "/api"
+
+	// параметры http_wait в миллисекундах: сколько сервер ждет после первого
+	// сообщения, сколько ждет перед ответом и максимальное время ожидания
+	httpWaitMaxDelay  = 0
+	httpWaitWaitAfter = 0
+	httpWaitMaxWait   = 25000
+)
+
+type httpTransport struct {
+	client *http.Client
+	url    string
+
+	responses chan []byte
+	errs      chan error
+	closed    chan struct{}
+
+	// сколько запросов сейчас висит на сервере. когда их не остается, в idle
+	// приходит сигнал, что пора снова отправить http_wait
+	inflight int32
+	idle     chan struct{}
+}
+
+// newHTTPTransport создает кодек, который отправляет пакеты на addr по HTTP.
+// соединения открываются через dial
+func newHTTPTransport(addr string, dial DialFunc) *httpTransport {
+	return &httpTransport{
+		client: &http.Client{
+			Transport: &http.Transport{
+				DialContext: dial,
+			},
+			// сервер может держать запрос до max_wait, даем немного запаса
+			Timeout: time.Duration(httpWaitMaxWait)*time.Millisecond + 30*time.Second,
+		},
+		url:       "http://" + addr + httpTransportPath,
+		responses: make(chan []byte, 16),
+		errs:      make(chan error, 1),
+		closed:    make(chan struct{}),
+		idle:      make(chan struct{}, 1),
+	}
+}
+
+// WritePacket не ждет ответа сервера: запрос может висеть долго (http_wait),
+// поэтому он выполняется в отдельной горутине, а ответ потом отдается через
+// ReadPacket
+func (t *httpTransport) WritePacket(data []byte) error {
+	select {
+	case <-t.closed:
+		return errors.New("transport is closed")
+	default:
+	}
+
+	atomic.AddInt32(&t.inflight, 1)
+	go func() {
+		resp, err := t.post(data)
+		if atomic.AddInt32(&t.inflight, -1) == 0 {
+			select {
+			case t.idle <- struct{}{}:
+			default:
+			}
+		}
+		if err != nil {
+			select {
+			case t.errs <- err:
+			default:
+			}
+			return
+		}
+
+		// пустой ответ значит, что у сервера для нас ничего нет
+		if len(resp) == 0 {
+			return
+		}
+
+		select {
+		case t.responses <- resp:
+		case <-t.closed:
+		}
+	}()
+
+	return nil
+}
+
+func (t *httpTransport) ReadPacket() ([]byte, error) {
+	select {
+	case data := <-t.responses:
+		return data, nil
+	case err := <-t.errs:
+		return nil, err
+	case <-t.closed:
+		return nil, io.EOF
+	}
+}
+
+func (t *httpTransport) Close() error {
+	select {
+	case <-t.closed:
+	default:
+		close(t.closed)
+		t.client.CloseIdleConnections()
+	}
+
+	return nil
+}
+
+func (t *httpTransport) post(data []byte) ([]byte, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-t.closed:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	req, err := http.NewRequest(http.MethodPost, t.url, bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "creating request")
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "sending request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxPacketSize))
+	if err != nil {
+		return nil, errors.Wrap(err, "reading response")
+	}
+
+	// при ошибке сервер присылает код ошибки (4 байта), его разбирает
+	// CatchResponseErrorCode, поэтому отдаем как обычный пакет
+	if resp.StatusCode != http.StatusOK && len(body) != 4 {
+		return nil, errors.New("server responded with " + strconv.Itoa(resp.StatusCode))
+	}
+
+	return body, nil
+}
+
+// longPollHTTP следит, что бы на сервере всегда висел хотя бы один запрос
+// (http_wait), тогда сервер может присылать нам сообщения, не дожидаясь наших
+// запросов
+func (m *MTProto) longPollHTTP(ctx context.Context, t *httpTransport) {
+	go func() {
+		for {
+			_, _, err := m.sendPacketNew(&HttpWaitParams{
+				MaxDelay:  httpWaitMaxDelay,
+				WaitAfter: httpWaitWaitAfter,
+				MaxWait:   httpWaitMaxWait,
+			})
+			if err != nil {
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.idle:
+			}
+		}
+	}()
+}
@@ -0,0 +1,46 @@
+package mtproto
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, httpTransportPath, r.URL.Path)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		switch string(body) {
+		case "empty":
+			// у сервера ничего нет, ответ пустой
+		case "error":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte{0x6c, 0xfe, 0xff, 0xff})
+		default:
+			w.Write(append([]byte("re: "), body...))
+		}
+	}))
+	defer server.Close()
+
+	tr := newHTTPTransport(strings.TrimPrefix(server.URL, "http://"), defaultDialer())
+	defer tr.Close()
+
+	assert.NoError(t, tr.WritePacket([]byte("empty")))
+	<-tr.idle
+	assert.NoError(t, tr.WritePacket([]byte("hello")))
+
+	got, err := tr.ReadPacket()
+	assert.NoError(t, err)
+	assert.Equal(t, "re: hello", string(got))
+
+	assert.NoError(t, tr.WritePacket([]byte("error")))
+	got, err = tr.ReadPacket()
+	assert.NoError(t, err)
+	assert.Equal(t, &ErrResponseCode{Code: -404}, CatchResponseErrorCode(got))
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/rsa"
 	"crypto/x509"
+	"encoding/binary"
 	"encoding/pem"
 	"io/ioutil"
 	"math/big"
@@ -30,6 +31,39 @@ func RSAFingerprint(key *rsa.PublicKey) []byte {
 	return []byte(fingerprint)[12:] // последние 8 байт это и есть отпечаток
 }
 
+// Fingerprint возвращает отпечаток ключа в том виде, в котором его присылает
+// сервер в resPQ
+func Fingerprint(key *rsa.PublicKey) int64 {
+	return int64(binary.LittleEndian.Uint64(RSAFingerprint(key)))
+}
+
+// Index это набор ключей по их отпечаткам
+type Index map[int64]*rsa.PublicKey
+
+// NewIndex собирает индекс из встроенных ключей и extra (например, ключа
+// тестовых серверов)
+func NewIndex(extra ...*rsa.PublicKey) Index {
+	index := make(Index, len(builtin)+len(extra))
+	for _, key := range append(Builtin(), extra...) {
+		if key != nil {
+			index[Fingerprint(key)] = key
+		}
+	}
+
+	return index
+}
+
+// Find возвращает первый ключ из fingerprints, который есть в индексе
+func (i Index) Find(fingerprints []int64) (key *rsa.PublicKey, fingerprint int64, found bool) {
+	for _, fingerprint := range fingerprints {
+		if key, ok := i[fingerprint]; ok {
+			return key, fingerprint, true
+		}
+	}
+
+	return nil, 0, false
+}
+
 func ReadFromFile(path string) ([]*rsa.PublicKey, error) {
 	if !dry.FileExists(path) {
 		return nil, errs.NotFound("file", path)
@@ -38,6 +72,12 @@ func ReadFromFile(path string) ([]*rsa.PublicKey, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "reading file  keys")
 	}
+
+	return ParsePEM(data)
+}
+
+// ParsePEM читает все ключи из PEM блоков подряд
+func ParsePEM(data []byte) ([]*rsa.PublicKey, error) {
 	keys := make([]*rsa.PublicKey, 0)
 	for {
 		block, rest := pem.Decode(data)
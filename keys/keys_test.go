@@ -0,0 +1,42 @@
+package keys
+
+import (
+	"crypto/rsa"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuiltinFingerprints(t *testing.T) {
+	keys := Builtin()
+	assert.Len(t, keys, 7)
+
+	// отпечатки боевого и тестового ключей из документации
+	assert.Equal(t, uint64(0xd09d1d85de64fd85), uint64(Fingerprint(keys[0])))
+	assert.Equal(t, uint64(0xb25898df208d2603), uint64(Fingerprint(keys[1])))
+	for _, key := range keys {
+		assert.Equal(t, 2048, key.N.BitLen())
+	}
+
+	// тестовые сервера присылают только отпечаток тестового ключа
+	testFingerprint := uint64(0xb25898df208d2603)
+	key, _, found := NewIndex().Find([]int64{int64(testFingerprint)})
+	assert.True(t, found)
+	assert.Equal(t, keys[1], key)
+}
+
+func TestIndexFind(t *testing.T) {
+	extra := &rsa.PublicKey{N: big.NewInt(0xabcdef), E: 65537}
+	index := NewIndex(extra, nil)
+	assert.Len(t, index, 8)
+
+	// незнакомые отпечатки пропускаются
+	key, fingerprint, found := index.Find([]int64{1, Fingerprint(extra), Fingerprint(Builtin()[0])})
+	assert.True(t, found)
+	assert.Equal(t, extra, key)
+	assert.Equal(t, Fingerprint(extra), fingerprint)
+
+	_, _, found = index.Find([]int64{1, 2})
+	assert.False(t, found)
+}
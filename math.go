@@ -2,115 +2,243 @@ package mtproto
 
 import (
 	"crypto/rsa"
+	"crypto/sha256"
 	"math/big"
-	"math/rand"
-	"time"
+	"math/bits"
 
+	"github.com/pkg/errors"
 	"github.com/xelaj/go-dry"
+
+	ige "github.com/xelaj/mtproto/aes_ige"
+)
+
+// https://core.telegram.org/mtproto/auth_key#presenting-proof-of-work-server-authentication
+// RSA_PAD: данные дополняются до 192 байт, переворачиваются, к ним добавляется
+// SHA256 со случайным ключом temp_key, все это шифруется в AES-256-IGE этим
+// ключом, а сам ключ прячется через xor с SHA256 от результата
+const (
+	rsaPadDataLen    = 192 // длина данных с паддингом
+	rsaPadMaxDataLen = 144 // данных может быть не больше
+	rsaPadTempKeyLen = 32
+	rsaPadResultLen  = 256
 )
 
-// doRSAencrypt шифрует ровно 1 блок сообщения длиной 255 байт публичным ключом.
-// специфический алгоритм для мтпрото, т.к. документация не указывает, шифрование
-// по OAEP или как-то еще
-func doRSAencrypt(block []byte, key *rsa.PublicKey) []byte {
-	dry.PanicIf(len(block) != 255, "block size isn't equal 255 bytes")
-	z := big.NewInt(0).SetBytes(block)
+// rsaPadEncrypt шифрует data публичным ключом по схеме RSA_PAD. если
+// получившееся число не меньше модуля ключа, все повторяется с новым temp_key
+func rsaPadEncrypt(data []byte, key *rsa.PublicKey) ([]byte, error) {
+	if len(data) > rsaPadMaxDataLen {
+		return nil, errors.Errorf("data is too long for RSA_PAD: %v bytes, max %v", len(data), rsaPadMaxDataLen)
+	}
+	if key.N.BitLen() > rsaPadResultLen*8 {
+		return nil, errors.Errorf("rsa key is too long: %v bits", key.N.BitLen())
+	}
+
+	dataWithPadding := append(append([]byte{}, data...), dry.RandomBytes(rsaPadDataLen-len(data))...)
+	dataPadReversed := make([]byte, rsaPadDataLen)
+	for i, b := range dataWithPadding {
+		dataPadReversed[rsaPadDataLen-1-i] = b
+	}
+
+	zeroIV := make([]byte, rsaPadTempKeyLen)
 	exponent := big.NewInt(int64(key.E))
+	for {
+		tempKey := dry.RandomBytes(rsaPadTempKeyLen)
+		hash := sha256.Sum256(append(append([]byte{}, tempKey...), dataWithPadding...))
+		dataWithHash := append(append([]byte{}, dataPadReversed...), hash[:]...)
+
+		aesEncrypted, err := ige.EncryptIGE(dataWithHash, tempKey, zeroIV)
+		if err != nil {
+			return nil, errors.Wrap(err, "encrypting data with temp key")
+		}
 
-	c := big.NewInt(0).Exp(z, exponent, key.N)
+		tempKeyXor := sha256.Sum256(aesEncrypted)
+		xor(tempKeyXor[:], tempKey)
 
-	res := make([]byte, 256)
-	copy(res, c.Bytes())
+		z := big.NewInt(0).SetBytes(append(tempKeyXor[:], aesEncrypted...))
+		if z.Cmp(key.N) >= 0 {
+			continue
+		}
 
-	return res
+		c := big.NewInt(0).Exp(z, exponent, key.N)
+		return dry.BigIntBytes(c, rsaPadResultLen*8), nil
+	}
 }
 
-// splitPQ раскладывает число на два простых, при том таким образом, что p1 < p2
-// Часть алгоритма диффи хеллмана, как работает — без понятия
-func splitPQ(pq *big.Int) (p1, p2 *big.Int) {
-	value_0 := big.NewInt(0)
-	value_1 := big.NewInt(1)
-	value_15 := big.NewInt(15)
-	value_17 := big.NewInt(17)
-	rndmax := big.NewInt(0).SetBit(big.NewInt(0), 64, 1)
-
-	what := big.NewInt(0).Set(pq)
-	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
-	g := big.NewInt(0)
-	i := 0
-	for !(g.Cmp(value_1) == 1 && g.Cmp(what) == -1) {
-		q := big.NewInt(0).Rand(rnd, rndmax)
-		q = q.And(q, value_15)
-		q = q.Add(q, value_17)
-		q = q.Mod(q, what)
-
-		x := big.NewInt(0).Rand(rnd, rndmax)
-		whatnext := big.NewInt(0).Sub(what, value_1)
-		x = x.Mod(x, whatnext)
-		x = x.Add(x, value_1)
-
-		y := big.NewInt(0).Set(x)
-		lim := 1 << (uint(i) + 18)
-		j := 1
-		flag := true
-
-		for j < lim && flag {
-			a := big.NewInt(0).Set(x)
-			b := big.NewInt(0).Set(x)
-			c := big.NewInt(0).Set(q)
-
-			for b.Cmp(value_0) == 1 {
-				b2 := big.NewInt(0)
-				if b2.And(b, value_1).Cmp(value_0) == 1 {
-					c.Add(c, a)
-					if c.Cmp(what) >= 0 {
-						c.Sub(c, what)
-					}
-				}
-				a.Add(a, a)
-				if a.Cmp(what) >= 0 {
-					a.Sub(a, what)
-				}
-				b.Rsh(b, 1)
-			}
-			x.Set(c)
-
-			z := big.NewInt(0)
-			if x.Cmp(y) == -1 {
-				z.Add(what, x)
-				z.Sub(z, y)
-			} else {
-				z.Sub(x, y)
-			}
-			g.GCD(nil, nil, z, what)
+// splitPQ раскладывает pq на два простых множителя p <= q. сервер присылает
+// pq меньше 2^63, поэтому обычно хватает арифметики на uint64, большие числа
+// раскладываются через big.Int тем же алгоритмом
+func splitPQ(pq *big.Int) (p, q *big.Int, err error) {
+	if pq.Cmp(big.NewInt(3)) <= 0 {
+		return nil, nil, errors.Errorf("pq is too small: %v", pq)
+	}
+	if pq.ProbablyPrime(primalityRounds) {
+		return nil, nil, errors.Errorf("pq is prime: %v", pq)
+	}
 
-			if (j & (j - 1)) == 0 {
-				y.Set(x)
-			}
-			j = j + 1
+	if pq.IsUint64() {
+		n := pq.Uint64()
+		f := pollardBrent(n)
+		p, q = new(big.Int).SetUint64(f), new(big.Int).SetUint64(n/f)
+	} else {
+		p = pollardBrentBig(pq)
+		q = new(big.Int).Div(pq, p)
+	}
+
+	if p.Cmp(q) > 0 {
+		p, q = q, p
+	}
+
+	return p, q, nil
+}
+
+// https://maths-people.anu.edu.au/~brent/pd/rpb051i.pdf
+// pollardBrent находит нетривиальный делитель составного n ро-методом Полларда
+// в варианте Брента: gcd считается не на каждом шаге, а от произведения
+// разностей за m шагов, что сильно быстрее
+func pollardBrent(n uint64) uint64 {
+	if n%2 == 0 {
+		return 2
+	}
+
+	for c := uint64(1); ; c++ {
+		// для каждого c все начинается заново: если q хоть раз обнулился по
+		// модулю n, со старым q любое следующее c сразу получит gcd == n
+		if g := pollardBrentTry(n, c%n); g != n {
+			return g
+		}
+		// не повезло с c, пробуем другой многочлен
+	}
+}
+
+// pollardBrentTry одна попытка pollardBrent с многочленом x^2 + c. если
+// делитель не нашелся, возвращает n
+func pollardBrentTry(n, c uint64) uint64 {
+	const m = 128
+	f := func(x uint64) uint64 {
+		return addMod(mulMod(x, x, n), c, n)
+	}
+
+	y, x, ys := uint64(2), uint64(0), uint64(0)
+	g, q := uint64(1), uint64(1)
+	for r := 1; g == 1; r *= 2 {
+		x = y
+		for i := 0; i < r; i++ {
+			y = f(y)
+		}
 
-			if g.Cmp(value_1) != 0 {
-				flag = false
+		for k := 0; k < r && g == 1; k += m {
+			ys = y
+			for i := 0; i < m && i < r-k; i++ {
+				y = f(y)
+				q = mulMod(q, absDiff(x, y), n)
 			}
+			g = gcd(q, n)
+		}
+	}
+
+	if g == n {
+		// произведение разностей обнулилось, ищем делитель по одному шагу
+		for g = 1; g == 1; {
+			ys = f(ys)
+			g = gcd(absDiff(x, ys), n)
 		}
-		i = i + 1
 	}
 
-	p1 = big.NewInt(0).Set(g)
-	p2 = big.NewInt(0).Div(what, g)
+	return g
+}
+
+func mulMod(a, b, n uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	_, rem := bits.Div64(hi%n, lo, n)
+	return rem
+}
 
-	if p1.Cmp(p2) == 1 {
-		p1, p2 = p2, p1
+func addMod(a, b, n uint64) uint64 {
+	sum, carry := bits.Add64(a, b, 0)
+	if carry != 0 || sum >= n {
+		sum -= n
 	}
+	return sum
+}
 
-	return
+func absDiff(a, b uint64) uint64 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+func gcd(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// pollardBrentBig то же самое, что и pollardBrent, но для чисел больше 2^64
+func pollardBrentBig(n *big.Int) *big.Int {
+	two := big.NewInt(2)
+	if n.Bit(0) == 0 {
+		return two
+	}
+
+	one := big.NewInt(1)
+	for c := big.NewInt(1); ; c.Add(c, one) {
+		if g := pollardBrentBigTry(n, c); g.Cmp(n) != 0 {
+			return g
+		}
+	}
+}
+
+// pollardBrentBigTry то же самое, что и pollardBrentTry
+func pollardBrentBigTry(n, c *big.Int) *big.Int {
+	const m = 128
+	one := big.NewInt(1)
+	f := func(x *big.Int) *big.Int {
+		x.Mul(x, x)
+		x.Add(x, c)
+		return x.Mod(x, n)
+	}
+
+	y, x, ys := big.NewInt(2), new(big.Int), new(big.Int)
+	g, q, diff := big.NewInt(1), big.NewInt(1), new(big.Int)
+	for r := 1; g.Cmp(one) == 0; r *= 2 {
+		x.Set(y)
+		for i := 0; i < r; i++ {
+			f(y)
+		}
+
+		for k := 0; k < r && g.Cmp(one) == 0; k += m {
+			ys.Set(y)
+			for i := 0; i < m && i < r-k; i++ {
+				f(y)
+				q.Mul(q, diff.Abs(diff.Sub(x, y)))
+				q.Mod(q, n)
+			}
+			g.GCD(nil, nil, q, n)
+		}
+	}
+
+	if g.Cmp(n) == 0 {
+		for g.SetInt64(1); g.Cmp(one) == 0; {
+			f(ys)
+			g.GCD(nil, nil, diff.Abs(diff.Sub(x, ys)), n)
+		}
+	}
+
+	return g
 }
 
+// makeGAB выбирает случайное b и считает g_b и g_ab. b выбирается заново, пока
+// g_b не пройдет те же проверки, что и g_a
 func makeGAB(g int32, g_a, dh_prime *big.Int) (b, g_b, g_ab *big.Int) {
-	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
-	rndmax := big.NewInt(0).SetBit(big.NewInt(0), 2048, 1)
-	b = big.NewInt(0).Rand(rnd, rndmax)
-	g_b = big.NewInt(0).Exp(big.NewInt(int64(g)), b, dh_prime)
+	for {
+		b = big.NewInt(0).SetBytes(dry.RandomBytes(dhPrimeBits / 8))
+		g_b = big.NewInt(0).Exp(big.NewInt(int64(g)), b, dh_prime)
+		if checkDHValue(g_b, dh_prime) == nil {
+			break
+		}
+	}
 	g_ab = big.NewInt(0).Exp(g_a, b, dh_prime)
 
 	return
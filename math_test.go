@@ -1,8 +1,16 @@
 package mtproto
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
 	"math/big"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xelaj/go-dry"
+
+	ige "github.com/xelaj/mtproto/aes_ige"
 )
 
 func TestSplitPQ(t *testing.T) {
@@ -12,12 +20,114 @@ func TestSplitPQ(t *testing.T) {
 		{big.NewInt(1724114033281923457), big.NewInt(1229739323), big.NewInt(1402015859)},
 		{big.NewInt(378221), big.NewInt(613), big.NewInt(617)},
 		{big.NewInt(15), big.NewInt(3), big.NewInt(5)},
+		{big.NewInt(9), big.NewInt(3), big.NewInt(3)},
+		{big.NewInt(4), big.NewInt(2), big.NewInt(2)},
 	}
 
 	for _, c := range cases {
-		r1, r2 := splitPQ(c.pq)
-		if c.p.Cmp(r1) != 0 || c.q.Cmp(r2) != 0 {
-			t.Errorf("PQ mismatch: %v %v, want %v %v", r1, r2, c.p, c.q)
+		r1, r2, err := splitPQ(c.pq)
+		if err != nil || c.p.Cmp(r1) != 0 || c.q.Cmp(r2) != 0 {
+			t.Errorf("PQ mismatch: %v %v (%v), want %v %v", r1, r2, err, c.p, c.q)
 		}
 	}
+
+	_, _, err := splitPQ(big.NewInt(1402015859))
+	assert.Error(t, err, "prime can't be split")
+	_, _, err = splitPQ(big.NewInt(1))
+	assert.Error(t, err)
+}
+
+func TestSplitPQRetry(t *testing.T) {
+	// для этих pq первый многочлен (c = 1) не находит делитель, и нужно
+	// начинать заново со следующим c
+	cases := []struct {
+		pq, p, q uint64
+	}{
+		{143, 11, 13},
+		{391, 17, 23},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.pq, pollardBrentTry(c.pq, 1))
+		n := new(big.Int).SetUint64(c.pq)
+		assert.Equal(t, 0, pollardBrentBigTry(n, big.NewInt(1)).Cmp(n))
+
+		p, q, err := splitPQ(n)
+		assert.NoError(t, err)
+		assert.Equal(t, c.p, p.Uint64())
+		assert.Equal(t, c.q, q.Uint64())
+
+		f := pollardBrentBig(n)
+		assert.True(t, f.Uint64() == c.p || f.Uint64() == c.q, f.String())
+	}
+}
+
+func TestSplitPQProperties(t *testing.T) {
+	cases := []struct {
+		bits, count int
+	}{
+		{16, 20},
+		{32, 20}, // как у настоящих pq
+		{34, 3},  // pq больше 2^64, раскладывается через big.Int
+	}
+
+	for _, c := range cases {
+		for i := 0; i < c.count; i++ {
+			p1, err := rand.Prime(rand.Reader, c.bits)
+			assert.NoError(t, err)
+			p2, err := rand.Prime(rand.Reader, c.bits)
+			assert.NoError(t, err)
+			pq := new(big.Int).Mul(p1, p2)
+
+			p, q, err := splitPQ(pq)
+			if !assert.NoError(t, err, pq.String()) {
+				continue
+			}
+			assert.Equal(t, pq, new(big.Int).Mul(p, q), pq.String())
+			assert.True(t, p.Cmp(q) <= 0, pq.String())
+			assert.True(t, p.ProbablyPrime(20), pq.String())
+			assert.True(t, q.ProbablyPrime(20), pq.String())
+		}
+	}
+}
+
+func BenchmarkSplitPQ(b *testing.B) {
+	// pq из примера в документации
+	pq := big.NewInt(0x17ED48941A08F981)
+	for i := 0; i < b.N; i++ {
+		_, _, err := splitPQ(pq)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestRSAPadEncrypt(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	data := []byte("p_q_inner_data_dc")
+	encrypted, err := rsaPadEncrypt(data, &key.PublicKey)
+	assert.NoError(t, err)
+	assert.Len(t, encrypted, rsaPadResultLen)
+
+	// расшифровываем так, как это делает сервер
+	z := dry.BigIntBytes(new(big.Int).Exp(new(big.Int).SetBytes(encrypted), key.D, key.N), rsaPadResultLen*8)
+	tempKeyXor, aesEncrypted := z[:rsaPadTempKeyLen], z[rsaPadTempKeyLen:]
+	tempKey := sha256.Sum256(aesEncrypted)
+	xor(tempKey[:], tempKeyXor)
+
+	dataWithHash, err := ige.DecryptIGE(aesEncrypted, tempKey[:], make([]byte, rsaPadTempKeyLen))
+	assert.NoError(t, err)
+
+	dataWithPadding := make([]byte, rsaPadDataLen)
+	for i := range dataWithPadding {
+		dataWithPadding[i] = dataWithHash[rsaPadDataLen-1-i]
+	}
+	assert.Equal(t, data, dataWithPadding[:len(data)])
+	hash := sha256.Sum256(append(tempKey[:], dataWithPadding...))
+	assert.Equal(t, hash[:], dataWithHash[rsaPadDataLen:])
+
+	_, err = rsaPadEncrypt(make([]byte, rsaPadMaxDataLen+1), &key.PublicKey)
+	assert.Error(t, err)
 }
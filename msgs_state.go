@@ -0,0 +1,206 @@
+package mtproto
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/xelaj/mtproto/serialize"
+	"github.com/xelaj/mtproto/utils"
+)
+
+// https://core.telegram.org/mtproto/service_messages_about_messages
+// после короткого обрыва связи ответы на запросы могут потеряться. сервер
+// сообщает, что он знает о наших сообщениях (msgs_all_info, msgs_detailed_info),
+// и спрашивает нас о своих (msgs_state_req, msg_resend_req). сами мы
+// спрашиваем сервер о запросах, на которые долго нет ответа
+
+// состояние сообщения в msgs_state_info и msgs_all_info, младшие три бита
+const (
+	msgStateTooLow      = 1 // msg_id слишком маленький, сообщение могли уже забыть
+	msgStateNotReceived = 2 // сообщение не приходило
+	msgStateTooHigh     = 3 // msg_id слишком большой, сообщение еще не могло прийти
+	msgStateReceived    = 4 // сообщение получено
+
+	msgStateMask = 7
+)
+
+const (
+	// через сколько без ответа спрашиваем сервер, что стало с запросом
+	requestStateTimeout = 30 * time.Second
+	// как часто ищем такие запросы
+	requestStateCheckInterval = 10 * time.Second
+)
+
+// messagesState возвращает состояние входящих сообщений, по байту на каждое
+func (m *MTProto) messagesState(ids []int64) []byte {
+	now := time.Now().Add(m.seq.TimeOffset())
+
+	info := make([]byte, len(ids))
+	for i, id := range ids {
+		info[i] = m.replay.state(id, now)
+	}
+
+	return info
+}
+
+// processStateRequest отвечает на msgs_state_req сервера
+func (m *MTProto) processStateRequest(msgID int64, req *serialize.MsgsStateReq) {
+	m.sendAsync(&serialize.MsgsStateInfo{
+		ReqMsgId: msgID,
+		Info:     m.messagesState(req.MsgIds),
+	})
+}
+
+// processResendRequest отправляет заново сообщения, которые сервер не получил.
+// если какое-то сообщение мы уже забыли, то по правилам отвечаем на запрос
+// так же, как на msgs_state_req
+func (m *MTProto) processResendRequest(msgID int64, req *serialize.MsgResendReq) {
+	forgotten := false
+	for _, id := range req.MsgIds {
+		item := m.sendQueue.sent(id)
+		if item == nil {
+			forgotten = true
+			continue
+		}
+		m.sendQueue.push(item)
+	}
+
+	if forgotten {
+		m.processStateRequest(msgID, &serialize.MsgsStateReq{MsgIds: req.MsgIds})
+	}
+}
+
+// processMessageState разбирает, что сервер знает о нашем сообщении. если
+// сервер его не получил, а мы все еще ждем ответа, запрос отправляется заново
+func (m *MTProto) processMessageState(msgID int64, state byte) {
+	switch state & msgStateMask {
+	case msgStateTooLow, msgStateNotReceived, msgStateTooHigh:
+		m.resendMessage(msgID)
+	}
+}
+
+// processAllInfo обрабатывает msgs_all_info, которое сервер присылает сам
+func (m *MTProto) processAllInfo(info *serialize.MsgsAllInfo) {
+	for i, id := range info.MsgIds {
+		if i >= len(info.Info) {
+			break
+		}
+		m.processMessageState(id, info.Info[i])
+	}
+}
+
+// processDetailedInfo обрабатывает msgs_detailed_info и msgs_new_detailed_info:
+// сервер сообщает, что ответ на запрос reqMsgID (в msgs_new_detailed_info его
+// нет, тогда 0) уже отправлен в сообщении answerMsgID. если ответ до нас не
+// дошел и все еще нужен, просим отправить его заново, иначе просто подтверждаем
+func (m *MTProto) processDetailedInfo(reqMsgID, answerMsgID int64) {
+	now := time.Now().Add(m.seq.TimeOffset())
+	received := m.replay.state(answerMsgID, now) == msgStateReceived
+	if received || (reqMsgID != 0 && !m.isPending(reqMsgID)) {
+		m.sendQueue.ack(answerMsgID)
+		return
+	}
+
+	m.sendAsync(&serialize.MsgResendReq{MsgIds: []int64{answerMsgID}})
+}
+
+// isPending возвращает true, если на запрос msgID еще ждем ответа
+func (m *MTProto) isPending(msgID int64) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	_, ok := m.responseChannels[msgID]
+	return ok
+}
+
+// sendAsync отправляет служебное сообщение, не блокируя того, кто его отправил
+// (обычно это горутина чтения ответов)
+func (m *MTProto) sendAsync(msg serialize.TL) {
+	go func() {
+		_, _, err := m.sendPacketNew(msg)
+		if err != nil {
+			m.reportError(errors.Wrap(err, "sending "+reflect.TypeOf(msg).String()))
+		}
+	}()
+}
+
+// stalledRequests возвращает запросы, отправленные раньше deadline (по
+// времени сервера), на которые до сих пор нет ответа
+func (m *MTProto) stalledRequests(deadline time.Time) []int64 {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var ids []int64
+	for id := range m.responseChannels {
+		if utils.MessageIdTime(id).Before(deadline) {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// checkStalledRequests спрашивает сервер о запросах, на которые долго нет
+// ответа, и отправляет заново те, которые до сервера не дошли
+func (m *MTProto) checkStalledRequests(ctx context.Context) error {
+	now := time.Now().Add(m.seq.TimeOffset())
+	ids := m.stalledRequests(now.Add(-requestStateTimeout))
+	if len(ids) == 0 {
+		return nil
+	}
+
+	resp, reqID, err := m.sendPacketNew(&serialize.MsgsStateReq{MsgIds: ids})
+	if err != nil {
+		return errors.Wrap(err, "sending msgs_state_req")
+	}
+
+	var data serialize.TL
+	select {
+	case data = <-resp:
+	case <-ctx.Done():
+		m.writeToPending(reqID, &serialize.Null{})
+		return ctx.Err()
+	case <-time.After(requestStateTimeout):
+		m.writeToPending(reqID, &serialize.Null{})
+		return errors.New("timeout waiting for msgs_state_info")
+	}
+
+	info, ok := data.(*serialize.MsgsStateInfo)
+	if !ok {
+		if _, ok := data.(*serialize.ErrorSessionConfigsChanged); ok {
+			// соединение или сессия поменялись, запросы и так отправятся заново
+			return nil
+		}
+		return errors.New("got invalid response type: " + reflect.TypeOf(data).String())
+	}
+
+	for i, id := range ids {
+		if i >= len(info.Info) {
+			break
+		}
+		m.processMessageState(id, info.Info[i])
+	}
+
+	return nil
+}
+
+// startCheckingRequests периодически проверяет, не потерялись ли запросы
+func (m *MTProto) startCheckingRequests(ctx context.Context) {
+	ticker := time.NewTicker(requestStateCheckInterval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				err := m.checkStalledRequests(ctx)
+				if err != nil && ctx.Err() == nil {
+					m.reportError(errors.Wrap(err, "checking stalled requests"))
+				}
+			}
+		}
+	}()
+}
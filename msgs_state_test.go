@@ -0,0 +1,91 @@
+package mtproto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/xelaj/mtproto/serialize"
+	"github.com/xelaj/mtproto/utils"
+)
+
+func TestMsgsStateEncoding(t *testing.T) {
+	info := &serialize.MsgsAllInfo{MsgIds: []int64{1, 2, 3}, Info: []byte{1, 2, 4}}
+
+	d := serialize.NewDecoder(info.Encode())
+	decoded, ok := d.PopObj().(*serialize.MsgsAllInfo)
+	assert.NoError(t, d.Err())
+	assert.True(t, ok)
+	assert.Equal(t, info, decoded)
+}
+
+func TestReplayWindowState(t *testing.T) {
+	w := newReplayWindow()
+	now := time.Now()
+	msgID := func(at time.Time) int64 {
+		return utils.GenerateMessageId(time.Until(at)) | 1
+	}
+
+	received := msgID(now)
+	assert.NoError(t, w.check(received, now))
+
+	assert.Equal(t, byte(msgStateReceived), w.state(received, now))
+	assert.Equal(t, byte(msgStateNotReceived), w.state(msgID(now.Add(-time.Second)), now))
+	assert.Equal(t, byte(msgStateTooLow), w.state(msgID(now.Add(-maxMessageAge-time.Minute)), now))
+	assert.Equal(t, byte(msgStateTooHigh), w.state(msgID(now.Add(maxMessageFuture+time.Minute)), now))
+}
+
+func TestProcessResendRequest(t *testing.T) {
+	m := newTestMTProto()
+
+	items := []*queuedMessage{
+		m.newQueuedMessage(&PingParams{PingID: 1}, true),
+		m.newQueuedMessage(&serialize.MsgsAck{MsgIds: []int64{1}}, false),
+	}
+	m.sendQueue.rememberSent(items, time.Now())
+
+	// ack не content-related, его не помним
+	assert.Nil(t, m.sendQueue.sent(items[1].msgID))
+
+	m.processResendRequest(1, &serialize.MsgResendReq{MsgIds: []int64{items[0].msgID}})
+	resent := m.sendQueue.take()
+	assert.Len(t, resent, 1)
+	assert.Equal(t, items[0].msgID, resent[0].msgID)
+	assert.Equal(t, items[0].seqNo, resent[0].seqNo)
+	assert.Equal(t, items[0].body, resent[0].body)
+}
+
+func TestProcessMessageState(t *testing.T) {
+	m := newTestMTProto()
+	lost := make(chan serialize.TL, 1)
+	delivered := make(chan serialize.TL, 1)
+	m.responseChannels[4] = lost
+	m.responseChannels[8] = delivered
+
+	m.processAllInfo(&serialize.MsgsAllInfo{
+		MsgIds: []int64{4, 8},
+		Info:   []byte{msgStateNotReceived, msgStateReceived | 32},
+	})
+
+	// запрос, который не дошел, отправится заново, второй ждет ответа дальше
+	assert.IsType(t, &serialize.ErrorSessionConfigsChanged{}, <-lost)
+	assert.True(t, m.isPending(8))
+	assert.Empty(t, delivered)
+}
+
+func TestProcessDetailedInfo(t *testing.T) {
+	m := newTestMTProto()
+	m.seq.SetTimeOffset(0)
+
+	answer := utils.GenerateMessageId(0) | 1
+	assert.NoError(t, m.checkMessageID(answer, nil))
+
+	// ответ уже приходил, достаточно подтвердить его
+	m.processDetailedInfo(0, answer)
+	assert.Equal(t, []int64{answer}, m.sendQueue.takeAcks())
+
+	// запрос отменили, ответ больше не нужен
+	m.processDetailedInfo(4, answer+4)
+	assert.Equal(t, []int64{answer + 4}, m.sendQueue.takeAcks())
+}
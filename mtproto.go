@@ -3,7 +3,9 @@ package mtproto
 import (
 	"context"
 	"crypto/rsa"
+	"fmt"
 	"net"
+	"os"
 	"reflect"
 	"sync"
 	"time"
@@ -12,8 +14,8 @@ import (
 	"github.com/k0kubun/pp"
 	"github.com/pkg/errors"
 	"github.com/xelaj/errs"
-	"github.com/xelaj/go-dry"
 
+	"github.com/xelaj/mtproto/keys"
 	"github.com/xelaj/mtproto/serialize"
 	"github.com/xelaj/mtproto/utils"
 )
@@ -25,36 +27,86 @@ const (
 
 type MTProto struct {
 	addr         string
-	conn         *net.TCPConn
+	conn         net.Conn
+	dialer       DialFunc
 	stopRoutines context.CancelFunc // остановить ping, read, и подобные горутины
 
+	// блокируется, пока меняются conn, transport и stopRoutines при переподключении
+	connMutex sync.Mutex
+
+	// состояние соединения и все, что нужно для переподключения
+	state            ConnState
+	stateMutex       sync.Mutex
+	onStateChange    func(ConnState)
+	reconnectEnabled bool
+	backoff          backoff
+	// закрывается в Disconnect, останавливает переподключение. меняется под
+	// stateMutex, читать только через closedChan
+	closed chan struct{}
+
+	// сюда уходят ошибки из фоновых горутин
+	errorHandler func(error)
+
+	// протокол упаковки пакетов, задается в конфиге
+	transportMode TransportMode
+	transport     Transport
+	obfuscated    bool
+
+	// адрес и секрет MTProxy, если подключаемся через него
+	proxyAddr   string
+	proxySecret *ProxySecret
+	dcID        int
+	testServer  bool
+
+	// адрес websocket сервера, если подключаемся через websocket
+	webSocketURL string
+
 	// ключ авторизации. изменять можно только через setAuthKey
 	authKey []byte
 
 	// хеш ключа авторизации. изменять можно только через setAuthKey
 	authKeyHash []byte
 
+	// постоянный ключ авторизации, если включен PFS. тогда authKey это
+	// временный ключ, привязанный к постоянному
+	permAuthKey      []byte
+	pfs              bool
+	tempKeyTTL       time.Duration
+	tempKeyExpiresAt time.Time
+
 	// соль сессии
 	serverSalt int64
-	encrypted  bool
-	sessionId  int64
+	// будущие соли, на которые соль переключается сама
+	salts saltManager
+	// есть ли ключ авторизации. меняется под mutex
+	encrypted bool
+	sessionId int64
 
 	// общий мьютекс
 	mutex *sync.Mutex
 
+	// очередь зашифрованных сообщений, которые ждут отправки
+	sendQueue *sendQueue
+	// запросы больше этого размера сжимаются gzip'ом, 0 если не сжимаем
+	gzipThreshold int
+
 	msgsIdToResp  map[int64]chan serialize.TL
 	idsToAck      map[int64]struct{}
 	idsToAckMutex sync.Mutex
 
 	// каналы, которые ожидают ответа rpc. ответ записывается в канал и удаляется
 	responseChannels map[int64]chan serialize.TL
+	// каналы, которые ждут ответа на destroy_session, по session_id
+	destroySessionChannels map[int64]chan serialize.TL
 
 	// идентификаторы сообщений, нужны что бы посылать и принимать сообщения.
 	seqNo int32
 	msgId int64
 
-	// не знаю что это но как-то используется
-	lastSeqNo int32
+	// выдает msg_id и seqno исходящим сообщениям
+	seq *utils.MessageSequence
+	// помнит msg_id входящих сообщений, что бы не принимать их повторно
+	replay *replayWindow
 
 	// пока непонятно для чего, кажется это нужно клиенту конкретно телеграма
 	dclist map[int32]string
@@ -62,31 +114,188 @@ type MTProto struct {
 	// шина сообщений, используется для разных нотификаций, описанных в константах нотификации
 	bus bus.Bus
 
-	// путь до файла токена сессии.
-	tokensStorage string
+	// где хранится сессия между запусками
+	sessionStorage SessionStorage
 
-	// один из публичных ключей telegram. нужен только для создания сессии.
-	publicKey *rsa.PublicKey
+	// состояние, которое не нужно самому протоколу, но сохраняется вместе с
+	// сессией (см. Session)
+	mainDC       bool
+	layer        int
+	userID       int64
+	updatesState UpdatesState
+
+	// публичные ключи telegram по отпечаткам. нужны только для создания сессии,
+	// из них берется тот, который предложит сервер
+	publicKeys keys.Index
 
 	// serviceChannel нужен только на время создания ключей, т.к. это
 	// не RpcResult, поэтому все данные отдаются в один поток без
-	// привязки к MsgID
+	// привязки к MsgID. serviceModeActivated меняется под mutex, см. setServiceMode
 	serviceChannel       chan serialize.TL
 	serviceModeActivated bool
 }
 
 type Config struct {
+	// AuthKeyFile путь до json файла с сессией, используется, если не задан
+	// SessionStorage. если не задано ни то, ни другое, сессия хранится в памяти
 	AuthKeyFile string
 	ServerHost  string
 	PublicKey   *rsa.PublicKey
 	AppID       int
 	AppHash     string
+
+	// PublicKeys, как и PublicKey, дополняют встроенные ключи telegram (ключи
+	// боевых и тестовых серверов уже встроены, см. пакет keys). при обмене
+	// ключами берется тот, чей отпечаток пришлет сервер
+	PublicKeys []*rsa.PublicKey
+
+	// SessionStorage хранилище сессии, например NewEncryptedFileSession или свое
+	// на основе хранилища секретов
+	SessionStorage SessionStorage
+
+	// Transport протокол упаковки пакетов, по умолчанию intermediate
+	Transport TransportMode
+	// Obfuscated включает обфускацию соединения (obfuscated2), что бы его
+	// нельзя было отличить от случайного потока байт
+	Obfuscated bool
+
+	// ProxyHost адрес MTProxy сервера. если задан, то все соединения идут через
+	// него, обфускация включается автоматически
+	ProxyHost string
+	// ProxySecret секрет MTProxy в hex или base64. для секретов dd и ee
+	// транспорт принудительно меняется на padded intermediate
+	ProxySecret string
+	// DcID номер датацентра, к которому прокси должен нас подключить. он же
+	// передается серверу при создании ключа
+	DcID int
+	// TestServer нужно указать, если подключаемся к тестовым серверам, от этого
+	// зависит номер датацентра при создании ключа
+	TestServer bool
+
+	// Dialer открывает соединение до сервера (или до MTProxy). по умолчанию
+	// обычное tcp соединение, для SOCKS5 и HTTP прокси см. SOCKS5Dialer и
+	// HTTPConnectDialer
+	Dialer DialFunc
+
+	// WebSocketURL адрес websocket сервера (например wss://venus.web.telegram.org/apiws).
+	// если задан, то соединение идет через websocket вместо tcp, обфускация
+	// включается автоматически. с MTProxy не совместим
+	WebSocketURL string
+
+	// OnStateChange вызывается при каждом изменении состояния соединения
+	OnStateChange func(ConnState)
+	// DisableReconnect отключает автоматическое переподключение. при обрыве
+	// все ожидающие ответа запросы вернут ошибку
+	DisableReconnect bool
+	// ReconnectMinDelay задержка перед первой попыткой переподключения, каждая
+	// следующая ждет в два раза дольше, но не больше ReconnectMaxDelay.
+	// по умолчанию 500ms и 30s
+	ReconnectMinDelay time.Duration
+	ReconnectMaxDelay time.Duration
+	// ReconnectJitter доля, на которую задержка случайно отклоняется (0..1),
+	// по умолчанию 0.2
+	ReconnectJitter float64
+
+	// PFS включает perfect forward secrecy: трафик шифруется временным ключом,
+	// который привязывается к постоянному и заменяется новым перед истечением
+	PFS bool
+	// TempKeyTTL время жизни временного ключа, по умолчанию сутки
+	TempKeyTTL time.Duration
+
+	// GzipThreshold запросы больше этого размера (в байтах) сжимаются в
+	// gzip_packed, если это уменьшает их размер. по умолчанию 512
+	GzipThreshold int
+	// DisableGzip отключает сжатие запросов
+	DisableGzip bool
+
+	// ErrorHandler получает ошибки, которые случились в фоне (битые пакеты от
+	// сервера, ошибки обработки ответов и т.п.). по умолчанию ошибки пишутся в stderr
+	ErrorHandler func(error)
 }
 
 func NewMTProto(c Config) (*MTProto, error) {
 	m := new(MTProto)
-	m.tokensStorage = c.AuthKeyFile
+	m.sessionStorage = c.SessionStorage
+	if m.sessionStorage == nil {
+		m.sessionStorage = NewMemorySession()
+		if c.AuthKeyFile != "" {
+			m.sessionStorage = NewFileSession(c.AuthKeyFile)
+		}
+	}
+
+	m.transportMode = c.Transport
+	m.obfuscated = c.Obfuscated
+	m.dcID = c.DcID
+	m.testServer = c.TestServer
+	m.dialer = c.Dialer
+	if m.dialer == nil {
+		m.dialer = defaultDialer()
+	}
+	if c.ProxyHost != "" {
+		var err error
+		m.proxySecret, err = ParseProxySecret(c.ProxySecret)
+		if err != nil {
+			return nil, errors.Wrap(err, "parsing proxy secret")
+		}
 
+		m.proxyAddr = c.ProxyHost
+		m.transportMode = m.proxySecret.transportMode(m.transportMode)
+		m.obfuscated = true
+	}
+	if c.WebSocketURL != "" {
+		if c.ProxyHost != "" {
+			return nil, errors.New("websocket can't be used with MTProxy")
+		}
+
+		m.webSocketURL = c.WebSocketURL
+		m.obfuscated = true
+	}
+	if m.transportMode == TransportHTTP && (m.obfuscated || m.webSocketURL != "") {
+		return nil, errors.New("http transport can't be obfuscated or used with MTProxy or websocket")
+	}
+	m.onStateChange = c.OnStateChange
+	m.errorHandler = c.ErrorHandler
+	m.reconnectEnabled = !c.DisableReconnect
+	m.backoff = backoff{
+		min:    c.ReconnectMinDelay,
+		max:    c.ReconnectMaxDelay,
+		jitter: c.ReconnectJitter,
+	}
+	if m.backoff.min == 0 {
+		m.backoff.min = defaultReconnectMinDelay
+	}
+	if m.backoff.max == 0 {
+		m.backoff.max = defaultReconnectMaxDelay
+	}
+	if m.backoff.jitter == 0 {
+		m.backoff.jitter = defaultReconnectJitter
+	}
+
+	m.sessionId = utils.GenerateSessionID()
+	m.seq = utils.NewMessageSequence()
+	m.replay = newReplayWindow()
+	m.serviceChannel = make(chan serialize.TL)
+	m.publicKeys = keys.NewIndex(append(c.PublicKeys, c.PublicKey)...)
+	m.responseChannels = make(map[int64]chan serialize.TL)
+	m.destroySessionChannels = make(map[int64]chan serialize.TL)
+	m.msgsIdToResp = make(map[int64]chan serialize.TL)
+	m.mutex = &sync.Mutex{}
+	m.sendQueue = newSendQueue()
+	m.pfs = c.PFS
+	m.tempKeyTTL = c.TempKeyTTL
+	if m.tempKeyTTL == 0 {
+		m.tempKeyTTL = defaultTempKeyTTL
+	}
+	if !c.DisableGzip {
+		m.gzipThreshold = c.GzipThreshold
+		if m.gzipThreshold == 0 {
+			m.gzipThreshold = defaultGzipThreshold
+		}
+	}
+	m.closed = make(chan struct{})
+	m.resetAck()
+
+	// сессия загружается в самом конце: ей нужны уже созданные seq и mutex
 	err := m.LoadSession()
 	if err == nil {
 		m.encrypted = true
@@ -97,108 +306,273 @@ func NewMTProto(c Config) (*MTProto, error) {
 		return nil, errors.Wrap(err, "loading session")
 	}
 
-	m.sessionId = utils.GenerateSessionID()
-	m.serviceChannel = make(chan serialize.TL)
-	m.publicKey = c.PublicKey
-	m.responseChannels = make(map[int64]chan serialize.TL)
-	m.resetAck()
-
 	return m, nil
 }
 
 func (m *MTProto) CreateConnection() error {
-	// connect
-	tcpAddr, err := net.ResolveTCPAddr("tcp", m.addr)
+	m.stateMutex.Lock()
+	select {
+	case <-m.closed:
+		// после Disconnect нужен новый канал. горутины, которые остались от
+		// прошлого соединения, ждут старый, он уже закрыт
+		m.closed = make(chan struct{})
+	default:
+	}
+	m.stateMutex.Unlock()
+
+	m.setState(ConnStateConnecting)
+
+	err := m.connect()
+	if err == nil {
+		err = m.startRoutines()
+	}
 	if err != nil {
-		return errors.Wrap(err, "resolving tcp")
+		m.closeConnection()
+		m.setState(ConnStateDisconnected)
+		return err
+	}
+
+	m.setState(ConnStateConnected)
+	return nil
+}
+
+// connect открывает новое соединение и создает для него транспорт
+func (m *MTProto) connect() error {
+	addr := m.addr
+	if m.proxyAddr != "" {
+		addr = m.proxyAddr
+	}
+
+	if m.transportMode == TransportHTTP {
+		m.setConnection(nil, newHTTPTransport(addr, m.dialer))
+		return nil
+	}
+
+	// connect
+	var conn net.Conn
+	var err error
+	if m.webSocketURL != "" {
+		conn, err = dialWebSocket(context.Background(), m.dialer, m.webSocketURL)
+	} else {
+		conn, err = m.dialer(context.Background(), "tcp", addr)
 	}
-	m.conn, err = net.DialTCP("tcp", nil, tcpAddr)
 	if err != nil {
-		return errors.Wrap(err, "dialing tcp")
+		return errors.Wrap(err, "dialing")
 	}
+	// если дальше что-то пойдет не так, соединение надо закрыть
+	success := false
+	defer func() {
+		if !success {
+			conn.Close()
+		}
+	}()
+
+	var secret []byte
+	if m.proxySecret != nil {
+		secret = m.proxySecret.Key
+		if m.proxySecret.Type == ProxySecretFakeTLS {
+			conn, err = newFakeTLSConn(conn, m.proxySecret)
+			if err != nil {
+				return errors.Wrap(err, "making fake tls handshake")
+			}
+		}
+	}
+
+	// https://core.telegram.org/mtproto/mtproto-transports
+	if m.obfuscated {
+		tag, err := m.transportMode.protocolTag()
+		if err != nil {
+			return errors.Wrap(err, "obfuscating connection")
+		}
 
-	// https://core.telegram.org/mtproto/mtproto-transports#intermediate
-	_, err = m.conn.Write([]byte{0xee, 0xee, 0xee, 0xee})
+		conn, err = newObfuscatedConn(conn, tag, secret, int16(m.dcID))
+		if err != nil {
+			return errors.Wrap(err, "obfuscating connection")
+		}
+	} else if header := m.transportMode.header(); header != nil {
+		_, err = conn.Write(header)
+		if err != nil {
+			return errors.Wrap(err, "writing transport header")
+		}
+	}
+
+	transport, err := NewTransport(m.transportMode, conn)
 	if err != nil {
-		return errors.Wrap(err, "writing first byte")
+		return errors.Wrap(err, "creating transport")
 	}
 
+	m.setConnection(conn, transport)
+	success = true
+	return nil
+}
+
+func (m *MTProto) setConnection(conn net.Conn, transport Transport) {
+	m.connMutex.Lock()
+	m.conn = conn
+	m.transport = transport
+	m.connMutex.Unlock()
+}
+
+func (m *MTProto) connection() (net.Conn, Transport) {
+	m.connMutex.Lock()
+	defer m.connMutex.Unlock()
+	return m.conn, m.transport
+}
+
+// startRoutines запускает чтение ответов и отправку сообщений, создает ключ авторизации, если его
+// нет, и запускает пинги
+func (m *MTProto) startRoutines() error {
 	ctx, cancelfunc := context.WithCancel(context.Background())
+	m.connMutex.Lock()
 	m.stopRoutines = cancelfunc
+	m.connMutex.Unlock()
 
 	// start reading responses from the server
 	m.startReadingResponses(ctx)
 
 	// get new authKey if need
-	if !m.encrypted {
+	m.mutex.Lock()
+	encrypted := m.encrypted
+	m.mutex.Unlock()
+	if !encrypted {
 		println("not encrypted, creating auth key")
-		err = m.makeAuthKey()
+		err := m.makeAuthKey()
 		if err != nil {
 			return errors.Wrap(err, "making auth key")
 		}
 	}
 
-	// start goroutines
-	m.msgsIdToResp = make(map[int64]chan serialize.TL)
-	m.mutex = &sync.Mutex{}
+	// start sending queued messages. только теперь, когда ключ точно есть:
+	// очередь все шифрует, и без ключа отправлять ее нечем
+	m.startSending(ctx)
+
+	// temporary key for PFS, if there is no key yet or it expires soon
+	if m.pfs {
+		if time.Now().After(m.tempKeyRenewAt()) {
+			err := m.makeTempAuthKey()
+			if err != nil {
+				return errors.Wrap(err, "making temporary auth key")
+			}
+		}
+		m.startRenewingTempKey(ctx)
+	}
 
 	// start keepalive pinging
 	m.startPinging(ctx)
 
+	// keep server salt up to date
+	m.startRotatingSalts(ctx)
+
+	// ask server about requests without answer
+	m.startCheckingRequests(ctx)
+
+	if _, transport := m.connection(); transport != nil {
+		if t, ok := transport.(*httpTransport); ok {
+			m.longPollHTTP(ctx, t)
+		}
+	}
+
 	return nil
 }
 
 // отправить запрос
 func (m *MTProto) makeRequest(data serialize.TL) (serialize.TL, error) {
+	return m.makeRequestContext(context.Background(), data)
+}
+
+// makeRequestContext отправляет запрос и ждет ответ, пока не отменен ctx.
+// если ctx отменили, ответ больше не ждем, а сервер просим его не присылать
+func (m *MTProto) makeRequestContext(ctx context.Context, data serialize.TL) (serialize.TL, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	println("sending packet " + reflect.TypeOf(data).String())
 
-	resp, err := m.sendPacketNew(data)
+	resp, msgID, err := m.sendPacketNew(data)
 	if err != nil {
 		return nil, errors.Wrap(err, "sending message")
 	}
-	response := <-resp
+
+	var response serialize.TL
+	select {
+	case response = <-resp:
+	case <-ctx.Done():
+		m.dropRequest(msgID)
+		return nil, ctx.Err()
+	}
 
 	if _, ok := response.(*serialize.ErrorSessionConfigsChanged); ok {
 		// если пришел ответ типа badServerSalt, то отправляем данные заново
-		return m.makeRequest(data)
+		return m.makeRequestContext(ctx, data)
 	}
 	if e, ok := response.(*serialize.RpcError); ok {
 		return nil, RpcErrorToNative(e)
 	}
+	if e, ok := response.(*errorResponse); ok {
+		return nil, e.err
+	}
 
 	return response, nil
 }
 
 func (m *MTProto) Disconnect() error {
-	// stop all routines
-	m.stopRoutines()
-
-	err := m.conn.Close()
-	if err != nil {
-		return errors.Wrap(err, "closing TCP connection")
+	m.stateMutex.Lock()
+	select {
+	case <-m.closed:
+		m.stateMutex.Unlock()
+		return nil
+	default:
+		close(m.closed)
 	}
+	m.stateMutex.Unlock()
+	m.setState(ConnStateDisconnected)
+
+	// stop all routines and close connection
+	m.closeConnection()
 
-	// TODO: закрыть каналы
+	// ответов на висящие запросы уже не будет
+	m.failPending(ErrDisconnected)
 
 	// возвращаем в false, потому что мы теряем конфигурацию
 	// сессии, и можем ее потерять во время отключения.
+	m.mutex.Lock()
 	m.encrypted = false
+	m.mutex.Unlock()
 
 	return nil
 }
 
 // startPinging пингует сервер что все хорошо, клиент в сети
 // нужно просто запустить
+// если pong не пришел вовремя, соединение считается мертвым
 func (m *MTProto) startPinging(ctx context.Context) {
-	ticker := time.Tick(60 * time.Second)
+	ticker := time.NewTicker(pingInterval)
 	go func() {
+		defer ticker.Stop()
 		for {
 			select {
 			case <-ctx.Done():
 				return
-			case <-ticker:
-				_, err := m.Ping(0xCADACADA)
-				dry.PanicIfErr(err)
+			case <-ticker.C:
+				// если следующий пинг не дойдет, сервер сам закроет соединение
+				resp, _, err := m.sendPacketNew(&PingDelayDisconnectParams{
+					PingID:          0xCADACADA,
+					DisconnectDelay: int32(pingDisconnectDelay / time.Second),
+				})
+				if err != nil {
+					m.connectionLost(ctx, errors.Wrap(err, "sending ping"))
+					return
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-resp:
+				case <-time.After(pingTimeout):
+					m.connectionLost(ctx, errors.New("ping timeout"))
+					return
+				}
 			}
 		}
 	}()
@@ -212,24 +586,85 @@ func (m *MTProto) startReadingResponses(ctx context.Context) {
 				return
 			default:
 				data, err := m.readFromConn(ctx)
-				dry.PanicIfErr(err)
+				if err != nil {
+					m.connectionLost(ctx, err)
+					return
+				}
 
 				response, err := m.decodeRecievedData(data)
-				dry.PanicIfErr(err)
+				if err != nil {
+					m.processBrokenResponse(response, err)
+					m.reportError(errors.Wrap(err, "decoding response"))
+					continue
+				}
 
 				pp.Println("got", response)
 
-				if m.serviceModeActivated {
-					m.serviceChannel <- response
-				} else {
-					err = m.processResponse(int(m.msgId), int(m.seqNo), response)
-					dry.PanicIfErr(err)
+				if !IsPacketEncrypted(data) {
+					// без шифрования сервер отвечает только на обмен ключами
+					if !m.serviceMode() {
+						m.reportError(errors.New("unexpected unencrypted message: " + reflect.TypeOf(response).String()))
+						continue
+					}
+
+					select {
+					case m.serviceChannel <- response:
+					case <-ctx.Done():
+						return
+					}
+					continue
+				}
+
+				err = m.processResponse(int(m.msgId), int(m.seqNo), response)
+				if err != nil {
+					m.reportError(errors.Wrap(err, "processing response"))
 				}
 			}
 		}
 	}()
 }
 
+// reportError отдает ошибку из фоновой горутины в ErrorHandler
+func (m *MTProto) reportError(err error) {
+	if m.errorHandler != nil {
+		m.errorHandler(err)
+		return
+	}
+
+	fmt.Fprintln(os.Stderr, "mtproto:", err)
+}
+
+// processBrokenResponse разбирает то, что удалось декодировать из битого
+// сообщения: если понятно, на какой запрос был ответ, то запрос получает
+// ошибку вместо того, что бы ждать вечно
+func (m *MTProto) processBrokenResponse(data serialize.TL, err error) {
+	switch message := data.(type) {
+	case *serialize.MessageContainer:
+		// битое сообщение всегда последнее, все до него разобрались нормально
+		for i, item := range *message {
+			if i == len(*message)-1 {
+				m.processBrokenResponse(item.Msg, err)
+				break
+			}
+
+			processErr := m.processResponse(int(item.MsgID), int(item.SeqNo), item.Msg)
+			if processErr != nil {
+				m.reportError(errors.Wrap(processErr, "processing item in container"))
+			}
+		}
+
+	case *serialize.RpcResult:
+		if message.ReqMsgID == 0 {
+			return
+		}
+
+		writeErr := m.writeRPCResponse(int(message.ReqMsgID), &errorResponse{err: errors.Wrap(err, "decoding response")})
+		if writeErr != nil && !errs.IsNotFound(writeErr) {
+			m.reportError(writeErr)
+		}
+	}
+}
+
 func (m *MTProto) processResponse(msgId, seqNo int, data serialize.TL) error {
 	switch message := data.(type) {
 	case *serialize.MessageContainer:
@@ -242,21 +677,29 @@ func (m *MTProto) processResponse(msgId, seqNo int, data serialize.TL) error {
 		}
 
 	case *serialize.BadServerSalt:
-		m.serverSalt = message.NewSalt
+		// будущие соли, которые мы знаем, уже неправильные, их надо запросить заново
+		m.salts.set(nil)
+		m.setServerSalt(message.NewSalt)
 		err := m.SaveSession()
-		dry.PanicIfErr(err)
+		if err != nil {
+			return errors.Wrap(err, "saving session")
+		}
 
-		m.mutex.Lock() // что это?
-		for _, v := range m.responseChannels {
-			v <- &serialize.ErrorSessionConfigsChanged{}
+		m.replayPending()
+
+	case *serialize.BadMsgNotification:
+		err := m.processBadMsgNotification(int64(msgId), message)
+		if err != nil {
+			return errors.Wrap(err, "processing bad message notification")
 		}
-		m.mutex.Unlock() // что это?
 
 	case *serialize.NewSessionCreated:
 		pp.Println("session created")
-		m.serverSalt = message.ServerSalt
+		m.setServerSalt(message.ServerSalt)
 		err := m.SaveSession()
-		dry.PanicIfErr(err)
+		if err != nil {
+			return errors.Wrap(err, "saving session")
+		}
 
 	//case *serialize.Ping:
 	//	resp, err := m.makeRequest(&TL_Pong{MsgID: int64(msgId), PingID: message.PingID})
@@ -266,13 +709,52 @@ func (m *MTProto) processResponse(msgId, seqNo int, data serialize.TL) error {
 	//	}
 
 	case *serialize.Pong:
-		// игнорим, пришло и пришло, че бубнить то
+		// pong приходит не в RpcResult, но отвечает на конкретный ping
+		err := m.writeRPCResponse(int(message.MsgID), message)
+		if err != nil && !errs.IsNotFound(err) {
+			return errors.Wrap(err, "writing pong")
+		}
+
+	case *serialize.FutureSalts:
+		// future_salts тоже приходит без RpcResult
+		err := m.writeRPCResponse(int(message.ReqMsgID), message)
+		if err != nil && !errs.IsNotFound(err) {
+			return errors.Wrap(err, "writing future salts")
+		}
+
+	case serialize.DestroySessionRes:
+		err := m.writeDestroySessionResponse(message)
+		if err != nil && !errs.IsNotFound(err) {
+			return errors.Wrap(err, "writing destroy session response")
+		}
 
 	case *serialize.MsgsAck:
 		for _, id := range message.MsgIds {
 			m.gotAck(id)
 		}
 
+	case *serialize.MsgsStateReq:
+		m.processStateRequest(int64(msgId), message)
+
+	case *serialize.MsgResendReq:
+		m.processResendRequest(int64(msgId), message)
+
+	case *serialize.MsgsStateInfo:
+		// ответ на наш msgs_state_req, приходит без RpcResult
+		err := m.writeRPCResponse(int(message.ReqMsgId), message)
+		if err != nil && !errs.IsNotFound(err) {
+			return errors.Wrap(err, "writing msgs state info")
+		}
+
+	case *serialize.MsgsAllInfo:
+		m.processAllInfo(message)
+
+	case *serialize.MsgsDetailedInfo:
+		m.processDetailedInfo(message.MsgId, message.AnswerMsgId)
+
+	case *serialize.MsgsNewDetailedInfo:
+		m.processDetailedInfo(0, message.AnswerMsgId)
+
 	case *serialize.RpcResult:
 		pp.Println("rpc!!!")
 		obj := message.Obj
@@ -281,20 +763,18 @@ func (m *MTProto) processResponse(msgId, seqNo int, data serialize.TL) error {
 		}
 
 		err := m.writeRPCResponse(int(message.ReqMsgID), obj)
-		if err != nil {
+		// ответа уже не ждут, если запрос отменили
+		if err != nil && !errs.IsNotFound(err) {
 			return errors.Wrap(err, "writing RPC response")
 		}
 
 	default:
-		panic("this is not system message: " + reflect.TypeOf(message).String())
+		return errors.New("this is not system message: " + reflect.TypeOf(message).String())
 	}
 
 	if (seqNo & 1) != 0 {
-		resp, err := m.makeRequest(&serialize.MsgsAck{[]int64{int64(msgId)}})
-		pp.Println(resp)
-		if err != nil {
-			return errors.Wrap(err, "sending ack")
-		}
+		// ack уйдет вместе со следующей пачкой сообщений
+		m.sendQueue.ack(int64(msgId))
 	}
 
 	return nil
@@ -1,6 +1,8 @@
 package mtproto
 
 import (
+	"context"
+
 	"github.com/xelaj/mtproto/serialize"
 	"github.com/xelaj/mtproto/utils"
 )
@@ -32,16 +34,20 @@ func (m *MTProto) resetAck() {
 
 // получает текущий идентификатор сессии
 func (m *MTProto) GetSessionID() int64 {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
 	return m.sessionId
 }
 
-// Получает lastSeqNo
+// Получает seqno, который получит следующее служебное сообщение
 func (m *MTProto) GetLastSeqNo() int32 {
-	return m.lastSeqNo
+	return m.seq.LastSeqNo()
 }
 
 // получает соль
 func (m *MTProto) GetServerSalt() int64 {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
 	return m.serverSalt
 }
 
@@ -58,3 +64,9 @@ func (m *MTProto) SetAuthKey(key []byte) {
 func (m *MTProto) MakeRequest(msg serialize.TL) (serialize.TL, error) {
 	return m.makeRequest(msg)
 }
+
+// MakeRequestContext то же самое, что MakeRequest, но перестает ждать ответ,
+// когда ctx отменяется
+func (m *MTProto) MakeRequestContext(ctx context.Context, msg serialize.TL) (serialize.TL, error) {
+	return m.makeRequestContext(ctx, msg)
+}
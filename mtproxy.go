@@ -0,0 +1,356 @@
+package mtproto
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/xelaj/go-dry"
+)
+
+// ProxySecretType определяет, какой протокол ожидает MTProxy сервер
+// https://core.telegram.org/mtproto/mtproto-transports#transport-obfuscation
+type ProxySecretType int
+
+const (
+	// ProxySecretPlain обычный 16-байтовый секрет, obfuscated2 с любым транспортом
+	ProxySecretPlain ProxySecretType = iota
+	// ProxySecretPadded секрет с префиксом dd, только padded intermediate
+	ProxySecretPadded
+	// ProxySecretFakeTLS секрет с префиксом ee и доменом, соединение маскируется под TLS
+	ProxySecretFakeTLS
+)
+
+const proxySecretKeyLen = 16
+
+// ProxySecret это разобранный секрет MTProxy
+type ProxySecret struct {
+	Type ProxySecretType
+	Key  []byte
+	// Domain нужен только для fake TLS, кладется в SNI
+	Domain string
+}
+
+// ParseProxySecret разбирает секрет из ссылки на MTProxy. секрет может быть
+// записан в hex или base64 (обычном или url-safe)
+func ParseProxySecret(secret string) (*ProxySecret, error) {
+	data, err := decodeProxySecret(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(data) == proxySecretKeyLen:
+		return &ProxySecret{Type: ProxySecretPlain, Key: data}, nil
+
+	case len(data) == proxySecretKeyLen+1 && data[0] == 0xdd:
+		return &ProxySecret{Type: ProxySecretPadded, Key: data[1:]}, nil
+
+	case len(data) > proxySecretKeyLen+1 && data[0] == 0xee:
+		return &ProxySecret{
+			Type:   ProxySecretFakeTLS,
+			Key:    data[1 : proxySecretKeyLen+1],
+			Domain: string(data[proxySecretKeyLen+1:]),
+		}, nil
+
+	default:
+		return nil, errors.New("unknown proxy secret format")
+	}
+}
+
+func decodeProxySecret(secret string) ([]byte, error) {
+	secret = strings.TrimSpace(secret)
+	if data, err := hex.DecodeString(secret); err == nil {
+		return data, nil
+	}
+
+	for _, encoding := range []*base64.Encoding{
+		base64.RawURLEncoding,
+		base64.URLEncoding,
+		base64.RawStdEncoding,
+		base64.StdEncoding,
+	} {
+		if data, err := encoding.DecodeString(secret); err == nil {
+			return data, nil
+		}
+	}
+
+	return nil, errors.New("proxy secret is neither hex nor base64")
+}
+
+// transportMode возвращает протокол, который требует прокси. для обычного
+// секрета подходит любой, поэтому возвращается тот, что задан в конфиге
+func (s *ProxySecret) transportMode(fallback TransportMode) TransportMode {
+	if s.Type == ProxySecretPlain {
+		return fallback
+	}
+
+	return TransportPaddedIntermediate
+}
+
+//----------------------------------------------------------------------------
+
+// https://core.telegram.org/mtproto/mtproto-transports#transport-obfuscation
+// fake TLS не документирован, сделано по аналогии с официальными клиентами:
+// отправляется ClientHello, у которого поле random это HMAC от секрета,
+// сервер отвечает ServerHello, подписанным так же, а дальше все данные
+// ходят внутри TLS записей с типом application data.
+
+const (
+	tlsRecordHeaderLen = 5
+	// максимальный размер данных в одной TLS записи
+	tlsMaxRecordLen = 1 << 14
+
+	tlsRecordChangeCipherSpec = 0x14
+	tlsRecordHandshake        = 0x16
+	tlsRecordApplicationData  = 0x17
+
+	// где лежит поле random в ClientHello и ServerHello: заголовок записи,
+	// заголовок handshake сообщения и версия
+	tlsRandomOffset = tlsRecordHeaderLen + 4 + 2
+	tlsRandomLen    = 32
+
+	// размер ClientHello, как у браузеров
+	tlsClientHelloLen = 517
+)
+
+var (
+	tlsVersion             = []byte{0x03, 0x03}
+	tlsChangeCipherSpecMsg = []byte{tlsRecordChangeCipherSpec, 0x03, 0x03, 0x00, 0x01, 0x01}
+)
+
+// fakeTLSConn заворачивает все, что пишется, в TLS записи application data, и
+// достает данные из таких же записей при чтении
+type fakeTLSConn struct {
+	net.Conn
+
+	readBuf    bytes.Buffer
+	wroteFirst bool
+}
+
+func newFakeTLSConn(conn net.Conn, secret *ProxySecret) (*fakeTLSConn, error) {
+	hello := fakeTLSClientHello(secret.Domain)
+
+	mac := hmac.New(sha256.New, secret.Key)
+	mac.Write(hello)
+	digest := mac.Sum(nil)
+
+	// последние 4 байта это время, что бы сервер мог отбросить повторы
+	timestamp := binary.LittleEndian.Uint32(digest[28:]) ^ uint32(time.Now().Unix())
+	binary.LittleEndian.PutUint32(digest[28:], timestamp)
+	copy(hello[tlsRandomOffset:], digest)
+
+	_, err := conn.Write(hello)
+	if err != nil {
+		return nil, errors.Wrap(err, "writing ClientHello")
+	}
+
+	// ServerHello, ChangeCipherSpec и одна запись со случайными данными
+	response := new(bytes.Buffer)
+	for _, expectedType := range []byte{tlsRecordHandshake, tlsRecordChangeCipherSpec, tlsRecordApplicationData} {
+		recordType, record, err := readTLSRecord(conn)
+		if err != nil {
+			return nil, errors.Wrap(err, "reading ServerHello")
+		}
+		if recordType != expectedType {
+			return nil, errors.Errorf("unexpected tls record type: %#x, want %#x", recordType, expectedType)
+		}
+
+		response.Write(record)
+	}
+
+	serverHello := response.Bytes()
+	if len(serverHello) < tlsRandomOffset+tlsRandomLen {
+		return nil, errors.New("ServerHello is too short")
+	}
+
+	serverRandom := append([]byte{}, serverHello[tlsRandomOffset:tlsRandomOffset+tlsRandomLen]...)
+	copy(serverHello[tlsRandomOffset:], make([]byte, tlsRandomLen))
+
+	mac = hmac.New(sha256.New, secret.Key)
+	mac.Write(digest)
+	mac.Write(serverHello)
+	if !hmac.Equal(mac.Sum(nil), serverRandom) {
+		return nil, errors.New("invalid ServerHello digest, proxy secret is wrong")
+	}
+
+	return &fakeTLSConn{Conn: conn}, nil
+}
+
+func (c *fakeTLSConn) Read(b []byte) (int, error) {
+	for c.readBuf.Len() == 0 {
+		recordType, record, err := readTLSRecord(c.Conn)
+		if err != nil {
+			return 0, err
+		}
+
+		switch recordType {
+		case tlsRecordApplicationData:
+			c.readBuf.Write(record[tlsRecordHeaderLen:])
+		case tlsRecordChangeCipherSpec:
+			// игнорируем, ничего не значит
+		default:
+			return 0, errors.Errorf("unexpected tls record type: %#x", recordType)
+		}
+	}
+
+	return c.readBuf.Read(b)
+}
+
+func (c *fakeTLSConn) Write(b []byte) (int, error) {
+	buf := new(bytes.Buffer)
+	if !c.wroteFirst {
+		// перед первыми данными клиент отправляет ChangeCipherSpec, как в настоящем TLS
+		buf.Write(tlsChangeCipherSpecMsg)
+		c.wroteFirst = true
+	}
+
+	for data := b; len(data) > 0; {
+		chunk := data
+		if len(chunk) > tlsMaxRecordLen {
+			chunk = chunk[:tlsMaxRecordLen]
+		}
+		data = data[len(chunk):]
+
+		buf.WriteByte(tlsRecordApplicationData)
+		buf.Write(tlsVersion)
+		writeUint16BE(buf, len(chunk))
+		buf.Write(chunk)
+	}
+
+	_, err := c.Conn.Write(buf.Bytes())
+	if err != nil {
+		return 0, err
+	}
+
+	return len(b), nil
+}
+
+// readTLSRecord читает одну TLS запись целиком, вместе с заголовком
+func readTLSRecord(r io.Reader) (recordType byte, record []byte, err error) {
+	header := make([]byte, tlsRecordHeaderLen)
+	_, err = io.ReadFull(r, header)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "reading tls record header")
+	}
+
+	size := int(binary.BigEndian.Uint16(header[3:]))
+	record = make([]byte, tlsRecordHeaderLen+size)
+	copy(record, header)
+	_, err = io.ReadFull(r, record[tlsRecordHeaderLen:])
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "reading tls record")
+	}
+
+	return header[0], record, nil
+}
+
+// fakeTLSClientHello собирает ClientHello, похожий на браузерный. поле random
+// заполнено нулями, туда потом кладется подпись
+func fakeTLSClientHello(domain string) []byte {
+	body := new(bytes.Buffer)
+	body.Write(tlsVersion)
+	body.Write(make([]byte, tlsRandomLen))
+
+	body.WriteByte(32) // session id
+	body.Write(dry.RandomBytes(32))
+
+	cipherSuites := []uint16{
+		0x1301, 0x1302, 0x1303, 0xc02b, 0xc02f, 0xc02c, 0xc030,
+		0xcca9, 0xcca8, 0xc013, 0xc014, 0x009c, 0x009d, 0x002f, 0x0035,
+	}
+	writeUint16BE(body, len(cipherSuites)*2)
+	for _, suite := range cipherSuites {
+		writeUint16BE(body, int(suite))
+	}
+
+	body.Write([]byte{0x01, 0x00}) // compression methods: только null
+
+	extensions := fakeTLSExtensions(domain)
+
+	// добиваем расширением padding, что бы весь пакет был как у браузера
+	const handshakeHeaderLen = 4
+	const extensionHeaderLen = 4
+	paddingLen := tlsClientHelloLen - tlsRecordHeaderLen - handshakeHeaderLen - body.Len() - 2 - len(extensions) - extensionHeaderLen
+	if paddingLen >= 0 {
+		extensions = append(extensions, tlsExtension(0x0015, make([]byte, paddingLen))...)
+	}
+
+	writeUint16BE(body, len(extensions))
+	body.Write(extensions)
+
+	handshake := new(bytes.Buffer)
+	handshake.WriteByte(0x01) // ClientHello
+	handshake.Write([]byte{0x00})
+	writeUint16BE(handshake, body.Len())
+	handshake.Write(body.Bytes())
+
+	record := new(bytes.Buffer)
+	record.WriteByte(tlsRecordHandshake)
+	record.Write([]byte{0x03, 0x01})
+	writeUint16BE(record, handshake.Len())
+	record.Write(handshake.Bytes())
+
+	return record.Bytes()
+}
+
+func fakeTLSExtensions(domain string) []byte {
+	serverName := new(bytes.Buffer)
+	writeUint16BE(serverName, len(domain)+3)
+	serverName.WriteByte(0x00) // host_name
+	writeUint16BE(serverName, len(domain))
+	serverName.WriteString(domain)
+
+	keyShare := new(bytes.Buffer)
+	writeUint16BE(keyShare, 32+4)
+	writeUint16BE(keyShare, 0x001d) // x25519
+	writeUint16BE(keyShare, 32)
+	keyShare.Write(dry.RandomBytes(32))
+
+	res := new(bytes.Buffer)
+	for _, ext := range [][]byte{
+		tlsExtension(0x0000, serverName.Bytes()),                                     // server_name
+		tlsExtension(0x0017, nil),                                                    // extended_master_secret
+		tlsExtension(0xff01, []byte{0x00}),                                           // renegotiation_info
+		tlsExtension(0x000a, []byte{0x00, 0x06, 0x00, 0x1d, 0x00, 0x17, 0x00, 0x18}), // supported_groups
+		tlsExtension(0x000b, []byte{0x01, 0x00}),                                     // ec_point_formats
+		tlsExtension(0x0023, nil),                                                    // session_ticket
+		tlsExtension(0x0010, []byte{
+			0x00, 0x0c, 0x02, 'h', '2', 0x08, 'h', 't', 't', 'p', '/', '1', '.', '1',
+		}), // alpn
+		tlsExtension(0x0005, []byte{0x01, 0x00, 0x00, 0x00, 0x00}), // status_request
+		tlsExtension(0x000d, []byte{
+			0x00, 0x10, 0x04, 0x03, 0x08, 0x04, 0x04, 0x01, 0x05, 0x03,
+			0x08, 0x05, 0x05, 0x01, 0x08, 0x06, 0x06, 0x01,
+		}), // signature_algorithms
+		tlsExtension(0x0033, keyShare.Bytes()),                     // key_share
+		tlsExtension(0x002d, []byte{0x01, 0x01}),                   // psk_key_exchange_modes
+		tlsExtension(0x002b, []byte{0x04, 0x03, 0x04, 0x03, 0x03}), // supported_versions
+	} {
+		res.Write(ext)
+	}
+
+	return res.Bytes()
+}
+
+func tlsExtension(id int, data []byte) []byte {
+	buf := new(bytes.Buffer)
+	writeUint16BE(buf, id)
+	writeUint16BE(buf, len(data))
+	buf.Write(data)
+	return buf.Bytes()
+}
+
+func writeUint16BE(buf *bytes.Buffer, v int) {
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, uint16(v))
+	buf.Write(b)
+}
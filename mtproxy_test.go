@@ -0,0 +1,132 @@
+package mtproto
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xelaj/go-dry"
+)
+
+func TestParseProxySecret(t *testing.T) {
+	key, _ := hex.DecodeString("0123456789abcdef0123456789abcdef")
+
+	tests := []struct {
+		name    string
+		secret  string
+		want    *ProxySecret
+		wantErr bool
+	}{
+		{
+			name:   "plain hex",
+			secret: "0123456789abcdef0123456789abcdef",
+			want:   &ProxySecret{Type: ProxySecretPlain, Key: key},
+		},
+		{
+			name:   "padded hex",
+			secret: "dd0123456789abcdef0123456789abcdef",
+			want:   &ProxySecret{Type: ProxySecretPadded, Key: key},
+		},
+		{
+			name:   "fake tls hex",
+			secret: "ee0123456789abcdef0123456789abcdef" + hex.EncodeToString([]byte("example.com")),
+			want:   &ProxySecret{Type: ProxySecretFakeTLS, Key: key, Domain: "example.com"},
+		},
+		{
+			name:   "fake tls base64",
+			secret: "7gEjRWeJq83vASNFZ4mrze9leGFtcGxlLmNvbQ",
+			want:   &ProxySecret{Type: ProxySecretFakeTLS, Key: key, Domain: "example.com"},
+		},
+		{
+			name:    "too short",
+			secret:  "0123456789abcdef",
+			wantErr: true,
+		},
+		{
+			name:    "garbage",
+			secret:  "not a secret!",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseProxySecret(tt.secret)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFakeTLSConn(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+
+	secret := &ProxySecret{Type: ProxySecretFakeTLS, Key: dry.RandomBytes(16), Domain: "example.com"}
+	msg := dry.RandomBytes(100)
+	answer := dry.RandomBytes(200)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		conn, err := newFakeTLSConn(client, secret)
+		if !assert.NoError(t, err) {
+			return
+		}
+		_, err = conn.Write(msg)
+		assert.NoError(t, err)
+
+		got := make([]byte, len(answer))
+		_, err = io.ReadFull(conn, got)
+		assert.NoError(t, err)
+		assert.Equal(t, answer, got)
+	}()
+
+	// сервер: проверяем подпись ClientHello, время не проверяем
+	recordType, hello, err := readTLSRecord(server)
+	assert.NoError(t, err)
+	assert.Equal(t, byte(tlsRecordHandshake), recordType)
+	assert.Len(t, hello, tlsClientHelloLen)
+	assert.True(t, bytes.Contains(hello, []byte(secret.Domain)))
+
+	clientRandom := append([]byte{}, hello[tlsRandomOffset:tlsRandomOffset+tlsRandomLen]...)
+	copy(hello[tlsRandomOffset:], make([]byte, tlsRandomLen))
+	mac := hmac.New(sha256.New, secret.Key)
+	mac.Write(hello)
+	assert.Equal(t, mac.Sum(nil)[:28], clientRandom[:28])
+
+	serverHello := []byte{tlsRecordHandshake, 0x03, 0x03, 0x00, 0x2c, 0x02, 0x00, 0x00, 0x28, 0x03, 0x03}
+	serverHello = append(serverHello, make([]byte, tlsRandomLen+6)...)
+	response := append(serverHello, tlsChangeCipherSpecMsg...)
+	response = append(response, tlsRecordApplicationData, 0x03, 0x03, 0x00, 0x10)
+	response = append(response, dry.RandomBytes(16)...)
+
+	mac = hmac.New(sha256.New, secret.Key)
+	mac.Write(clientRandom)
+	mac.Write(response)
+	copy(response[tlsRandomOffset:], mac.Sum(nil))
+	_, err = server.Write(response)
+	assert.NoError(t, err)
+
+	recordType, _, err = readTLSRecord(server)
+	assert.NoError(t, err)
+	assert.Equal(t, byte(tlsRecordChangeCipherSpec), recordType)
+
+	recordType, record, err := readTLSRecord(server)
+	assert.NoError(t, err)
+	assert.Equal(t, byte(tlsRecordApplicationData), recordType)
+	assert.Equal(t, msg, record[tlsRecordHeaderLen:])
+
+	_, err = server.Write(append([]byte{tlsRecordApplicationData, 0x03, 0x03, 0x00, byte(len(answer))}, answer...))
+	assert.NoError(t, err)
+	<-done
+}
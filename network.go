@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
-	"github.com/xelaj/go-dry"
 
 	"github.com/xelaj/mtproto/serialize"
 )
@@ -18,7 +17,13 @@ const (
 
 func isNullableResponse(t serialize.TL) bool {
 	switch t.(type) {
-	case /**serialize.Ping,*/ *serialize.Pong, *serialize.MsgsAck:
+	case /**serialize.Ping,*/ *serialize.Pong, *serialize.MsgsAck, *HttpWaitParams:
+		return true
+	case *serialize.MsgsStateInfo, *serialize.MsgResendReq:
+		// наши ответы на служебные сообщения сервера
+		return true
+	case *DestroySessionParams:
+		// ответ приходит без msg_id запроса, DestroySession ждет его сам
 		return true
 	default:
 		return false
@@ -30,8 +35,10 @@ const (
 )
 
 func CatchResponseErrorCode(data []byte) error {
-	if len(data) == 4 {
-		code := int(binary.LittleEndian.Uint32(data))
+	// код ошибки это одно int32, но в padded intermediate к нему может
+	// добавиться до 15 байт паддинга. сообщения короче 20 байт не бывает
+	if len(data) >= serialize.WordLen && len(data) < serialize.WordLen+16 {
+		code := int(int32(binary.LittleEndian.Uint32(data)))
 		return &ErrResponseCode{Code: code}
 	}
 	return nil
@@ -52,15 +59,32 @@ func (m *MTProto) decodeRecievedData(data []byte) (serialize.TL, error) {
 
 	var obj serialize.TL
 
+	if len(data) < serialize.LongLen {
+		return nil, errors.New("packet is too short")
+	}
+
 	if IsPacketEncrypted(data) {
-		msg, err := serialize.DeserializeEncryptedMessage(data, m.GetAuthKey())
-		dry.PanicIfErr(err)
+		msg, err := serialize.DeserializeEncryptedMessage(data, m.GetAuthKey(), m.GetSessionID())
+		if err != nil {
+			if msg != nil && m.checkMessageID(msg.MsgID, msg.Msg) == nil {
+				// объект разобран частично, но может быть понятно, кому был ответ
+				return msg.Msg, errors.Wrap(err, "decoding encrypted message")
+			}
+			return nil, errors.Wrap(err, "decoding encrypted message")
+		}
+
+		err = m.checkReplay(msg)
+		if err != nil {
+			return nil, errors.Wrap(err, "checking message")
+		}
 		obj = msg.Msg
 		m.seqNo = msg.SeqNo
 		m.msgId = msg.MsgID
 	} else {
 		msg, err := serialize.DeserializeUnencryptedMessage(data)
-		dry.PanicIfErr(err)
+		if err != nil {
+			return nil, errors.Wrap(err, "decoding unencrypted message")
+		}
 		obj = msg.Msg
 		m.seqNo = 0
 		m.msgId = msg.MsgID
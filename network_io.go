@@ -2,7 +2,6 @@ package mtproto
 
 import (
 	"context"
-	"encoding/binary"
 	"fmt"
 	"strconv"
 	"time"
@@ -15,69 +14,84 @@ import (
 	"github.com/xelaj/mtproto/utils"
 )
 
-func (m *MTProto) sendPacketNew(request serialize.TL) (chan serialize.TL, error) {
-	resp := make(chan serialize.TL)
-	if m.serviceModeActivated {
-		resp = m.serviceChannel
+// sendPacketNew отправляет запрос и возвращает канал, в который придет ответ, и
+// msg_id, с которым запрос ушел. сообщения всегда шифруются и уходят через
+// очередь отправки, функция ждет, пока пакет с сообщением будет записан.
+// незашифрованными уходят только сообщения обмена ключами, см. makeServiceRequest
+func (m *MTProto) sendPacketNew(request serialize.TL) (chan serialize.TL, int64, error) {
+	requireToAck := MessageRequireToAck(request)
+
+	m.mutex.Lock()
+	item := m.newQueuedMessage(request, requireToAck)
+	m.mutex.Unlock()
+
+	return m.sendQueued(request, item)
+}
+
+// makeServiceRequest отправляет сообщение обмена ключами без шифрования и
+// ждет ответ. ответы на такие сообщения не привязаны к msg_id, поэтому
+// приходят в serviceChannel, пока идет обмен ключами (см. exchangeKeys)
+func (m *MTProto) makeServiceRequest(request serialize.TL) (serialize.TL, error) {
+	data := (&serialize.UnencryptedMessage{
+		Msg:   request,
+		MsgID: m.seq.NextMessageID(),
+	}).Serialize(m)
+
+	_, transport := m.connection()
+	err := transport.WritePacket(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "sending request")
 	}
-	var data []byte
-	var msgID = utils.GenerateMessageId()
-	if m.encrypted {
-		requireToAck := false
-		if MessageRequireToAck(request) {
-			m.mutex.Lock()
-			m.waitAck(msgID)
-			m.mutex.Unlock()
-			requireToAck = true
-		}
 
-		data = (&serialize.EncryptedMessage{
-			Msg:         request,
-			MsgID:       msgID,
-			AuthKeyHash: m.authKeyHash,
-		}).Serialize(m, requireToAck)
+	select {
+	case resp := <-m.serviceChannel:
+		return resp, nil
+	case <-m.closedChan():
+		return nil, ErrDisconnected
+	}
+}
 
-		if !isNullableResponse(request) {
-			m.mutex.Lock()
+// sendQueued отправляет зашифрованное сообщение, которому уже выдан msg_id,
+// и возвращает канал, в который придет ответ
+func (m *MTProto) sendQueued(request serialize.TL, item *queuedMessage) (chan serialize.TL, int64, error) {
+	resp := make(chan serialize.TL, 1)
+	requireToAck := MessageRequireToAck(request)
 
-			m.responseChannels[msgID] = resp
-			m.mutex.Unlock()
-		} else {
-			// ответов на TL_Ack, TL_Pong и пр. не требуется
-			go func() {
-				// горутина, т.к. мы ПРЯМО СЕЙЧАС из resp не читаем
-				resp <- &serialize.Null{}
-			}()
-		}
-		// этот кусок не часть кодирования так что делаем при отправке
-		m.lastSeqNo += 2
+	m.mutex.Lock()
+	if requireToAck {
+		m.waitAck(item.msgID)
+	}
+	if !isNullableResponse(request) {
+		m.responseChannels[item.msgID] = resp
 	} else {
-		data = (&serialize.UnencryptedMessage{
-			Msg:   request,
-			MsgID: msgID,
-		}).Serialize(m)
+		// ответов на TL_Ack, TL_Pong и пр. не требуется
+		resp <- &serialize.Null{}
 	}
+	m.mutex.Unlock()
 
-	//? https://core.telegram.org/mtproto/mtproto-transports#intermediate
-	size := make([]byte, 4)
-	binary.LittleEndian.PutUint32(size, uint32(len(data)))
-	_, err := m.conn.Write(size)
-	dry.PanicIfErr(err)
+	m.sendQueue.push(item)
 
-	//? https://core.telegram.org/mtproto/mtproto-transports#abridged
-	// _, err := m.conn.Write(utils.PacketLengthMTProtoCompatible(data))
-	// dry.PanicIfErr(err)
-	println("writing message")
-	_, err = m.conn.Write(data)
+	var err error
+	select {
+	case err = <-item.sent:
+	case <-m.closedChan():
+		err = ErrDisconnected
+	}
 	if err != nil {
-		return nil, errors.Wrap(err, "sending request")
+		if !isNullableResponse(request) {
+			// запрос уже ждет ответа, после переподключения он отправится заново
+			m.connectionLost(context.Background(), err)
+			return resp, item.msgID, nil
+		}
+		return nil, 0, errors.Wrap(err, "sending request")
 	}
 
-	return resp, nil
+	return resp, item.msgID, nil
 }
 
 func (m *MTProto) writeRPCResponse(msgID int, data serialize.TL) error {
 	m.mutex.Lock()
+	defer m.mutex.Unlock()
 	v, ok := m.responseChannels[int64(msgID)]
 	if !ok {
 		return errs.NotFound("msgID", strconv.Itoa(msgID))
@@ -86,60 +100,77 @@ func (m *MTProto) writeRPCResponse(msgID int, data serialize.TL) error {
 	v <- data
 
 	delete(m.responseChannels, int64(msgID))
-	m.mutex.Unlock()
 	return nil
 }
 
-func (m *MTProto) readFromConn(ctx context.Context) (data []byte, err error) {
-	err = m.conn.SetReadDeadline(time.Now().Add(readTimeout)) // возможно поможет???
-	dry.PanicIfErr(err)
+// writeDestroySessionResponse отдает ответ на destroy_session тому, кто ждет
+// его по session_id
+func (m *MTProto) writeDestroySessionResponse(data serialize.DestroySessionRes) error {
+	var sessionID int64
+	switch res := data.(type) {
+	case *serialize.DestroySessionOk:
+		sessionID = res.SessionID
+	case *serialize.DestroySessionNone:
+		sessionID = res.SessionID
+	}
 
-	reader := dry.NewCancelableReader(ctx, m.conn)
-	// https://core.telegram.org/mtproto/mtproto-transports#abridged
-	// что делаем:
-	// в conn есть определенный буффер, все что телега присылает, мы сохраняем в буффере, и потом через
-	// Read читаем. т.к. маленькие пакеты (до 127 байт)  кодируют длину в 1 байт, а побольше в 4, то
-	// мы читаем сначала 1 байт, смотрим, это 0xef или нет, если да, то читаем оставшиеся 3 байта и получаем длину
-	//firstByte, err := reader.ReadByte()
-	//dry.PanicIfErr(err)
-	//
-	//sizeInBytes, err := utils.GetPacketLengthMTProtoCompatible([]byte{firstByte})
-	//if err == utils.ErrPacketSizeIsBigger {
-	//	restOfSize := make([]byte, 3)
-	//	n, err := reader.Read(restOfSize)
-	//	dry.PanicIfErr(err)
-	//	dry.PanicIf(n != 3, "expected read 3 bytes, got "+strconv.Itoa(n))
-	//
-	//	sizeInBytes, _ = utils.GetPacketLengthMTProtoCompatible(append([]byte{firstByte}, restOfSize...))
-	//
-	//	pp.Println(firstByte, restOfSize, sizeInBytes)
-	//}
-
-	// https://core.telegram.org/mtproto/mtproto-transports#intermediate
-	sizeInBytes := make([]byte, 4)
-	n, err := reader.Read(sizeInBytes)
-	if err != nil {
-		pp.Println(sizeInBytes, err)
-		return nil, errors.Wrap(err, "reading length")
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	v, ok := m.destroySessionChannels[sessionID]
+	if !ok {
+		return errs.NotFound("sessionID", strconv.FormatInt(sessionID, 10))
 	}
-	if n != 4 {
-		return nil, errors.New("size is not length of int32, expected 4 bytes, got " + strconv.Itoa(n))
+
+	v <- data
+
+	delete(m.destroySessionChannels, sessionID)
+	return nil
+}
+
+// dropRequest забывает про запрос, ответ на который больше не нужен. если
+// запрос уже ушел на сервер, то просим сервер ответ не присылать
+func (m *MTProto) dropRequest(msgID int64) {
+	m.mutex.Lock()
+	_, ok := m.responseChannels[msgID]
+	delete(m.responseChannels, msgID)
+	encrypted := m.encrypted
+	m.mutex.Unlock()
+
+	if !ok || !encrypted {
+		return
 	}
 
-	size := binary.LittleEndian.Uint32(sizeInBytes)
-	// читаем сами данные
-	data = make([]byte, int(size))
-	n, err = reader.Read(data)
-	dry.PanicIfErr(err)
-	dry.PanicIf(n != int(size), "expected read "+strconv.Itoa(int(size))+" bytes, got "+strconv.Itoa(n))
+	go func() {
+		_, err := m.DropAnswer(msgID)
+		if err != nil {
+			m.reportError(errors.Wrap(err, "dropping answer"))
+		}
+	}()
+}
+
+func (m *MTProto) readFromConn(ctx context.Context) (data []byte, err error) {
+	conn, transport := m.connection()
+
+	// у http транспорта нет своего соединения
+	if conn != nil {
+		err = conn.SetReadDeadline(time.Now().Add(readTimeout)) // возможно поможет???
+		if err != nil {
+			return nil, errors.Wrap(err, "setting read deadline")
+		}
+	}
+
+	data, err = transport.ReadPacket()
+	if err != nil {
+		return nil, errors.Wrap(err, "reading packet")
+	}
 
 	return data, nil
 }
 
-//! DEPRECATED
+// ! DEPRECATED
 func (m *MTProto) sendPacket(msg serialize.TL, resp chan serialize.TL) error {
 	var data []byte
-	var msgID = utils.GenerateMessageId()
+	var msgID = m.seq.NextMessageID()
 	if m.encrypted {
 		requireToAck := false
 		if MessageRequireToAck(msg) {
@@ -150,19 +181,23 @@ func (m *MTProto) sendPacket(msg serialize.TL, resp chan serialize.TL) error {
 
 		}
 
-		data = (&serialize.EncryptedMessage{
+		var seqNo int32
+		msgID, seqNo = m.seq.Next(requireToAck)
+
+		var err error
+		data, err = (&serialize.EncryptedMessage{
 			Msg:         msg,
 			MsgID:       msgID,
+			SeqNo:       seqNo,
 			AuthKeyHash: m.authKeyHash,
-		}).Serialize(m, requireToAck)
+		}).Serialize(m)
+		dry.PanicIfErr(err)
 
 		if resp != nil {
 			m.mutex.Lock()
 			m.msgsIdToResp[msgID] = resp
 			m.mutex.Unlock()
 		}
-		// этот кусок не часть кодирования так что делаем при отправке
-		m.lastSeqNo += 2
 	} else {
 		data = (&serialize.UnencryptedMessage{
 			Msg:   msg.(serialize.TL),
@@ -174,7 +209,7 @@ func (m *MTProto) sendPacket(msg serialize.TL, resp chan serialize.TL) error {
 	return nil
 }
 
-//! DEPRECATED
+// ! DEPRECATED
 func (m *MTProto) read(stop <-chan struct{}) (serialize.TL, error) {
 	var err error
 	var obj serialize.TL
@@ -222,7 +257,7 @@ func (m *MTProto) read(stop <-chan struct{}) (serialize.TL, error) {
 	}
 
 	if IsPacketEncrypted(data) {
-		msg, err := serialize.DeserializeEncryptedMessage(data, m.GetAuthKey())
+		msg, err := serialize.DeserializeEncryptedMessage(data, m.GetAuthKey(), m.GetSessionID())
 		dry.PanicIfErr(err)
 		obj = msg.Msg
 		m.seqNo = msg.SeqNo
@@ -0,0 +1,98 @@
+package mtproto
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/xelaj/go-dry"
+
+	"github.com/xelaj/mtproto/serialize"
+)
+
+func TestProcessBrokenResponse(t *testing.T) {
+	m := newTestMTProto()
+	var reported []error
+	m.errorHandler = func(err error) { reported = append(reported, err) }
+
+	resp := make(chan serialize.TL, 1)
+	m.responseChannels[123] = resp
+
+	decodeErr := errors.New("broken")
+	m.processBrokenResponse(&serialize.MessageContainer{
+		{MsgID: 1, SeqNo: 0, Msg: &serialize.Pong{MsgID: 100}},
+		{MsgID: 2, SeqNo: 0, Msg: &serialize.RpcResult{ReqMsgID: 123}},
+	}, decodeErr)
+
+	got, ok := (<-resp).(*errorResponse)
+	if assert.True(t, ok) {
+		assert.Equal(t, decodeErr, errors.Cause(got.err))
+	}
+	assert.Empty(t, m.responseChannels)
+	assert.Empty(t, reported)
+}
+
+// captureTransport складывает все отправленные пакеты в канал и ничего не читает
+type captureTransport struct {
+	packets chan []byte
+}
+
+func (t *captureTransport) WritePacket(data []byte) error {
+	t.packets <- data
+	return nil
+}
+
+func (t *captureTransport) ReadPacket() ([]byte, error) {
+	select {}
+}
+
+func TestMakeRequestContextCancel(t *testing.T) {
+	m := newTestMTProto()
+	m.encrypted = true
+	m.SetAuthKey(dry.RandomBytes(256))
+	m.idsToAck = make(map[int64]struct{})
+	tr := &captureTransport{packets: make(chan []byte, 2)}
+	m.setConnection(nil, tr)
+
+	sendCtx, stop := context.WithCancel(context.Background())
+	defer stop()
+	m.startSending(sendCtx)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := m.makeRequestContext(ctx, &PingParams{PingID: 1})
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	<-tr.packets
+	select {
+	case <-tr.packets:
+		// rpc_drop_answer
+	case <-time.After(time.Second):
+		t.Error("rpc_drop_answer was not sent")
+	}
+	// ответа ждет только сам rpc_drop_answer
+	assert.Len(t, m.responseChannels, 1)
+
+	// отмененный контекст не дает даже отправить запрос
+	_, err = m.makeRequestContext(ctx, &PingParams{PingID: 2})
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Len(t, tr.packets, 0)
+}
+
+func TestWriteDestroySessionResponse(t *testing.T) {
+	m := newTestMTProto()
+	resp := make(chan serialize.TL, 1)
+	m.destroySessionChannels[123] = resp
+
+	// ответ на destroy_session приходит сам по себе, без rpc_result
+	err := m.processResponse(1, 0, &serialize.DestroySessionOk{SessionID: 123})
+	assert.NoError(t, err)
+	assert.Equal(t, &serialize.DestroySessionOk{SessionID: 123}, <-resp)
+	assert.Empty(t, m.destroySessionChannels)
+
+	err = m.processResponse(2, 0, &serialize.DestroySessionNone{SessionID: 456})
+	assert.NoError(t, err)
+}
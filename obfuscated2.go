@@ -0,0 +1,136 @@
+package mtproto
+
+import (
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/binary"
+	"net"
+
+	"github.com/pkg/errors"
+	"github.com/xelaj/go-dry"
+
+	ige "github.com/xelaj/mtproto/aes_ige"
+)
+
+// https://core.telegram.org/mtproto/mtproto-transports#transport-obfuscation
+const (
+	obfuscatedHeaderLen = 64
+
+	// где в заголовке лежит тег протокола и номер датацентра
+	obfuscatedTagOffset = 56
+	obfuscatedDCOffset  = 60
+)
+
+// obfuscatedConn шифрует весь поток AES-256-CTR, так что DPI не может понять,
+// что внутри MTProto. поверх него работает любой транспорт, у которого есть
+// тег протокола (все, кроме full)
+type obfuscatedConn struct {
+	net.Conn
+
+	encryptor cipher.Stream
+	decryptor cipher.Stream
+}
+
+// newObfuscatedConn отправляет в соединение заголовок obfuscated2 и возвращает
+// соединение, которое дальше все шифрует. tag это тег транспорта, см. protocolTag.
+// secret и dc нужны только при подключении через MTProxy, иначе nil и 0
+func newObfuscatedConn(conn net.Conn, tag, secret []byte, dc int16) (*obfuscatedConn, error) {
+	header := obfuscatedHeader(tag, dc)
+
+	// ключи для отправки берутся из заголовка как есть, для приема из перевернутого
+	encryptKey, encryptIV := header[8:40], header[40:56]
+	reversed := reverseBytes(header[8:56])
+	decryptKey, decryptIV := reversed[0:32], reversed[32:48]
+
+	// с MTProxy ключи дополнительно смешиваются с секретом
+	if secret != nil {
+		encryptKey = proxyKey(encryptKey, secret)
+		decryptKey = proxyKey(decryptKey, secret)
+	}
+
+	encryptor, err := ige.NewCTRStream(encryptKey, encryptIV)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating encryptor")
+	}
+	decryptor, err := ige.NewCTRStream(decryptKey, decryptIV)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating decryptor")
+	}
+
+	// шифруем заголовок целиком (состояние шифра должно сдвинуться на 64 байта),
+	// но в сеть уходят зашифрованными только последние 8 байт с тегом
+	encrypted := make([]byte, obfuscatedHeaderLen)
+	encryptor.XORKeyStream(encrypted, header)
+	copy(header[obfuscatedTagOffset:], encrypted[obfuscatedTagOffset:])
+
+	_, err = conn.Write(header)
+	if err != nil {
+		return nil, errors.Wrap(err, "writing obfuscated header")
+	}
+
+	return &obfuscatedConn{
+		Conn:      conn,
+		encryptor: encryptor,
+		decryptor: decryptor,
+	}, nil
+}
+
+func (c *obfuscatedConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.decryptor.XORKeyStream(b[:n], b[:n])
+	return n, err
+}
+
+func (c *obfuscatedConn) Write(b []byte) (int, error) {
+	encrypted := make([]byte, len(b))
+	c.encryptor.XORKeyStream(encrypted, b)
+	return c.Conn.Write(encrypted)
+}
+
+// obfuscatedHeader генерирует случайные 64 байта заголовка, которые не должны
+// быть похожи ни на один другой протокол, и кладет в них тег транспорта и номер
+// датацентра
+func obfuscatedHeader(tag []byte, dc int16) []byte {
+	for {
+		header := dry.RandomBytes(obfuscatedHeaderLen)
+
+		if header[0] == 0xef { // abridged
+			continue
+		}
+
+		switch binary.LittleEndian.Uint32(header[0:4]) {
+		case 0x44414548, // HEAD
+			0x54534f50, // POST
+			0x20544547, // GET
+			0x4954504f, // OPTI
+			0x02010316, // TLS handshake
+			0xdddddddd, // padded intermediate
+			0xeeeeeeee: // intermediate
+			continue
+		}
+
+		if binary.LittleEndian.Uint32(header[4:8]) == 0 {
+			continue
+		}
+
+		copy(header[obfuscatedTagOffset:], tag)
+		binary.LittleEndian.PutUint16(header[obfuscatedDCOffset:], uint16(dc))
+		return header
+	}
+}
+
+// proxyKey это SHA256(key + secret)
+func proxyKey(key, secret []byte) []byte {
+	h := sha256.New()
+	h.Write(key)
+	h.Write(secret)
+	return h.Sum(nil)
+}
+
+func reverseBytes(b []byte) []byte {
+	res := make([]byte, len(b))
+	for i := range b {
+		res[len(b)-1-i] = b[i]
+	}
+	return res
+}
@@ -0,0 +1,65 @@
+package mtproto
+
+import (
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xelaj/go-dry"
+
+	ige "github.com/xelaj/mtproto/aes_ige"
+)
+
+func TestObfuscatedConn(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+
+	tag := []byte{0xee, 0xee, 0xee, 0xee}
+	msg := dry.RandomBytes(100)
+	answer := dry.RandomBytes(200)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		conn, err := newObfuscatedConn(client, tag, nil, 0)
+		if !assert.NoError(t, err) {
+			return
+		}
+		_, err = conn.Write(msg)
+		assert.NoError(t, err)
+
+		got := make([]byte, len(answer))
+		_, err = io.ReadFull(conn, got)
+		assert.NoError(t, err)
+		assert.Equal(t, answer, got)
+	}()
+
+	// сервер: ключи для приема берутся из заголовка как есть, для отправки из перевернутого
+	header := make([]byte, obfuscatedHeaderLen)
+	_, err := io.ReadFull(server, header)
+	assert.NoError(t, err)
+
+	decryptor, err := ige.NewCTRStream(header[8:40], header[40:56])
+	assert.NoError(t, err)
+	reversed := reverseBytes(header[8:56])
+	encryptor, err := ige.NewCTRStream(reversed[0:32], reversed[32:48])
+	assert.NoError(t, err)
+
+	decryptedHeader := make([]byte, obfuscatedHeaderLen)
+	decryptor.XORKeyStream(decryptedHeader, header)
+	assert.Equal(t, tag, decryptedHeader[obfuscatedTagOffset:obfuscatedTagOffset+4])
+
+	got := make([]byte, len(msg))
+	_, err = io.ReadFull(server, got)
+	assert.NoError(t, err)
+	decryptor.XORKeyStream(got, got)
+	assert.Equal(t, msg, got)
+
+	encrypted := make([]byte, len(answer))
+	encryptor.XORKeyStream(encrypted, answer)
+	_, err = server.Write(encrypted)
+	assert.NoError(t, err)
+	<-done
+}
@@ -0,0 +1,175 @@
+package mtproto
+
+import (
+	"context"
+	"encoding/binary"
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/xelaj/go-dry"
+
+	ige "github.com/xelaj/mtproto/aes_ige"
+	"github.com/xelaj/mtproto/serialize"
+	"github.com/xelaj/mtproto/utils"
+)
+
+// https://core.telegram.org/api/pfs
+// с включенным PFS весь трафик шифруется временным ключом, который сервер
+// забывает после истечения срока. постоянный ключ нужен только что бы
+// привязать к нему временный (auth.bindTempAuthKey), поэтому даже если
+// постоянный ключ утечет, старую переписку им не расшифровать
+
+const (
+	// по умолчанию временный ключ живет сутки
+	defaultTempKeyTTL = 24 * time.Hour
+	// новый временный ключ создаем заранее, пока старый еще действует
+	tempKeyRenewBefore = 10 * time.Minute
+)
+
+var errTempKeyExpires = errors.New("temporary auth key expires")
+
+// authKeyID это auth_key_id ключа: младшие 64 бита SHA1 от него
+func authKeyID(authKey []byte) int64 {
+	return int64(binary.LittleEndian.Uint64(utils.AuthKeyHash(authKey)))
+}
+
+// tempKeyRenewAt возвращает время, когда пора создавать новый временный ключ
+func (m *MTProto) tempKeyRenewAt() time.Time {
+	before := tempKeyRenewBefore
+	if m.tempKeyTTL/2 < before {
+		before = m.tempKeyTTL / 2
+	}
+
+	return m.tempKeyExpiresAt.Add(-before)
+}
+
+// makeTempAuthKey создает новый временный ключ и привязывает его к постоянному.
+// все дальнейшие сообщения шифруются временным ключом в новой сессии
+func (m *MTProto) makeTempAuthKey() error {
+	if m.permAuthKey == nil {
+		m.permAuthKey = m.authKey
+	}
+
+	// обмен ключами идет незашифрованными сообщениями, но только сам обмен:
+	// остальные запросы, пока он идет, шифруются старым ключом
+	tempKey, salt, err := m.exchangeKeys(int32(m.tempKeyTTL / time.Second))
+	if err != nil {
+		return errors.Wrap(err, "exchanging keys")
+	}
+	m.tempKeyExpiresAt = time.Now().Add(m.tempKeyTTL)
+
+	m.SetAuthKey(tempKey)
+	m.resetSession()
+	m.salts.set(nil)
+	m.setServerSalt(salt)
+
+	err = m.bindTempAuthKey()
+	if err != nil {
+		return errors.Wrap(err, "binding temporary key")
+	}
+
+	return nil
+}
+
+// bindTempAuthKey привязывает текущий (временный) ключ к постоянному. msg_id
+// запроса должен совпадать с msg_id внутри зашифрованного сообщения о привязке,
+// поэтому запрос собирается заново на каждую попытку
+func (m *MTProto) bindTempAuthKey() error {
+	for {
+		serverNow := time.Now().Add(m.seq.TimeOffset())
+		inner := &serialize.BindAuthKeyInner{
+			Nonce:         int64(binary.LittleEndian.Uint64(dry.RandomBytes(serialize.LongLen))),
+			TempAuthKeyID: authKeyID(m.authKey),
+			PermAuthKeyID: authKeyID(m.permAuthKey),
+			TempSessionID: m.GetSessionID(),
+			ExpiresAt:     int32(serverNow.Add(m.tempKeyTTL).Unix()),
+		}
+
+		item := &queuedMessage{sent: make(chan error, 1)}
+		item.msgID, item.seqNo = m.seq.Next(true)
+
+		encrypted, err := encryptBindMessage(item.msgID, inner, m.permAuthKey)
+		if err != nil {
+			return errors.Wrap(err, "encrypting bind message")
+		}
+
+		request := &BindTempAuthKeyParams{
+			PermAuthKeyID:    inner.PermAuthKeyID,
+			Nonce:            inner.Nonce,
+			ExpiresAt:        inner.ExpiresAt,
+			EncryptedMessage: encrypted,
+		}
+		item.body = request.Encode()
+
+		resp, _, err := m.sendQueued(request, item)
+		if err != nil {
+			return errors.Wrap(err, "sending bindTempAuthKey")
+		}
+
+		var data serialize.TL
+		select {
+		case data = <-resp:
+		case <-m.closedChan():
+			return ErrDisconnected
+		}
+
+		switch r := data.(type) {
+		case *serialize.ErrorSessionConfigsChanged:
+			// bad_server_salt и подобное, отправляем заново с новым msg_id
+			continue
+		case *serialize.RpcError:
+			return RpcErrorToNative(r)
+		case *errorResponse:
+			return r.err
+		case *serialize.Bool:
+			if !r.Value {
+				return errors.New("server refused to bind temporary key")
+			}
+			return nil
+		default:
+			return errors.New("got invalid response type: " + reflect.TypeOf(data).String())
+		}
+	}
+}
+
+// encryptBindMessage шифрует bind_auth_key_inner постоянным ключом так же, как
+// обычное сообщение, но по схеме MTProto 1.0, со случайными солью и сессией
+// и с msg_id запроса auth.bindTempAuthKey
+func encryptBindMessage(msgID int64, inner *serialize.BindAuthKeyInner, permAuthKey []byte) ([]byte, error) {
+	body := inner.Encode()
+
+	buf := serialize.NewEncoder()
+	buf.PutRawBytes(dry.RandomBytes(serialize.LongLen)) // соль
+	buf.PutLong(utils.GenerateSessionID())
+	buf.PutLong(msgID)
+	buf.PutInt(0) // seqno
+	buf.PutInt(int32(len(body)))
+	buf.PutRawBytes(body)
+
+	encrypted, msgKey, err := ige.EncryptV1(buf.Result(), permAuthKey)
+	if err != nil {
+		return nil, err
+	}
+
+	res := serialize.NewEncoder()
+	res.PutRawBytes(utils.AuthKeyHash(permAuthKey))
+	res.PutRawBytes(msgKey)
+	res.PutRawBytes(encrypted)
+	return res.Result(), nil
+}
+
+// startRenewingTempKey переподключается незадолго до того, как истечет
+// временный ключ. при переподключении создается и привязывается новый ключ, а
+// все запросы, которые ждут ответа, отправляются уже с ним
+func (m *MTProto) startRenewingTempKey(ctx context.Context) {
+	go func() {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Until(m.tempKeyRenewAt())):
+		}
+
+		m.connectionLost(ctx, errTempKeyExpires)
+	}()
+}
@@ -0,0 +1,108 @@
+package mtproto
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xelaj/go-dry"
+
+	"github.com/xelaj/mtproto/serialize"
+	"github.com/xelaj/mtproto/utils"
+)
+
+func TestTempKeyRenewAt(t *testing.T) {
+	m := newTestMTProto()
+	m.tempKeyTTL = defaultTempKeyTTL
+
+	// ключа еще нет, создавать нужно сразу
+	assert.True(t, time.Now().After(m.tempKeyRenewAt()))
+
+	m.tempKeyExpiresAt = time.Now().Add(m.tempKeyTTL)
+	assert.Equal(t, m.tempKeyExpiresAt.Add(-tempKeyRenewBefore), m.tempKeyRenewAt())
+
+	// короткоживущий ключ обновляется на середине срока
+	m.tempKeyTTL = 10 * time.Second
+	m.tempKeyExpiresAt = time.Now().Add(m.tempKeyTTL)
+	assert.Equal(t, m.tempKeyExpiresAt.Add(-5*time.Second), m.tempKeyRenewAt())
+}
+
+func TestEncryptBindMessage(t *testing.T) {
+	permKey := dry.RandomBytes(256)
+	inner := &serialize.BindAuthKeyInner{
+		Nonce:         1,
+		TempAuthKeyID: authKeyID(dry.RandomBytes(256)),
+		PermAuthKeyID: authKeyID(permKey),
+		TempSessionID: 3,
+		ExpiresAt:     4,
+	}
+
+	data, err := encryptBindMessage(utils.GenerateMessageId(0), inner, permKey)
+	assert.NoError(t, err)
+
+	// auth_key_id постоянного ключа, msg_key, и зашифрованные соль, сессия,
+	// msg_id, seqno, длина и само сообщение
+	assert.Equal(t, utils.AuthKeyHash(permKey), data[:8])
+	headerLen := serialize.LongLen*3 + serialize.WordLen*2
+	assert.Equal(t, (headerLen+len(inner.Encode())+15)/16*16, len(data)-8-16)
+}
+
+func TestMakeTempAuthKeyKeepsRequestsEncrypted(t *testing.T) {
+	m := newTestMTProto()
+	m.encrypted = true
+	m.SetAuthKey(dry.RandomBytes(256))
+	m.idsToAck = make(map[int64]struct{})
+	m.serviceChannel = make(chan serialize.TL)
+	m.tempKeyTTL = defaultTempKeyTTL
+	tr := &captureTransport{packets: make(chan []byte, 100)}
+	m.setConnection(nil, tr)
+
+	ctx, stop := context.WithCancel(context.Background())
+	defer stop()
+	m.startSending(ctx)
+
+	handshakeErr := make(chan error, 1)
+	go func() {
+		handshakeErr <- m.makeTempAuthKey()
+	}()
+
+	var reqPQ []byte
+	select {
+	case reqPQ = <-tr.packets:
+	case <-time.After(time.Second):
+		t.Fatal("req_pq was not sent")
+	}
+	// auth_key_id 0, msg_id, длина, и сам req_pq
+	assert.Equal(t, uint64(0), binary.LittleEndian.Uint64(reqPQ))
+	assert.Equal(t, (&ReqPQParams{}).CRC(), binary.LittleEndian.Uint32(reqPQ[20:]))
+
+	// пока идет обмен ключами, обычные запросы уходят зашифрованными и ответы
+	// ждут в своих каналах
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			resp, _, err := m.sendPacketNew(&PingParams{PingID: int64(i)})
+			assert.NoError(t, err)
+			assert.True(t, resp != m.serviceChannel, "request waits in service channel")
+		}(i)
+	}
+	wg.Wait()
+
+	// ответ на req_pq достается обмену ключами
+	m.serviceChannel <- &serialize.ResPQ{Nonce: serialize.RandomInt128()}
+	err := <-handshakeErr
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "Wrong nonce")
+	}
+
+	assert.NotEmpty(t, tr.packets)
+	for len(tr.packets) > 0 {
+		packet := <-tr.packets
+		assert.Equal(t, utils.AuthKeyHash(m.authKey), packet[:8], "only key exchange can be unencrypted")
+	}
+}
@@ -0,0 +1,145 @@
+package mtproto
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/xelaj/mtproto/serialize"
+	"github.com/xelaj/mtproto/utils"
+)
+
+// https://core.telegram.org/mtproto/security_guidelines#checking-msg-id
+// клиент должен игнорировать сообщения, msg_id которых слишком далеко от
+// текущего времени сервера, и сообщения, которые уже приходили
+
+const (
+	maxMessageAge    = 300 * time.Second
+	maxMessageFuture = 30 * time.Second
+)
+
+// replayWindow помнит msg_id всех сообщений за последние maxMessageAge. более
+// старые сообщения и так не пройдут проверку по времени, поэтому их можно забыть
+type replayWindow struct {
+	mutex sync.Mutex
+	seen  map[int64]struct{}
+	// msg_id в порядке получения, что бы знать, что забывать
+	order []int64
+}
+
+func newReplayWindow() *replayWindow {
+	return &replayWindow{
+		seen: make(map[int64]struct{}),
+	}
+}
+
+// check проверяет msg_id входящего сообщения и запоминает его. now это
+// текущее время сервера (пока оно неизвестно, смещение нулевое)
+func (w *replayWindow) check(msgID int64, now time.Time) error {
+	sent := utils.MessageIdTime(msgID)
+	if sent.Before(now.Add(-maxMessageAge)) {
+		return fmt.Errorf("message %v is too old", msgID)
+	}
+	if sent.After(now.Add(maxMessageFuture)) {
+		return fmt.Errorf("message %v is too far in the future", msgID)
+	}
+
+	return w.remember(msgID, now)
+}
+
+// remember проверяет только, что сообщение не приходило раньше, и запоминает его
+func (w *replayWindow) remember(msgID int64, now time.Time) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if _, ok := w.seen[msgID]; ok {
+		return fmt.Errorf("message %v was already received", msgID)
+	}
+
+	w.seen[msgID] = struct{}{}
+	w.order = append(w.order, msgID)
+
+	for len(w.order) > 0 && utils.MessageIdTime(w.order[0]).Before(now.Add(-maxMessageAge)) {
+		delete(w.seen, w.order[0])
+		w.order = w.order[1:]
+	}
+
+	return nil
+}
+
+// state возвращает состояние входящего сообщения для msgs_state_info: знаем ли
+// мы его, и если нет, то почему
+func (w *replayWindow) state(msgID int64, now time.Time) byte {
+	w.mutex.Lock()
+	_, ok := w.seen[msgID]
+	w.mutex.Unlock()
+
+	sent := utils.MessageIdTime(msgID)
+	switch {
+	case ok:
+		return msgStateReceived
+	case sent.Before(now.Add(-maxMessageAge)):
+		return msgStateTooLow
+	case sent.After(now.Add(maxMessageFuture)):
+		return msgStateTooHigh
+	default:
+		return msgStateNotReceived
+	}
+}
+
+// checkReplay отбрасывает старые и повторные сообщения. из контейнера
+// выкидываются только плохие сообщения, остальные обрабатываются как обычно
+func (m *MTProto) checkReplay(msg *serialize.EncryptedMessage) error {
+	err := m.checkMessageID(msg.MsgID, msg.Msg)
+	if err != nil {
+		return err
+	}
+
+	container, ok := msg.Msg.(*serialize.MessageContainer)
+	if !ok {
+		return nil
+	}
+
+	items := make(serialize.MessageContainer, 0, len(*container))
+	for _, item := range *container {
+		err := m.checkMessageID(item.MsgID, item.Msg)
+		if err != nil {
+			m.reportError(errors.Wrap(err, "checking message in container"))
+			continue
+		}
+		items = append(items, item)
+	}
+	*container = items
+
+	return nil
+}
+
+// checkMessageID проверяет msg_id сообщения obj по времени сервера и на повтор
+func (m *MTProto) checkMessageID(msgID int64, obj serialize.TL) error {
+	now := time.Now().Add(m.seq.TimeOffset())
+	if isTimeCorrection(obj) {
+		return m.replay.remember(msgID, now)
+	}
+
+	return m.replay.check(msgID, now)
+}
+
+// isTimeCorrection возвращает true для bad_msg_notification с кодами 16 и 17
+// (и контейнеров с ними). по ним часы сверяются с сервером, поэтому их msg_id
+// по времени не проверяется: если часы разъехались, иначе их не исправить
+func isTimeCorrection(obj serialize.TL) bool {
+	switch msg := obj.(type) {
+	case *serialize.BadMsgNotification:
+		return msg.ErrorCode == badMsgIDTooLow || msg.ErrorCode == badMsgIDTooHigh
+	case *serialize.MessageContainer:
+		for _, item := range *msg {
+			if isTimeCorrection(item.Msg) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,73 @@
+package mtproto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/xelaj/mtproto/serialize"
+	"github.com/xelaj/mtproto/utils"
+)
+
+func TestReplayWindow(t *testing.T) {
+	w := newReplayWindow()
+	now := time.Now()
+	msgID := func(at time.Time) int64 {
+		return utils.GenerateMessageId(time.Until(at)) | 1
+	}
+
+	id := msgID(now)
+	assert.NoError(t, w.check(id, now))
+	assert.Error(t, w.check(id, now), "duplicate")
+
+	assert.Error(t, w.check(msgID(now.Add(-maxMessageAge-time.Minute)), now))
+	assert.Error(t, w.check(msgID(now.Add(maxMessageFuture+time.Minute)), now))
+	assert.NoError(t, w.check(msgID(now.Add(maxMessageFuture-5*time.Second)), now))
+
+	// через maxMessageAge старые id забываются
+	assert.NoError(t, w.check(msgID(now.Add(maxMessageAge+time.Minute)), now.Add(maxMessageAge+time.Minute)))
+	assert.NotContains(t, w.seen, id)
+}
+
+func TestCheckMessageIDTime(t *testing.T) {
+	// время сервера еще не известно, но проверка все равно идет (смещение 0)
+	m := newTestMTProto()
+	old := utils.GenerateMessageId(-time.Hour) | 1
+	assert.Error(t, m.checkMessageID(old, &serialize.Pong{}))
+
+	// bad_msg_notification 16 и 17 нужны, что бы поправить часы, их пропускаем
+	fix := &serialize.BadMsgNotification{ErrorCode: badMsgIDTooLow}
+	assert.NoError(t, m.checkMessageID(old, fix))
+	future := utils.GenerateMessageId(time.Hour) | 1
+	assert.NoError(t, m.checkMessageID(future, fix))
+	assert.Error(t, m.checkMessageID(future, fix), "duplicate")
+
+	container := &serialize.MessageContainer{{MsgID: old + 4, Msg: &serialize.BadMsgNotification{ErrorCode: badMsgIDTooHigh}}}
+	assert.NoError(t, m.checkMessageID(old+8, container))
+
+	other := &serialize.BadMsgNotification{ErrorCode: badMsgServerSalt}
+	assert.Error(t, m.checkMessageID(old+12, other))
+}
+
+func TestCheckReplayContainer(t *testing.T) {
+	m := newTestMTProto()
+	m.seq.SetTimeOffset(0)
+
+	first := utils.GenerateMessageId(0) | 1
+	second := utils.GenerateMessageId(time.Millisecond) | 1
+	assert.NoError(t, m.checkMessageID(first, nil))
+
+	container := &serialize.MessageContainer{
+		{MsgID: first, Msg: &serialize.Pong{}},
+		{MsgID: second, Msg: &serialize.Pong{}},
+	}
+	var reported []error
+	m.errorHandler = func(err error) { reported = append(reported, err) }
+
+	err := m.checkReplay(&serialize.EncryptedMessage{MsgID: utils.GenerateMessageId(time.Second) | 1, Msg: container})
+	assert.NoError(t, err)
+	assert.Len(t, *container, 1)
+	assert.Equal(t, second, (*container)[0].MsgID)
+	assert.Len(t, reported, 1)
+}
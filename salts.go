@@ -0,0 +1,164 @@
+package mtproto
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/xelaj/mtproto/serialize"
+)
+
+// https://core.telegram.org/mtproto/service_messages#request-for-several-future-salts
+// соль сервера периодически меняется. что бы не ловить на каждую смену
+// bad_server_salt (и не переотправлять все висящие запросы), заранее
+// запрашиваем будущие соли и переключаемся на них сами
+
+const (
+	// сколько солей запрашиваем за раз (сервер отдает не больше 64)
+	futureSaltsCount = 64
+	// запрашиваем новые соли, когда известных осталось меньше чем на это время
+	futureSaltsRefreshBefore = time.Hour
+	// переключаемся на новую соль чуть раньше, чем истечет старая
+	saltSwitchMargin = time.Minute
+	// как часто проверяем соль, даже если ничего не должно измениться
+	saltCheckInterval = 10 * time.Minute
+)
+
+// saltManager хранит будущие соли, отсортированные по ValidSince
+type saltManager struct {
+	mutex sync.Mutex
+	salts []*serialize.FutureSalt
+}
+
+func (s *saltManager) set(salts []*serialize.FutureSalt) {
+	sorted := make([]*serialize.FutureSalt, len(salts))
+	copy(sorted, salts)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ValidSince < sorted[j].ValidSince })
+
+	s.mutex.Lock()
+	s.salts = sorted
+	s.mutex.Unlock()
+}
+
+func (s *saltManager) all() []*serialize.FutureSalt {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.salts
+}
+
+// current возвращает самую свежую соль, которая действует в момент now (по
+// времени сервера). заодно забывает уже истекшие соли
+func (s *saltManager) current(now time.Time) (int64, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	deadline := now.Add(saltSwitchMargin).Unix()
+	for len(s.salts) > 0 && int64(s.salts[0].ValidUntil) <= deadline {
+		s.salts = s.salts[1:]
+	}
+
+	var salt *serialize.FutureSalt
+	for _, item := range s.salts {
+		if int64(item.ValidSince) > now.Unix() {
+			break
+		}
+		salt = item
+	}
+	if salt == nil {
+		return 0, false
+	}
+
+	return salt.Salt, true
+}
+
+// validUntil возвращает время, до которого хватит известных солей
+func (s *saltManager) validUntil() time.Time {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(s.salts) == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(int64(s.salts[len(s.salts)-1].ValidUntil), 0)
+}
+
+// nextSwitch возвращает время, когда соль в следующий раз может поменяться
+func (s *saltManager) nextSwitch(now time.Time) time.Time {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	next := now.Add(saltCheckInterval)
+	for _, item := range s.salts {
+		since := time.Unix(int64(item.ValidSince), 0)
+		if since.After(now) && since.Before(next) {
+			next = since
+		}
+		until := time.Unix(int64(item.ValidUntil), 0).Add(-saltSwitchMargin)
+		if until.After(now) && until.Before(next) {
+			next = until
+		}
+	}
+
+	return next
+}
+
+func (m *MTProto) setServerSalt(salt int64) {
+	m.mutex.Lock()
+	m.serverSalt = salt
+	m.mutex.Unlock()
+}
+
+// updateSalt переключает соль на ту, которая сейчас должна действовать, и
+// запрашивает новые соли, если известные скоро закончатся
+func (m *MTProto) updateSalt(ctx context.Context) error {
+	now := time.Now().Add(m.seq.TimeOffset())
+
+	if m.salts.validUntil().Before(now.Add(futureSaltsRefreshBefore)) {
+		data, err := m.makeRequestContext(ctx, &GetFutureSaltsParams{Num: futureSaltsCount})
+		if err != nil {
+			return errors.Wrap(err, "getting future salts")
+		}
+		resp, ok := data.(*serialize.FutureSalts)
+		if !ok {
+			return errors.New("got invalid response type: " + reflect.TypeOf(data).String())
+		}
+		m.salts.set(resp.Salts)
+	}
+
+	salt, ok := m.salts.current(now)
+	if !ok || salt == m.GetServerSalt() {
+		return nil
+	}
+
+	m.setServerSalt(salt)
+	err := m.SaveSession()
+	if err != nil {
+		return errors.Wrap(err, "saving session")
+	}
+
+	return nil
+}
+
+// startRotatingSalts следит, что бы соль всегда была актуальной
+func (m *MTProto) startRotatingSalts(ctx context.Context) {
+	go func() {
+		for {
+			err := m.updateSalt(ctx)
+			if err != nil && ctx.Err() == nil {
+				m.reportError(errors.Wrap(err, "updating salt"))
+			}
+
+			now := time.Now().Add(m.seq.TimeOffset())
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(m.salts.nextSwitch(now).Sub(now)):
+			}
+		}
+	}()
+}
@@ -0,0 +1,72 @@
+package mtproto
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/xelaj/mtproto/serialize"
+)
+
+func TestSaltManager(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	at := func(d time.Duration) int32 { return int32(now.Add(d).Unix()) }
+
+	s := &saltManager{}
+	_, ok := s.current(now)
+	assert.False(t, ok)
+	assert.True(t, s.validUntil().IsZero())
+
+	s.set([]*serialize.FutureSalt{
+		{ValidSince: at(30 * time.Minute), ValidUntil: at(90 * time.Minute), Salt: 2},
+		{ValidSince: at(-30 * time.Minute), ValidUntil: at(30 * time.Minute), Salt: 1},
+		{ValidSince: at(90 * time.Minute), ValidUntil: at(150 * time.Minute), Salt: 3},
+	})
+	assert.True(t, now.Add(150*time.Minute).Equal(s.validUntil()))
+
+	salt, ok := s.current(now)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), salt)
+	// ничего не меняется, проверяем через обычный интервал
+	assert.True(t, now.Add(saltCheckInterval).Equal(s.nextSwitch(now)))
+	// следующая проверка за минуту до истечения первой соли
+	assert.True(t, now.Add(29*time.Minute).Equal(s.nextSwitch(now.Add(25*time.Minute))))
+
+	// за минуту до истечения старая соль забывается
+	_, ok = s.current(now.Add(29*time.Minute + time.Second))
+	assert.False(t, ok)
+	salt, ok = s.current(now.Add(31 * time.Minute))
+	assert.True(t, ok)
+	assert.Equal(t, int64(2), salt)
+	assert.Len(t, s.all(), 2)
+
+	_, ok = s.current(now.Add(3 * time.Hour))
+	assert.False(t, ok)
+	assert.Empty(t, s.all())
+}
+
+func TestSessionFutureSalts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mtproto")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "session.json")
+
+	s := &Session{
+		Key:         []byte{1, 2, 3},
+		Hash:        []byte{4, 5},
+		Salt:        []byte{6, 7, 8, 9, 10, 11, 12, 13},
+		Hostname:    "127.0.0.1:443",
+		FutureSalts: []*serialize.FutureSalt{{ValidSince: 10, ValidUntil: 20, Salt: -30}},
+	}
+	assert.NoError(t, SaveSession(s, path))
+
+	loaded, err := LoadSession(path)
+	assert.NoError(t, err)
+	assert.Equal(t, s, loaded)
+}
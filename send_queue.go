@@ -0,0 +1,378 @@
+package mtproto
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/xelaj/mtproto/serialize"
+	"github.com/xelaj/mtproto/utils"
+)
+
+// https://core.telegram.org/mtproto/service_messages#simple-container
+// зашифрованные сообщения не отправляются сразу, а складываются в очередь.
+// отдельная горутина ждет немного, пока накопятся другие сообщения и ack'и, и
+// отправляет все, что накопилось, одним msg_container
+
+const (
+	// сколько ждем остальные сообщения после первого, прежде чем отправить пачку
+	sendFlushDelay = 5 * time.Millisecond
+
+	// ограничения сервера на один контейнер
+	maxContainerMessages = 1020
+	maxContainerSize     = 1044448
+
+	// сколько последних контейнеров помним, что бы по msg_id контейнера
+	// найти сообщения, которые в нем были
+	rememberedContainers = 100
+
+	// сколько последних отправленных сообщений помним, что бы отправить их
+	// заново, если сервер попросит через msg_resend_req
+	rememberedMessages = 1000
+
+	// запросы больше этого размера по умолчанию сжимаются
+	defaultGzipThreshold = 512
+
+	// msg_id, seqno и длина перед каждым сообщением в контейнере
+	containerItemHeaderLen = serialize.LongLen + serialize.WordLen + serialize.WordLen
+)
+
+// queuedMessage это сообщение, которое ждет отправки. в sent приходит
+// результат записи пакета, в котором сообщение ушло
+type queuedMessage struct {
+	msgID int64
+	seqNo int32
+	body  []byte
+	sent  chan error
+}
+
+type sendQueue struct {
+	mutex sync.Mutex
+	items []*queuedMessage
+	acks  []int64
+
+	// сигнал отправляющей горутине, что в очереди что-то появилось
+	wakeup chan struct{}
+
+	// номер отправляющей горутины (то есть соединения). горутина старого
+	// соединения может завершиться уже после того, как запустилась новая, и
+	// по номеру понятно, что очередь ей больше не принадлежит
+	generation uint64
+
+	// msg_id контейнера -> msg_id сообщений в нем
+	containers     map[int64][]int64
+	containerOrder []int64
+
+	// msg_id -> уже отправленное content-related сообщение
+	sentMessages map[int64]*queuedMessage
+	sentOrder    []int64
+}
+
+func newSendQueue() *sendQueue {
+	return &sendQueue{
+		wakeup:       make(chan struct{}, 1),
+		containers:   make(map[int64][]int64),
+		sentMessages: make(map[int64]*queuedMessage),
+	}
+}
+
+func (q *sendQueue) push(item *queuedMessage) {
+	q.mutex.Lock()
+	q.items = append(q.items, item)
+	q.mutex.Unlock()
+	q.notify()
+}
+
+// ack добавляет id в следующий msgs_ack
+func (q *sendQueue) ack(msgID int64) {
+	q.mutex.Lock()
+	q.acks = append(q.acks, msgID)
+	q.mutex.Unlock()
+	q.notify()
+}
+
+func (q *sendQueue) notify() {
+	select {
+	case q.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// take забирает из очереди столько сообщений, сколько влезет в один контейнер.
+// сообщение, которое само больше контейнера, забирается в одиночку
+func (q *sendQueue) take() []*queuedMessage {
+	return q.takeReserving(0, 0)
+}
+
+// takeReserving работает как take, но оставляет в контейнере место еще под
+// reservedCount сообщений общим размером reservedSize (например, под msgs_ack)
+func (q *sendQueue) takeReserving(reservedCount, reservedSize int) []*queuedMessage {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	size := reservedSize
+	count := 0
+	for count < len(q.items) && count+reservedCount < maxContainerMessages {
+		itemSize := containerItemHeaderLen + len(q.items[count].body)
+		if count+reservedCount > 0 && size+itemSize > maxContainerSize {
+			break
+		}
+		size += itemSize
+		count++
+	}
+
+	items := q.items[:count:count]
+	q.items = q.items[count:]
+	return items
+}
+
+func (q *sendQueue) takeAcks() []int64 {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	acks := q.acks
+	q.acks = nil
+	return acks
+}
+
+func (q *sendQueue) rememberContainer(msgID int64, items []int64) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	q.containers[msgID] = items
+	q.containerOrder = append(q.containerOrder, msgID)
+	if len(q.containerOrder) > rememberedContainers {
+		delete(q.containers, q.containerOrder[0])
+		q.containerOrder = q.containerOrder[1:]
+	}
+}
+
+// containerItems возвращает msg_id сообщений в контейнере, или nil, если
+// msgID не контейнер (или его уже забыли)
+func (q *sendQueue) containerItems(msgID int64) []int64 {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return q.containers[msgID]
+}
+
+// rememberSent запоминает отправленные content-related сообщения. сообщения
+// старше maxMessageAge сервер все равно не примет, поэтому их можно забыть
+func (q *sendQueue) rememberSent(items []*queuedMessage, now time.Time) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	for _, item := range items {
+		// нечетный seqno только у content-related сообщений
+		if item.seqNo&1 == 0 {
+			continue
+		}
+		q.sentMessages[item.msgID] = item
+		q.sentOrder = append(q.sentOrder, item.msgID)
+	}
+
+	for len(q.sentOrder) > 0 {
+		id := q.sentOrder[0]
+		if len(q.sentOrder) <= rememberedMessages && !utils.MessageIdTime(id).Before(now.Add(-maxMessageAge)) {
+			break
+		}
+		delete(q.sentMessages, id)
+		q.sentOrder = q.sentOrder[1:]
+	}
+}
+
+// sent возвращает копию отправленного сообщения с тем же msg_id и seqno, или
+// nil, если такого сообщения не помним
+func (q *sendQueue) sent(msgID int64) *queuedMessage {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	item, ok := q.sentMessages[msgID]
+	if !ok {
+		return nil
+	}
+
+	return &queuedMessage{
+		msgID: item.msgID,
+		seqNo: item.seqNo,
+		body:  item.body,
+		sent:  make(chan error, 1),
+	}
+}
+
+// nextGeneration отдает очередь новой отправляющей горутине
+func (q *sendQueue) nextGeneration() uint64 {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.generation++
+	return q.generation
+}
+
+// failAll отдает err всем сообщениям, которые так и не ушли. если очередь уже
+// отдана горутине нового соединения (generation устарел), ничего не делает:
+// сообщения в ней уже для нового соединения
+func (q *sendQueue) failAll(generation uint64, err error) {
+	q.mutex.Lock()
+	if q.generation != generation {
+		q.mutex.Unlock()
+		return
+	}
+	items := q.items
+	q.items = nil
+	q.mutex.Unlock()
+
+	for _, item := range items {
+		item.sent <- err
+	}
+}
+
+// encodedObject это уже закодированный объект, который нужно просто положить
+// в сообщение как есть
+type encodedObject []byte
+
+func (o encodedObject) CRC() uint32 {
+	return binary.LittleEndian.Uint32(o)
+}
+
+func (o encodedObject) Encode() []byte {
+	return o
+}
+
+func (o encodedObject) DecodeFrom(d *serialize.Decoder) {
+	panic("not acceptable")
+}
+
+// newQueuedMessage выдает сообщению msg_id и seqno. content-related это
+// сообщения, которые требуют ack
+func (m *MTProto) newQueuedMessage(msg serialize.TL, contentRelated bool) *queuedMessage {
+	body := msg.Encode()
+	if contentRelated {
+		body = m.maybeGzip(body)
+	}
+
+	item := &queuedMessage{
+		body: body,
+		sent: make(chan error, 1),
+	}
+	item.msgID, item.seqNo = m.seq.Next(contentRelated)
+
+	return item
+}
+
+// maybeGzip заворачивает большое сообщение в gzip_packed, если от этого оно
+// действительно становится меньше
+func (m *MTProto) maybeGzip(body []byte) []byte {
+	if m.gzipThreshold <= 0 || len(body) < m.gzipThreshold {
+		return body
+	}
+
+	packed := (&serialize.GzipPacked{Obj: encodedObject(body)}).Encode()
+	if len(packed) >= len(body) {
+		return body
+	}
+
+	return packed
+}
+
+// startSending запускает горутину, которая отправляет накопившиеся в очереди
+// сообщения. при ошибке записи соединение считается мертвым
+func (m *MTProto) startSending(ctx context.Context) {
+	generation := m.sendQueue.nextGeneration()
+	go func() {
+		defer m.sendQueue.failAll(generation, errors.New("connection closed"))
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-m.sendQueue.wakeup:
+			}
+
+			// даем остальным сообщениям немного времени попасть в ту же пачку
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(sendFlushDelay):
+			}
+
+			for ctx.Err() == nil {
+				sent, err := m.flushSendQueue()
+				if err != nil {
+					m.connectionLost(ctx, errors.Wrap(err, "sending messages"))
+					return
+				}
+				if !sent {
+					break
+				}
+			}
+		}
+	}()
+}
+
+// flushSendQueue отправляет один пакет из того, что лежит в очереди. если
+// отправлять было нечего, возвращает false
+func (m *MTProto) flushSendQueue() (bool, error) {
+	var items []*queuedMessage
+	if acks := m.sendQueue.takeAcks(); len(acks) > 0 {
+		// под ack заранее оставляем место, иначе полный контейнер вместе с ним
+		// выйдет за ограничения сервера
+		ack := m.newQueuedMessage(&serialize.MsgsAck{MsgIds: acks}, false)
+		items = append(m.sendQueue.takeReserving(1, containerItemHeaderLen+len(ack.body)), ack)
+	} else {
+		items = m.sendQueue.take()
+	}
+	if len(items) == 0 {
+		return false, nil
+	}
+
+	data, err := m.packMessages(items).Serialize(m)
+	if err == nil {
+		_, transport := m.connection()
+		err = transport.WritePacket(data)
+	}
+
+	if err == nil {
+		m.sendQueue.rememberSent(items, time.Now().Add(m.seq.TimeOffset()))
+	}
+	for _, item := range items {
+		item.sent <- err
+	}
+
+	return true, err
+}
+
+// packMessages кладет сообщения в контейнер. одно сообщение отправляется как есть
+func (m *MTProto) packMessages(items []*queuedMessage) *serialize.EncryptedMessage {
+	if len(items) == 1 {
+		return &serialize.EncryptedMessage{
+			Msg:         encodedObject(items[0].body),
+			MsgID:       items[0].msgID,
+			SeqNo:       items[0].seqNo,
+			AuthKeyHash: m.authKeyHash,
+		}
+	}
+
+	container := make(serialize.MessageContainer, len(items))
+	ids := make([]int64, len(items))
+	for i, item := range items {
+		container[i] = &serialize.EncryptedMessage{
+			Msg:   encodedObject(item.body),
+			MsgID: item.msgID,
+			SeqNo: item.seqNo,
+		}
+		ids[i] = item.msgID
+	}
+
+	// контейнер не требует ack, а его msg_id должен быть больше, чем у
+	// всех сообщений внутри, это гарантирует m.seq
+	msg := &serialize.EncryptedMessage{
+		Msg:         &container,
+		AuthKeyHash: m.authKeyHash,
+	}
+	msg.MsgID, msg.SeqNo = m.seq.Next(false)
+
+	m.sendQueue.rememberContainer(msg.MsgID, ids)
+	return msg
+}
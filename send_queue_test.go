@@ -0,0 +1,163 @@
+package mtproto
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/xelaj/go-dry"
+
+	"github.com/xelaj/mtproto/serialize"
+)
+
+func TestSendQueueTake(t *testing.T) {
+	q := newSendQueue()
+	for i := 0; i < maxContainerMessages+10; i++ {
+		q.push(&queuedMessage{body: make([]byte, 4)})
+	}
+	assert.Len(t, q.take(), maxContainerMessages)
+	assert.Len(t, q.take(), 10)
+	assert.Len(t, q.take(), 0)
+
+	// большие сообщения не влезают в один контейнер вместе
+	q.push(&queuedMessage{body: make([]byte, maxContainerSize/2)})
+	q.push(&queuedMessage{body: make([]byte, maxContainerSize/2)})
+	q.push(&queuedMessage{body: make([]byte, maxContainerSize*2)})
+	assert.Len(t, q.take(), 1)
+	assert.Len(t, q.take(), 1)
+	assert.Len(t, q.take(), 1)
+
+	// место под ack
+	for i := 0; i < maxContainerMessages; i++ {
+		q.push(&queuedMessage{body: make([]byte, 4)})
+	}
+	assert.Len(t, q.takeReserving(1, containerItemHeaderLen+16), maxContainerMessages-1)
+	assert.Len(t, q.take(), 1)
+
+	q.push(&queuedMessage{body: make([]byte, maxContainerSize-containerItemHeaderLen)})
+	assert.Len(t, q.takeReserving(1, containerItemHeaderLen+16), 0, "with ack it doesn't fit")
+	assert.Len(t, q.take(), 1)
+}
+
+func TestSendQueueFailAllGeneration(t *testing.T) {
+	q := newSendQueue()
+	old := q.nextGeneration()
+	current := q.nextGeneration()
+
+	item := &queuedMessage{sent: make(chan error, 1)}
+	q.push(item)
+
+	// горутина старого соединения завершилась позже, чем запустилась новая
+	q.failAll(old, errors.New("connection closed"))
+	assert.Empty(t, item.sent)
+	assert.Len(t, q.items, 1)
+
+	q.failAll(current, errors.New("connection closed"))
+	assert.Error(t, <-item.sent)
+	assert.Empty(t, q.items)
+}
+
+func TestFlushSendQueueWithAck(t *testing.T) {
+	m := newTestMTProto()
+	m.encrypted = true
+	m.SetAuthKey(dry.RandomBytes(256))
+	tr := &captureTransport{packets: make(chan []byte, 10)}
+	m.setConnection(nil, tr)
+
+	for i := 0; i < maxContainerMessages; i++ {
+		m.sendQueue.push(m.newQueuedMessage(&PingParams{PingID: int64(i)}, true))
+	}
+	m.sendQueue.ack(100)
+
+	// ack идет в первом контейнере, последнее сообщение переносится во второй
+	sent, err := m.flushSendQueue()
+	assert.True(t, sent)
+	assert.NoError(t, err)
+	assert.Len(t, m.sendQueue.items, 1)
+	assert.Empty(t, m.sendQueue.acks)
+}
+
+func TestSendQueueBatching(t *testing.T) {
+	m := newTestMTProto()
+	m.encrypted = true
+	m.SetAuthKey(dry.RandomBytes(256))
+	m.idsToAck = make(map[int64]struct{})
+	tr := &captureTransport{packets: make(chan []byte, 10)}
+	m.setConnection(nil, tr)
+
+	// все кладем в очередь до того, как запустится отправка, что бы не
+	// зависеть от того, когда планировщик запустит горутины
+	m.sendQueue.ack(100)
+	var items []*queuedMessage
+	for i := 0; i < 3; i++ {
+		item := m.newQueuedMessage(&serialize.MsgsAck{MsgIds: []int64{int64(i)}}, false)
+		m.sendQueue.push(item)
+		items = append(items, item)
+	}
+
+	ctx, stop := context.WithCancel(context.Background())
+	defer stop()
+	m.startSending(ctx)
+
+	for _, item := range items {
+		assert.NoError(t, <-item.sent)
+	}
+
+	// все ушло одним пакетом
+	assert.Len(t, tr.packets, 1)
+}
+
+func TestPackMessages(t *testing.T) {
+	m := newTestMTProto()
+
+	m.mutex.Lock()
+	items := []*queuedMessage{
+		m.newQueuedMessage(&serialize.MsgsAck{MsgIds: []int64{1}}, false),
+		m.newQueuedMessage(&serialize.MsgsAck{MsgIds: []int64{2, 3}}, false),
+	}
+	m.mutex.Unlock()
+
+	// одиночное сообщение уходит без контейнера
+	msg := m.packMessages(items[:1])
+	assert.Equal(t, items[0].msgID, msg.MsgID)
+	assert.Equal(t, items[0].body, msg.Msg.Encode())
+
+	msg = m.packMessages(items)
+	assert.True(t, msg.MsgID > items[1].msgID, "container msg_id must be greater than inner ones")
+
+	d := serialize.NewDecoder(msg.Msg.Encode())
+	container, ok := d.PopObj().(*serialize.MessageContainer)
+	assert.NoError(t, d.Err())
+	if !assert.True(t, ok) {
+		return
+	}
+	assert.Equal(t, &serialize.MessageContainer{
+		{MsgID: items[0].msgID, SeqNo: items[0].seqNo, Msg: &serialize.MsgsAck{MsgIds: []int64{1}}},
+		{MsgID: items[1].msgID, SeqNo: items[1].seqNo, Msg: &serialize.MsgsAck{MsgIds: []int64{2, 3}}},
+	}, container)
+}
+
+func TestMaybeGzip(t *testing.T) {
+	m := newTestMTProto()
+	small := (&serialize.MsgsAck{MsgIds: []int64{1}}).Encode()
+	big := (&serialize.MsgsAck{MsgIds: make([]int64, 1000)}).Encode()
+	random := dry.RandomBytes(1000)
+
+	// сжатие выключено
+	assert.Equal(t, big, m.maybeGzip(big))
+
+	m.gzipThreshold = defaultGzipThreshold
+	assert.Equal(t, small, m.maybeGzip(small))
+	// случайные байты не сжимаются, отправляем как есть
+	assert.Equal(t, random, m.maybeGzip(random))
+
+	packed := m.maybeGzip(big)
+	assert.True(t, len(packed) < len(big))
+
+	d := serialize.NewDecoder(packed)
+	obj, ok := d.PopObj().(*serialize.GzipPacked)
+	assert.NoError(t, d.Err())
+	assert.True(t, ok)
+	assert.Equal(t, big, obj.Obj.Encode())
+}
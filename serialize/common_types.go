@@ -7,11 +7,11 @@ import (
 	"bytes"
 	"compress/gzip"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"reflect"
 
-	"github.com/k0kubun/pp"
-
-	"github.com/xelaj/go-dry"
+	"github.com/pkg/errors"
 )
 
 // TYPES
@@ -77,6 +77,87 @@ func (e *PQInnerData) DecodeFrom(d *Decoder) {
 	e.NewNonce = d.PopInt256()
 }
 
+// PQInnerDataDc то же, что и PQInnerData, но с номером датацентра. для
+// тестовых серверов к номеру прибавляется 10000, для медиа он отрицательный
+type PQInnerDataDc struct {
+	Pq          []byte
+	P           []byte
+	Q           []byte
+	Nonce       *Int128
+	ServerNonce *Int128
+	NewNonce    *Int256
+	Dc          int32
+}
+
+func (_ *PQInnerDataDc) CRC() uint32 {
+	return 0xa9f55f95
+}
+
+func (t *PQInnerDataDc) Encode() []byte {
+	buf := NewEncoder()
+	buf.PutUint(t.CRC())
+	buf.PutMessage(t.Pq)
+	buf.PutMessage(t.P)
+	buf.PutMessage(t.Q)
+	buf.PutInt128(t.Nonce)
+	buf.PutInt128(t.ServerNonce)
+	buf.PutInt256(t.NewNonce)
+	buf.PutInt(t.Dc)
+	return buf.GetBuffer()
+}
+
+func (e *PQInnerDataDc) DecodeFrom(d *Decoder) {
+	e.Pq = d.PopMessage()
+	e.P = d.PopMessage()
+	e.Q = d.PopMessage()
+	e.Nonce = d.PopInt128()
+	e.ServerNonce = d.PopInt128()
+	e.NewNonce = d.PopInt256()
+	e.Dc = d.PopInt()
+}
+
+// PQInnerDataTempDc нужен для создания временного ключа, который живет
+// ExpiresIn секунд
+type PQInnerDataTempDc struct {
+	Pq          []byte
+	P           []byte
+	Q           []byte
+	Nonce       *Int128
+	ServerNonce *Int128
+	NewNonce    *Int256
+	Dc          int32
+	ExpiresIn   int32
+}
+
+func (_ *PQInnerDataTempDc) CRC() uint32 {
+	return 0x56fddf88
+}
+
+func (t *PQInnerDataTempDc) Encode() []byte {
+	buf := NewEncoder()
+	buf.PutUint(t.CRC())
+	buf.PutMessage(t.Pq)
+	buf.PutMessage(t.P)
+	buf.PutMessage(t.Q)
+	buf.PutInt128(t.Nonce)
+	buf.PutInt128(t.ServerNonce)
+	buf.PutInt256(t.NewNonce)
+	buf.PutInt(t.Dc)
+	buf.PutInt(t.ExpiresIn)
+	return buf.GetBuffer()
+}
+
+func (e *PQInnerDataTempDc) DecodeFrom(d *Decoder) {
+	e.Pq = d.PopMessage()
+	e.P = d.PopMessage()
+	e.Q = d.PopMessage()
+	e.Nonce = d.PopInt128()
+	e.ServerNonce = d.PopInt128()
+	e.NewNonce = d.PopInt256()
+	e.Dc = d.PopInt()
+	e.ExpiresIn = d.PopInt()
+}
+
 type ServerDHParamsFail struct {
 	Nonce        *Int128
 	ServerNonce  *Int128
@@ -407,8 +488,41 @@ func (t *Pong) DecodeFrom(d *Decoder) {
 	t.PingID = d.PopLong()
 }
 
-// destroy_session_ok#e22045fc session_id:long = DestroySessionRes;
-// destroy_session_none#62d350c9 session_id:long = DestroySessionRes;
+type DestroySessionOk struct {
+	SessionID int64
+}
+
+func (t *DestroySessionOk) ImplementsDestroySessionRes() {}
+
+func (_ *DestroySessionOk) CRC() uint32 {
+	return 0xe22045fc
+}
+
+func (t *DestroySessionOk) Encode() []byte {
+	panic("makes no sense")
+}
+
+func (t *DestroySessionOk) DecodeFrom(d *Decoder) {
+	t.SessionID = d.PopLong()
+}
+
+type DestroySessionNone struct {
+	SessionID int64
+}
+
+func (t *DestroySessionNone) ImplementsDestroySessionRes() {}
+
+func (_ *DestroySessionNone) CRC() uint32 {
+	return 0x62d350c9
+}
+
+func (t *DestroySessionNone) Encode() []byte {
+	panic("makes no sense")
+}
+
+func (t *DestroySessionNone) DecodeFrom(d *Decoder) {
+	t.SessionID = d.PopLong()
+}
 
 type NewSessionCreated struct {
 	FirstMsgID int64
@@ -450,23 +564,37 @@ func (t *MessageContainer) Encode() []byte {
 
 		buf.PutLong(msg.MsgID)
 		buf.PutInt(msg.SeqNo)
-		//         msgID     seqNo     len             object
-		buf.PutInt(LongLen + WordLen + WordLen + int32(len(encoded)))
+		// bytes это длина только самого объекта, без заголовка
+		buf.PutInt(int32(len(encoded)))
 		buf.PutRawBytes(encoded)
 	}
 	return buf.GetBuffer()
 }
 
+// если какое-то сообщение не удалось разобрать, в контейнере остаются все
+// сообщения до него и само битое сообщение последним
 func (t *MessageContainer) DecodeFrom(d *Decoder) {
 	count := int(d.PopInt())
-	arr := make([]*EncryptedMessage, count)
+	// msgID, seqNo, длина и хотя бы crc объекта
+	const minMessageLen = LongLen + WordLen + WordLen + WordLen
+	if count < 0 || count > d.buf.Len()/minMessageLen {
+		d.setErr(fmt.Errorf("container has %v messages, but only %v bytes left", count, d.buf.Len()))
+	}
+	if d.err != nil {
+		return
+	}
+
+	arr := make([]*EncryptedMessage, 0, count)
 	for i := 0; i < count; i++ {
 		msg := new(EncryptedMessage)
 		msg.MsgID = d.PopLong()
 		msg.SeqNo = d.PopInt()
 		_ = d.PopInt() // size, но нам нахуй не нужен
-		msg.Msg = d.PopObj().(TL)
-		arr[i] = msg
+		msg.Msg = d.PopObj()
+		arr = append(arr, msg)
+		if d.err != nil {
+			break
+		}
 	}
 	*t = arr
 }
@@ -491,10 +619,13 @@ func (t *MsgCopy) Encode() []byte {
 }
 
 func (t *MsgCopy) DecodeFrom(d *Decoder) {
-	pp.Println(d)
-	panic("очень специфичный конструктор Message, надо сначала посмотреть, как это что это")
+	// очень специфичный конструктор Message, надо сначала посмотреть, как это что это
+	d.setErr(errors.New("decoding MsgCopy is not implemented"))
 }
 
+// MaxGzipUnpackedSize максимальный размер объекта после распаковки gzip_packed
+const MaxGzipUnpackedSize = 32 * 1024 * 1024
+
 type GzipPacked struct {
 	Obj TL
 }
@@ -504,45 +635,47 @@ func (_ *GzipPacked) CRC() uint32 {
 }
 
 func (t *GzipPacked) Encode() []byte {
-	panic("not implemented")
+	var packed bytes.Buffer
+	gz := gzip.NewWriter(&packed)
+	// запись в bytes.Buffer не может вернуть ошибку
+	_, _ = gz.Write(t.Obj.Encode())
+	_ = gz.Close()
+
+	buf := NewEncoder()
+	buf.PutCRC(t.CRC())
+	buf.PutMessage(packed.Bytes())
+	return buf.Result()
 }
 
 func (t *GzipPacked) DecodeFrom(d *Decoder) {
-	// TODO: СТАНДАРТНЫЙ СУКА ПАКЕТ gzip пишет "gzip: invalid header". при этом как я разобрался, в сам гзип попадает кусок, который находится за миллиард бит от реального сообщения
-	//       например: сообщение начинается с 0x1f 0x8b 0x08 0x00 ..., но при этом в сам гзип отдается кусок, который дальше начала сообщения за 500+ байт
-	//! вот ЭТОТ кусок работает. так что наверное не будем трогать, дай бог чтоб работал
-
-	obj := make([]byte, 0, 4096)
-
-	var buf bytes.Buffer
-	_, _ = buf.Write(d.PopMessage())
-	gz, err := gzip.NewReader(&buf)
-	dry.PanicIfErr(err)
-	b := make([]byte, 4096)
-	for {
-		n, _ := gz.Read(b)
-
-		obj = append(obj, b[0:n]...)
-		if n <= 0 {
-			break
-		}
+	data := d.PopMessage()
+	if d.err != nil {
+		return
 	}
 
-	decoder := NewDecoder(obj)
-	t.Obj = decoder.PopObj()
-
-	//? это то что я пытался сделать
-	// data := d.PopMessage()
-	// gz, err := gzip.NewReader(bytes.NewBuffer(data))
-	// dry.PanicIfErr(err)
-
-	// decompressed, err := ioutil.ReadAll(gz)
-	// dry.PanicIfErr(err)
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		d.setErr(errors.Wrap(err, "reading gzip"))
+		return
+	}
 
-	// decoder := NewDecoder(decompressed)
-	// t.Obj = decoder.PopObj()
+	// распаковываем не больше MaxGzipUnpackedSize, что бы маленький пакет не
+	// смог распаковаться в гигабайты
+	obj, err := ioutil.ReadAll(io.LimitReader(gz, MaxGzipUnpackedSize+1))
+	if err != nil {
+		d.setErr(errors.Wrap(err, "reading gzip"))
+		return
+	}
+	if len(obj) > MaxGzipUnpackedSize {
+		d.setErr(fmt.Errorf("gzipped object is bigger than %v bytes", MaxGzipUnpackedSize))
+		return
+	}
 
-	// return
+	decoder := NewDecoder(obj)
+	t.Obj = decoder.PopObj()
+	if decoder.err != nil {
+		d.setErr(errors.Wrap(decoder.err, "decoding gzipped object"))
+	}
 }
 
 type MsgsAck struct {
@@ -617,6 +750,39 @@ func (t *BadServerSalt) DecodeFrom(d *Decoder) {
 
 // msg_new_detailed_info#809db6df answer_msg_id:long bytes:int status:int = MsgDetailedInfo;
 
+// BindAuthKeyInner это сообщение о привязке временного ключа к постоянному,
+// шифруется постоянным ключом
+type BindAuthKeyInner struct {
+	Nonce         int64
+	TempAuthKeyID int64
+	PermAuthKeyID int64
+	TempSessionID int64
+	ExpiresAt     int32
+}
+
+func (_ *BindAuthKeyInner) CRC() uint32 {
+	return 0x75a3f765
+}
+
+func (t *BindAuthKeyInner) Encode() []byte {
+	buf := NewEncoder()
+	buf.PutCRC(t.CRC())
+	buf.PutLong(t.Nonce)
+	buf.PutLong(t.TempAuthKeyID)
+	buf.PutLong(t.PermAuthKeyID)
+	buf.PutLong(t.TempSessionID)
+	buf.PutInt(t.ExpiresAt)
+	return buf.Result()
+}
+
+func (t *BindAuthKeyInner) DecodeFrom(d *Decoder) {
+	t.Nonce = d.PopLong()
+	t.TempAuthKeyID = d.PopLong()
+	t.PermAuthKeyID = d.PopLong()
+	t.TempSessionID = d.PopLong()
+	t.ExpiresAt = d.PopInt()
+}
+
 type MsgResendReq struct {
 	MsgIds []int64
 }
@@ -627,11 +793,14 @@ func (_ *MsgResendReq) CRC() uint32 {
 }
 
 func (t *MsgResendReq) Encode() []byte {
-	panic("not implemented")
+	buf := NewEncoder()
+	buf.PutCRC(t.CRC())
+	buf.PutVector(t.MsgIds)
+	return buf.Result()
 }
 
 func (t *MsgResendReq) DecodeFrom(d *Decoder) {
-	panic("not implemented")
+	t.MsgIds = d.PopVector(int64Type).([]int64)
 }
 
 type MsgsStateReq struct {
@@ -644,11 +813,14 @@ func (_ *MsgsStateReq) CRC() uint32 {
 }
 
 func (t *MsgsStateReq) Encode() []byte {
-	panic("not implemented")
+	buf := NewEncoder()
+	buf.PutCRC(t.CRC())
+	buf.PutVector(t.MsgIds)
+	return buf.Result()
 }
 
 func (t *MsgsStateReq) DecodeFrom(d *Decoder) {
-	panic("not implemented")
+	t.MsgIds = d.PopVector(int64Type).([]int64)
 }
 
 type MsgsStateInfo struct {
@@ -662,11 +834,16 @@ func (_ *MsgsStateInfo) CRC() uint32 {
 }
 
 func (t *MsgsStateInfo) Encode() []byte {
-	panic("not implemented")
+	buf := NewEncoder()
+	buf.PutCRC(t.CRC())
+	buf.PutLong(t.ReqMsgId)
+	buf.PutMessage(t.Info)
+	return buf.Result()
 }
 
 func (t *MsgsStateInfo) DecodeFrom(d *Decoder) {
-	panic("not implemented")
+	t.ReqMsgId = d.PopLong()
+	t.Info = d.PopMessage()
 }
 
 type MsgsAllInfo struct {
@@ -680,11 +857,16 @@ func (_ *MsgsAllInfo) CRC() uint32 {
 }
 
 func (t *MsgsAllInfo) Encode() []byte {
-	panic("not implemented")
+	buf := NewEncoder()
+	buf.PutCRC(t.CRC())
+	buf.PutVector(t.MsgIds)
+	buf.PutMessage(t.Info)
+	return buf.Result()
 }
 
 func (t *MsgsAllInfo) DecodeFrom(d *Decoder) {
-	panic("not implemented")
+	t.MsgIds = d.PopVector(int64Type).([]int64)
+	t.Info = d.PopMessage()
 }
 
 type MsgsDetailedInfo struct {
@@ -700,11 +882,20 @@ func (_ *MsgsDetailedInfo) CRC() uint32 {
 }
 
 func (t *MsgsDetailedInfo) Encode() []byte {
-	panic("not implemented")
+	buf := NewEncoder()
+	buf.PutCRC(t.CRC())
+	buf.PutLong(t.MsgId)
+	buf.PutLong(t.AnswerMsgId)
+	buf.PutInt(t.Bytes)
+	buf.PutInt(t.Status)
+	return buf.Result()
 }
 
 func (t *MsgsDetailedInfo) DecodeFrom(d *Decoder) {
-	panic("not implemented")
+	t.MsgId = d.PopLong()
+	t.AnswerMsgId = d.PopLong()
+	t.Bytes = d.PopInt()
+	t.Status = d.PopInt()
 }
 
 type MsgsNewDetailedInfo struct {
@@ -719,11 +910,18 @@ func (_ *MsgsNewDetailedInfo) CRC() uint32 {
 }
 
 func (t *MsgsNewDetailedInfo) Encode() []byte {
-	panic("not implemented")
+	buf := NewEncoder()
+	buf.PutCRC(t.CRC())
+	buf.PutLong(t.AnswerMsgId)
+	buf.PutInt(t.Bytes)
+	buf.PutInt(t.Status)
+	return buf.Result()
 }
 
 func (t *MsgsNewDetailedInfo) DecodeFrom(d *Decoder) {
-	panic("not implemented")
+	t.AnswerMsgId = d.PopLong()
+	t.Bytes = d.PopInt()
+	t.Status = d.PopInt()
 }
 
 type ServerDHParams interface {
@@ -736,12 +934,26 @@ type SetClientDHParamsAnswer interface {
 	ImplementsSetClientDHParamsAnswer()
 }
 
+type RpcDropAnswer interface {
+	TL
+	ImplementsRpcDropAnswer()
+}
+
+type DestroySessionRes interface {
+	TL
+	ImplementsDestroySessionRes()
+}
+
 func GenerateCommonObject(constructorID uint32) (obj TL, isEnum bool, err error) {
 	switch constructorID {
 	case 0x05162463:
 		return &ResPQ{}, false, nil
 	case 0x83c95aec:
 		return &PQInnerData{}, false, nil
+	case 0xa9f55f95:
+		return &PQInnerDataDc{}, false, nil
+	case 0x56fddf88:
+		return &PQInnerDataTempDc{}, false, nil
 	case 0x79cb045d:
 		return &ServerDHParamsFail{}, false, nil
 	case 0xd0e8075c:
@@ -772,10 +984,10 @@ func GenerateCommonObject(constructorID uint32) (obj TL, isEnum bool, err error)
 		return &FutureSalts{}, false, nil
 	case 0x347773c5:
 		return &Pong{}, false, nil
-	//case 0xe22045fc:
-	//	return &destroy_session_ok{}, false, nil
-	//case 0x62d350c9:
-	//	return &destroy_session_none{}, false, nil
+	case 0xe22045fc:
+		return &DestroySessionOk{}, false, nil
+	case 0x62d350c9:
+		return &DestroySessionNone{}, false, nil
 	case 0x9ec20908:
 		return &NewSessionCreated{}, false, nil
 	case 0x73f1f8dc: //! SPECIFIC
@@ -802,6 +1014,12 @@ func GenerateCommonObject(constructorID uint32) (obj TL, isEnum bool, err error)
 		return &MsgsDetailedInfo{}, false, nil
 	case 0x809db6df:
 		return &MsgsNewDetailedInfo{}, false, nil
+	case 0x75a3f765:
+		return &BindAuthKeyInner{}, false, nil
+	case crc_boolTrue:
+		return &Bool{Value: true}, true, nil
+	case crc_boolFalse:
+		return &Bool{Value: false}, true, nil
 	default:
 		return nil, false, errs.NotFound("constructorID", fmt.Sprintf("%#v", constructorID))
 	}
@@ -16,8 +16,12 @@ import (
 	"github.com/xelaj/go-dry"
 )
 
+// Decoder читает TL объекты из набора байт. если данные битые, Decoder не
+// паникует: первая ошибка запоминается (см. Err), а все Pop* методы после нее
+// возвращают нулевые значения
 type Decoder struct {
 	buf *bytes.Buffer
+	err error
 }
 
 func NewDecoder(input []byte) *Decoder {
@@ -26,6 +30,17 @@ func NewDecoder(input []byte) *Decoder {
 	}
 }
 
+// Err возвращает первую ошибку, которая случилась при декодировании
+func (d *Decoder) Err() error {
+	return d.err
+}
+
+func (d *Decoder) setErr(err error) {
+	if d.err == nil {
+		d.err = err
+	}
+}
+
 func (d *Decoder) PopLong() int64 {
 	pp.Println("PopLong")
 	val := make([]byte, LongLen)
@@ -68,6 +83,13 @@ func (d *Decoder) PopInt256() *Int256 {
 
 func (d *Decoder) PopRawBytes(size int) []byte {
 	pp.Println("PopRawBytes")
+	if size < 0 || size > d.buf.Len() {
+		d.setErr(fmt.Errorf("expected to read %v bytes, but only %v left", size, d.buf.Len()))
+	}
+	if d.err != nil {
+		return nil
+	}
+
 	val := make([]byte, size)
 	d.mustRead(val)
 	return val
@@ -96,6 +118,13 @@ func (d *Decoder) PopMessage() []byte {
 		lenNumberSize = WordLen
 	}
 
+	if realSize > d.buf.Len() {
+		d.setErr(fmt.Errorf("message length is %v, but only %v bytes left", realSize, d.buf.Len()))
+	}
+	if d.err != nil {
+		return nil
+	}
+
 	buf := make([]byte, realSize)
 	d.mustRead(buf)
 	readLen := lenNumberSize + realSize // lenNumberSize это сколько байт ушло на описание длины а realsize это сколько мы по факту прочитали
@@ -104,10 +133,14 @@ func (d *Decoder) PopMessage() []byte {
 		d.mustRead(voidBytes) // читаем оставшиеся пустые байты. пустые, потому что длина слова 4 байта, может остаться 1,2 или 3 лишних байта
 		for _, b := range voidBytes {
 			if b != 0 {
-				panic("some of bytes doesn't equal zero: " + fmt.Sprintf("%#v", voidBytes))
+				d.setErr(errors.New("some of bytes doesn't equal zero: " + fmt.Sprintf("%#v", voidBytes)))
+				break
 			}
 		}
 	}
+	if d.err != nil {
+		return nil
+	}
 
 	return buf
 }
@@ -129,19 +162,25 @@ func (d *Decoder) PopBigInt() *big.Int {
 
 func (d *Decoder) PopBool() bool {
 	pp.Println("PopBool")
-	switch crc := d.PopUint(); crc {
+	crc := d.PopUint()
+	if d.err != nil {
+		return false
+	}
+
+	switch crc {
 	case crc_boolTrue:
 		return true
 	case crc_boolFalse:
 		return false
 	default:
-		panic("not a bool value, actually: " + fmt.Sprintf("%#v", crc))
+		d.setErr(errors.New("not a bool value, actually: " + fmt.Sprintf("%#v", crc)))
+		return false
 	}
 }
 
 func (d *Decoder) PopNull() interface{} {
 	if d.PopUint() != crc_null {
-		panic("not a bool value, actually")
+		d.setErr(errors.New("not a null value, actually"))
 	}
 	return nil
 }
@@ -150,9 +189,14 @@ func (d *Decoder) PopNull() interface{} {
 // Следует использовать только вкупе с функциями-генераторами, которые должны
 // быть объявлены в CustomDecoders. поиск и создание объекта выполняется в том
 // порядке, в котором были объявлены сами функции в CustomDecoders.
+// если при разборе полей случилась ошибка, возвращается частично заполненный
+// объект, а ошибку можно получить через Err
 func (d *Decoder) PopObj() TL {
 	pp.Println("PopObj")
 	constructorID := d.PopCRC()
+	if d.err != nil {
+		return nil
+	}
 
 	var obj TL
 	var isEnum bool
@@ -163,12 +207,14 @@ func (d *Decoder) PopObj() TL {
 			continue
 		}
 		if err != nil {
-			panic(err)
+			d.setErr(err)
+			return nil
 		}
 		break
 	}
 	if obj == nil {
-		panic(errs.NotFound("constructorID", fmt.Sprintf("%#v", constructorID)))
+		d.setErr(errs.NotFound("constructorID", fmt.Sprintf("%#v", constructorID)))
+		return nil
 	}
 
 	if !isEnum {
@@ -182,9 +228,12 @@ func (d *Decoder) PopToObjUsingReflection(item TL, ignoreCRCReading bool) {
 	pp.Println("PopToObjUsingReflection")
 	if !ignoreCRCReading {
 		crcCode := d.PopCRC()
+		if d.err != nil {
+			return
+		}
 		if crcCode != item.CRC() {
-			pp.Println("PANIC", d.GetRestOfMessage())
-			panic("invalid crc code: " + fmt.Sprintf("%#v", crcCode) + ", want: " + fmt.Sprintf("%#v", item.CRC()))
+			d.setErr(errors.New("invalid crc code: " + fmt.Sprintf("%#v", crcCode) + ", want: " + fmt.Sprintf("%#v", item.CRC())))
+			return
 		}
 	}
 
@@ -199,33 +248,42 @@ func (d *Decoder) PopToObjUsingReflection(item TL, ignoreCRCReading bool) {
 
 	value := reflect.ValueOf(item)
 	if value.Kind() != reflect.Ptr {
-		panic("not a pointer")
+		d.setErr(errors.New("not a pointer"))
+		return
 	}
 	value = reflect.Indirect(value)
 	if value.Kind() != reflect.Struct {
-		panic("not recieving on struct: " + value.Type().String() + " -> " + value.Kind().String())
+		d.setErr(errors.New("not recieving on struct: " + value.Type().String() + " -> " + value.Kind().String()))
+		return
 	}
 
 	vtyp := value.Type()
 
 	var optionalBitSet uint32
 
-	for i := 0; i < value.NumField(); i++ {
+	for i := 0; i < value.NumField() && d.err == nil; i++ {
 		ftyp := value.Field(i).Type()
 
 		// если в тегах указан flag значит нужно узнать, есть ли такой то бит, что бы уточнить, может вообще этот кусок пропустить?
 		tags, err := structtag.Parse(string(vtyp.Field(i).Tag))
-		dry.PanicIfErr(err)
+		if err != nil {
+			d.setErr(errors.Wrap(err, "parsing tags of "+vtyp.Field(i).Name))
+			return
+		}
 		flagTag, err := tags.Get("flag")
 		if err != nil {
 			if err.Error() != "tag does not exist" {
-				panic(err)
+				d.setErr(errors.Wrap(err, "parsing tags of "+vtyp.Field(i).Name))
+				return
 			}
 		}
 		if flagTag != nil {
 			fmt.Println(vtyp.Field(i).Name + " имеет теги flag " + flagTag.String())
 			triggerBit, err := strconv.Atoi(flagTag.Name)
-			dry.PanicIfErr(err)
+			if err != nil {
+				d.setErr(errors.Wrap(err, "parsing flag tag of "+vtyp.Field(i).Name))
+				return
+			}
 			if optionalBitSet&(1<<triggerBit) == 0 {
 				fmt.Println("бит не задан так что пропускаем")
 				continue
@@ -286,11 +344,11 @@ func (d *Decoder) PopToObjUsingReflection(item TL, ignoreCRCReading bool) {
 				d.PopToObjUsingReflection(value.Field(i).Interface().(TL), false)
 
 			} else {
-				panic("неизвестная штука: " + value.Field(i).Type().String())
+				d.setErr(errors.New("неизвестная штука: " + value.Field(i).Type().String()))
 			}
 
 		default:
-			panic("неизвестная штука: " + value.Field(i).Type().String())
+			d.setErr(errors.New("неизвестная штука: " + value.Field(i).Type().String()))
 		}
 	}
 
@@ -304,12 +362,24 @@ func (d *Decoder) PopCRC() uint32 {
 func (d *Decoder) PopVector(as reflect.Type) interface{} {
 	pp.Println("PopVector")
 	constructorID := d.PopCRC()
+	empty := reflect.MakeSlice(reflect.SliceOf(as), 0, 0).Interface()
+	if d.err != nil {
+		return empty
+	}
 
 	if constructorID != crc_vector {
-		pp.Println(d.buf.Bytes())
-		panic("not a vector: " + fmt.Sprintf("%#v", constructorID) + " want: 0x1cb5c415")
+		d.setErr(errors.New("not a vector: " + fmt.Sprintf("%#v", constructorID) + " want: 0x1cb5c415"))
+		return empty
 	}
 	size := int(d.PopUint())
+	// каждый элемент занимает хотя бы одно слово, так что мусор вместо длины
+	// не заставит выделить гигабайты памяти
+	if size < 0 || size > d.buf.Len()/WordLen {
+		d.setErr(fmt.Errorf("vector length is %v, but only %v bytes left", size, d.buf.Len()))
+	}
+	if d.err != nil {
+		return empty
+	}
 
 	x := reflect.MakeSlice(reflect.SliceOf(as), size, size)
 
@@ -340,20 +410,35 @@ func (d *Decoder) PopVector(as reflect.Type) interface{} {
 			d.PopToObjUsingReflection(n, false)
 			v = n
 		default:
-			panic("как обрабатывать? " + as.String())
+			d.setErr(errors.New("как обрабатывать? " + as.String()))
 		}
 
+		if d.err != nil {
+			return x.Slice(0, i).Interface()
+		}
 		x.Index(i).Set(reflect.ValueOf(v))
 	}
 
 	return x.Interface()
 }
 
+// mustRead читает ровно len(into) байт. если столько нет, запоминает ошибку и
+// заполняет into нулями
 func (d *Decoder) mustRead(into []byte) {
 	if len(into) == 0 {
 		return
 	}
-	n, err := d.buf.Read(into)
-	dry.PanicIfErr(errors.Wrap(err, fmt.Sprintf("read %v bytes", n)))
-	dry.PanicIf(n != len(into), fmt.Sprintf("expected to read equal %v bytes, got %v", len(into), n))
+	if d.err == nil {
+		n, err := d.buf.Read(into)
+		if err != nil {
+			d.setErr(errors.Wrap(err, fmt.Sprintf("read %v bytes", n)))
+		} else if n != len(into) {
+			d.setErr(fmt.Errorf("expected to read equal %v bytes, got %v", len(into), n))
+		}
+	}
+	if d.err != nil {
+		for i := range into {
+			into[i] = 0
+		}
+	}
 }
@@ -1,6 +1,8 @@
 package serialize
 
 import (
+	"bytes"
+	"compress/gzip"
 	"fmt"
 	"strconv"
 	"testing"
@@ -14,7 +16,7 @@ func TestPoppingInts(t *testing.T) {
 		input         []byte
 		leftBytes     []byte
 		expectedValue int32
-		expectPanic   bool
+		expectErr     bool
 	}{
 		{
 			[]byte{0x48, 0x0f, 0x00, 0x00},
@@ -42,19 +44,13 @@ func TestPoppingInts(t *testing.T) {
 		},
 	} {
 		t.Run("case "+strconv.Itoa(i), func(t *testing.T) {
-			defer func() {
-				r := recover()
-				if tcase.expectPanic && r == nil {
-					assert.Fail(t, "case %v: expected panic. didn't failed", i)
-				}
-
-				if !tcase.expectPanic && r != nil {
-					assert.Fail(t, "didn't expect panic. failed with:", r)
-				}
-			}()
-
 			d := NewDecoder(tcase.input)
 			result := d.PopInt()
+			if tcase.expectErr {
+				assert.Error(t, d.Err(), "case %v: expected error", i)
+			} else {
+				assert.NoError(t, d.Err())
+			}
 			assert.Equal(t, tcase.expectedValue, result)
 
 			assert.Equal(t, tcase.leftBytes, d.GetRestOfMessage())
@@ -67,7 +63,7 @@ func TestPoppingBools(t *testing.T) {
 		input         []byte
 		leftBytes     []byte
 		expectedValue bool
-		expectPanic   bool
+		expectErr     bool
 	}{
 		{
 			[]byte{0x37, 0x97, 0x79, 0xbc},
@@ -113,19 +109,13 @@ func TestPoppingBools(t *testing.T) {
 		},
 	} {
 		t.Run("case "+strconv.Itoa(i), func(t *testing.T) {
-			defer func() {
-				r := recover()
-				if tcase.expectPanic && r == nil {
-					assert.Fail(t, "case %v: expected panic. didn't failed", i)
-				}
-
-				if !tcase.expectPanic && r != nil {
-					assert.Fail(t, "didn't expect panic. failed with:", r)
-				}
-			}()
-
 			d := NewDecoder(tcase.input)
 			result := d.PopBool()
+			if tcase.expectErr {
+				assert.Error(t, d.Err(), "case %v: expected error", i)
+			} else {
+				assert.NoError(t, d.Err())
+			}
 			assert.Equal(t, tcase.expectedValue, result)
 
 			assert.Equal(t, tcase.leftBytes, d.GetRestOfMessage())
@@ -138,7 +128,7 @@ func TestPoppingMessages(t *testing.T) {
 		input         []byte
 		leftBytes     []byte
 		expectedValue string
-		expectPanic   bool
+		expectErr     bool
 	}{
 		{
 			[]byte{0x00, 0x00, 0x00, 0x00},
@@ -199,19 +189,13 @@ func TestPoppingMessages(t *testing.T) {
 		},
 	} {
 		t.Run("case "+strconv.Itoa(i), func(t *testing.T) {
-			defer func() {
-				r := recover()
-				if tcase.expectPanic && r == nil {
-					assert.Fail(t, "case %v: expected panic. didn't failed", i)
-				}
-
-				if !tcase.expectPanic && r != nil {
-					assert.Fail(t, "didn't expect panic. failed with:", r)
-				}
-			}()
-
 			d := NewDecoder(tcase.input)
 			result := d.PopString()
+			if tcase.expectErr {
+				assert.Error(t, d.Err(), "case %v: expected error", i)
+			} else {
+				assert.NoError(t, d.Err())
+			}
 			assert.Equal(t, tcase.expectedValue, result)
 
 			assert.Equal(t, tcase.leftBytes, d.GetRestOfMessage())
@@ -275,7 +259,7 @@ func TestPoppingBasicObjects(t *testing.T) {
 		input         []byte
 		leftBytes     []byte
 		expectedValue interface{}
-		expectPanic   bool
+		expectErr     bool
 	}{
 		{
 			[]byte{
@@ -310,19 +294,13 @@ func TestPoppingBasicObjects(t *testing.T) {
 		},
 	} {
 		t.Run("case "+strconv.Itoa(i), func(t *testing.T) {
-			defer func() {
-				r := recover()
-				if tcase.expectPanic && r == nil {
-					assert.Fail(t, "case %v: expected panic. didn't failed", i)
-				}
-
-				if !tcase.expectPanic && r != nil {
-					assert.Fail(t, "didn't expect panic. failed with:", r)
-				}
-			}()
-
 			d := NewDecoder(tcase.input)
 			result := d.PopObj()
+			if tcase.expectErr {
+				assert.Error(t, d.Err(), "case %v: expected error", i)
+			} else {
+				assert.NoError(t, d.Err())
+			}
 			assert.Equal(t, tcase.expectedValue, result)
 
 			assert.Equal(t, tcase.leftBytes, d.GetRestOfMessage())
@@ -660,3 +638,62 @@ var res = &telegram.Config{
 	BaseLangPackVersion:     0,
 }
 */
+
+func TestPoppingBrokenObjects(t *testing.T) {
+	for i, input := range [][]byte{
+		// неизвестный конструктор
+		{0x12, 0x34, 0x56, 0x78},
+		// строка обрезана
+		{0xaa, 0xaa, 0xaa, 0xaa, 0x0c, 0x64, 0x75, 0x6d},
+		// вектор на 0x7fffffff элементов
+		{0x98, 0xba, 0xdc, 0xfe, 0x15, 0xc4, 0xb5, 0x1c, 0xff, 0xff, 0xff, 0x7f},
+		// вместо вектора что-то другое
+		{0x98, 0xba, 0xdc, 0xfe, 0x00, 0x00, 0x00, 0x00},
+	} {
+		t.Run("case "+strconv.Itoa(i), func(t *testing.T) {
+			d := NewDecoder(input)
+			assert.NotPanics(t, func() { d.PopObj() })
+			assert.Error(t, d.Err())
+
+			// после ошибки все остальные вызовы возвращают нулевые значения
+			assert.Equal(t, int64(0), d.PopLong())
+			assert.Equal(t, "", d.PopString())
+		})
+	}
+}
+
+func TestGzipPacked(t *testing.T) {
+	ack := &MsgsAck{MsgIds: make([]int64, 1000)}
+	packed := (&GzipPacked{Obj: ack}).Encode()
+	assert.True(t, len(packed) < len(ack.Encode()))
+
+	d := NewDecoder(packed)
+	obj := d.PopObj()
+	assert.NoError(t, d.Err())
+	assert.Equal(t, &GzipPacked{Obj: ack}, obj)
+
+	// gzip бомба: несколько десятков килобайт, которые распаковываются в
+	// объект больше MaxGzipUnpackedSize
+	var bomb bytes.Buffer
+	gz := gzip.NewWriter(&bomb)
+	_, _ = gz.Write(make([]byte, MaxGzipUnpackedSize+1))
+	_ = gz.Close()
+
+	buf := NewEncoder()
+	buf.PutCRC((&GzipPacked{}).CRC())
+	buf.PutMessage(bomb.Bytes())
+
+	d = NewDecoder(buf.Result())
+	d.PopObj()
+	assert.Error(t, d.Err())
+}
+
+func TestPoppingBoolObject(t *testing.T) {
+	d := NewDecoder((&Bool{Value: true}).Encode())
+	assert.Equal(t, &Bool{Value: true}, d.PopObj())
+	assert.NoError(t, d.Err())
+
+	d = NewDecoder((&Bool{Value: false}).Encode())
+	assert.Equal(t, &Bool{Value: false}, d.PopObj())
+	assert.NoError(t, d.Err())
+}
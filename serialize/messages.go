@@ -9,12 +9,17 @@ import (
 
 	"github.com/k0kubun/pp"
 	"github.com/pkg/errors"
-	"github.com/xelaj/go-dry"
 
 	ige "github.com/xelaj/mtproto/aes_ige"
 	"github.com/xelaj/mtproto/utils"
 )
 
+// в MTProto 2.0 после сообщения должно быть от 12 до 1024 байт паддинга
+const (
+	minPaddingLen = 12
+	maxPaddingLen = 1024
+)
+
 type EncryptedMessage struct {
 	Msg         TL
 	MsgID       int64
@@ -26,20 +31,28 @@ type EncryptedMessage struct {
 	MsgKey    []byte
 }
 
-func (msg *EncryptedMessage) Serialize(client MessageInformator, requireToAck bool) []byte {
-	obj := serializePacket(client, msg.Msg, msg.MsgID, requireToAck)
-	encryptedData, err := ige.Encrypt(obj, client.GetAuthKey())
-	dry.PanicIfErr(err)
+// Serialize шифрует сообщение. msg_id и seqno берутся из самого сообщения, соль
+// и сессия из client
+func (msg *EncryptedMessage) Serialize(client MessageInformator) ([]byte, error) {
+	obj := serializePacket(client, msg.Msg, msg.MsgID, msg.SeqNo)
+	encryptedData, msgKey, err := ige.Encrypt(obj, client.GetAuthKey())
+	if err != nil {
+		return nil, errors.Wrap(err, "encrypting message")
+	}
 
 	buf := NewEncoder()
 	buf.PutRawBytes(utils.AuthKeyHash(client.GetAuthKey()))
-	buf.PutRawBytes(ige.MessageKey(obj))
+	buf.PutRawBytes(msgKey)
 	buf.PutRawBytes(encryptedData)
 
-	return buf.Result()
+	return buf.Result(), nil
 }
 
-func DeserializeEncryptedMessage(data, authKey []byte) (*EncryptedMessage, error) {
+// DeserializeEncryptedMessage расшифровывает и разбирает сообщение, которое
+// пришло в сессию sessionID. если не получилось разобрать сам объект, то вместе
+// с ошибкой возвращается сообщение с частично разобранным объектом, что бы
+// можно было понять, кому был ответ
+func DeserializeEncryptedMessage(data, authKey []byte, sessionID int64) (*EncryptedMessage, error) {
 	msg := new(EncryptedMessage)
 
 	buf := NewDecoder(data)
@@ -48,22 +61,44 @@ func DeserializeEncryptedMessage(data, authKey []byte) (*EncryptedMessage, error
 		return nil, errors.New("wrong encryption key")
 	}
 	msg.MsgKey = buf.PopRawBytes(Int128Len) // msgKey это хэш от расшифрованного набора байт, последние 16 символов
-	encryptedData := buf.PopRawBytes(len(data) - (LongLen + Int128Len))
+	// если транспорт добавил свой паддинг (padded intermediate), то он не кратен блоку aes
+	encryptedLen := len(data) - (LongLen + Int128Len)
+	encryptedData := buf.PopRawBytes(encryptedLen - encryptedLen%16)
+	if buf.Err() != nil {
+		return nil, errors.Wrap(buf.Err(), "reading message")
+	}
 
+	// проверка msg_key происходит при расшифровке
 	decrypted, err := ige.Decrypt(encryptedData, authKey, msg.MsgKey)
 	if err != nil {
 		return nil, errors.Wrap(err, "decrypting message")
 	}
-	pp.Println("decoded", decrypted)
 	buf = NewDecoder(decrypted)
 	msg.Salt = buf.PopLong()
 	msg.SessionID = buf.PopLong()
 	msg.MsgID = buf.PopLong()
 	msg.SeqNo = buf.PopInt()
 	messageLen := buf.PopInt()
+	if buf.Err() != nil {
+		return nil, errors.Wrap(buf.Err(), "reading message header")
+	}
 
-	if len(decrypted) < int(messageLen)-(LongLen+LongLen+LongLen+WordLen+WordLen) {
-		return nil, fmt.Errorf("message is smaller than it's defining: have %v, but messageLen is %v", len(decrypted), messageLen)
+	if msg.SessionID != sessionID {
+		return nil, fmt.Errorf("wrong session id: expected %v, got %v", sessionID, msg.SessionID)
+	}
+
+	const headerLen = LongLen + LongLen + LongLen + WordLen + WordLen
+	if messageLen < 0 || len(decrypted)-headerLen < int(messageLen) {
+		return nil, fmt.Errorf("message is smaller than it's defining: have %v, but messageLen is %v", len(decrypted)-headerLen, messageLen)
+	}
+	if messageLen%WordLen != 0 {
+		return nil, fmt.Errorf("message length is not divisible by %v: %v", WordLen, messageLen)
+	}
+
+	// https://core.telegram.org/mtproto/security_guidelines#checking-message-length
+	paddingLen := len(decrypted) - headerLen - int(messageLen)
+	if paddingLen < minPaddingLen || paddingLen > maxPaddingLen {
+		return nil, fmt.Errorf("invalid padding length: %v", paddingLen)
 	}
 
 	mod := msg.MsgID & 3
@@ -71,11 +106,10 @@ func DeserializeEncryptedMessage(data, authKey []byte) (*EncryptedMessage, error
 		return nil, fmt.Errorf("Wrong bits of message_id: %d", mod)
 	}
 
-	trimed := decrypted[0 : 32+messageLen] // суммарное сообщение, после расшифровки, с чет
-	if !bytes.Equal(dry.Sha1Byte(trimed)[4:20], msg.MsgKey) {
-		return nil, errors.New("Wrong message key, can't trust to sender")
-	}
 	msg.Msg = buf.PopObj()
+	if buf.Err() != nil {
+		return msg, errors.Wrap(buf.Err(), "decoding message")
+	}
 
 	return msg, nil
 	// TODO: мтпрото обновить msgID и seqNo
@@ -104,6 +138,9 @@ func DeserializeUnencryptedMessage(data []byte) (*UnencryptedMessage, error) {
 	_ = buf.PopRawBytes(LongLen) // authKeyHash, always 0 if unencrypted
 
 	msg.MsgID = buf.PopLong()
+	if buf.Err() != nil {
+		return nil, errors.Wrap(buf.Err(), "reading message header")
+	}
 
 	mod := msg.MsgID & 3
 	if mod != 1 && mod != 3 {
@@ -111,12 +148,16 @@ func DeserializeUnencryptedMessage(data []byte) (*UnencryptedMessage, error) {
 	}
 
 	messageLen := buf.PopUint()
-	if len(data)-(LongLen+LongLen+WordLen) != int(messageLen) {
+	// данных может быть больше, если транспорт добавил паддинг (padded intermediate)
+	if len(data)-(LongLen+LongLen+WordLen) < int(messageLen) {
 		pp.Println(len(data), int(messageLen), int(messageLen+(LongLen+LongLen+WordLen)))
-		return nil, fmt.Errorf("message not equal defined size: have %v, want %v", len(data), messageLen)
+		return nil, fmt.Errorf("message is smaller than it's defining: have %v, but messageLen is %v", len(data), messageLen)
 	}
 
 	obj := buf.PopObj()
+	if buf.Err() != nil {
+		return nil, errors.Wrap(buf.Err(), "decoding message")
+	}
 
 	msg.Msg = obj
 
@@ -136,7 +177,7 @@ type MessageInformator interface {
 	MakeRequest(msg TL) (TL, error)
 }
 
-func serializePacket(client MessageInformator, msg TL, messageID int64, requireToAck bool) []byte {
+func serializePacket(client MessageInformator, msg TL, messageID int64, seqNo int32) []byte {
 	serializedMessage := msg.Encode()
 
 	buf := NewEncoder()
@@ -147,11 +188,7 @@ func serializePacket(client MessageInformator, msg TL, messageID int64, requireT
 	pp.Println(saltBytes, fmt.Sprintf("%#v", uint64(client.GetServerSalt())))
 	buf.PutLong(client.GetSessionID())
 	buf.PutLong(messageID)
-	if requireToAck { // не спрашивай, как это работает
-		buf.PutInt(client.GetLastSeqNo() | 1) // почему тут добавляется бит не ебу
-	} else {
-		buf.PutInt(client.GetLastSeqNo())
-	}
+	buf.PutInt(seqNo)
 	buf.PutInt(int32(len(serializedMessage)))
 	buf.PutRawBytes(serializedMessage)
 	return buf.buf
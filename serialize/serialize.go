@@ -96,19 +96,26 @@ func (*ErrorSessionConfigsChanged) Error() string {
 	return "session configuration was changed"
 }
 
-// dummy bool struct for methods generation
-type Bool struct{}
+// Bool это boolTrue или boolFalse, которые методы возвращают как объект
+type Bool struct {
+	Value bool
+}
 
-func (*Bool) CRC() uint32 {
-	panic("it's a dummy constructor!")
+func (t *Bool) CRC() uint32 {
+	if t.Value {
+		return crc_boolTrue
+	}
+	return crc_boolFalse
 }
 
 func (t *Bool) Encode() []byte {
-	panic("it's a dummy constructor!")
+	buf := NewEncoder()
+	buf.PutCRC(t.CRC())
+	return buf.Result()
 }
 
+// DecodeFrom ничего не делает: все значение в конструкторе, который уже прочитан
 func (t *Bool) DecodeFrom(d *Decoder) {
-	panic("it's a dummy constructor!")
 }
 
 // dummy bool struct for methods generation
@@ -4,73 +4,251 @@ import (
 	"encoding/base64"
 	"encoding/binary"
 	"encoding/json"
-	"io/ioutil"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/xelaj/mtproto/serialize"
+	"github.com/xelaj/mtproto/utils"
 	"github.com/xelaj/errs"
-	"github.com/xelaj/go-dry"
 )
 
 func (m *MTProto) SaveSession() (err error) {
-	m.encrypted = true
 	s := new(Session)
 	s.Key = m.authKey
 	s.Hash = m.authKeyHash
-	buf := make([]byte, serialize.LongLen)
-	binary.LittleEndian.PutUint64(buf, uint64(m.serverSalt))
-	s.Salt = buf
+	if m.permAuthKey != nil {
+		// временный ключ никогда не сохраняется. соли тоже: они выданы сессии
+		// временного ключа, для постоянного их все равно придется получать заново
+		s.Key = m.permAuthKey
+		s.Hash = utils.AuthKeyHash(m.permAuthKey)
+	} else {
+		buf := make([]byte, serialize.LongLen)
+		binary.LittleEndian.PutUint64(buf, uint64(m.GetServerSalt()))
+		s.Salt = buf
+		s.FutureSalts = m.salts.all()
+	}
 	s.Hostname = m.addr
-	err = SaveSession(s, m.tokensStorage)
-	dry.PanicIfErr(err)
+	s.DcID = m.dcID
+	s.TimeOffset = m.seq.TimeOffset()
+
+	m.mutex.Lock()
+	m.encrypted = true
+	s.MainDC = m.mainDC
+	s.Layer = m.layer
+	s.UserID = m.userID
+	s.Updates = m.updatesState
+	m.mutex.Unlock()
+
+	err = m.sessionStorage.Save(s)
+	if err != nil {
+		return errors.Wrap(err, "saving session")
+	}
 
 	return nil
 }
 
 func (m *MTProto) LoadSession() (err error) {
-	s, err := LoadSession(m.tokensStorage)
+	s, err := m.sessionStorage.Load()
 	if errs.IsNotFound(err) {
 		return err
 	}
-	dry.PanicIfErr(err)
+	if err != nil {
+		return errors.Wrap(err, "loading session")
+	}
 
 	m.authKey = s.Key
 	m.authKeyHash = s.Hash
-	m.serverSalt = int64(binary.LittleEndian.Uint64(s.Salt)) // СОЛЬ ЭТО LONG
+	if len(s.Salt) == serialize.LongLen {
+		m.serverSalt = int64(binary.LittleEndian.Uint64(s.Salt)) // СОЛЬ ЭТО LONG
+	}
 	m.addr = s.Hostname
+	m.salts.set(s.FutureSalts)
+	if m.dcID == 0 {
+		m.dcID = s.DcID
+	}
+	m.seq.SetTimeOffset(s.TimeOffset)
+
+	m.mutex.Lock()
+	m.mainDC = s.MainDC
+	m.layer = s.Layer
+	m.userID = s.UserID
+	m.updatesState = s.Updates
+	m.mutex.Unlock()
 
 	return nil
 }
 
+// SetMainDC отмечает, что текущий датацентр основной для пользователя. сама
+// библиотека этого не знает, значение только сохраняется вместе с сессией
+func (m *MTProto) SetMainDC(main bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.mainDC = main
+}
+
+func (m *MTProto) IsMainDC() bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.mainDC
+}
+
+// SetLayer запоминает слой API, с которым инициализировано соединение
+func (m *MTProto) SetLayer(layer int) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.layer = layer
+}
+
+func (m *MTProto) Layer() int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.layer
+}
+
+// SetUserID запоминает пользователя, который авторизовался этим ключом (после
+// auth.signIn и подобных). значение только сохраняется вместе с сессией
+func (m *MTProto) SetUserID(id int64) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.userID = id
+}
+
+func (m *MTProto) UserID() int64 {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.userID
+}
+
+// SetUpdatesState запоминает последнее состояние обновлений. в хранилище оно
+// попадет при следующем SaveSession
+func (m *MTProto) SetUpdatesState(state UpdatesState) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.updatesState = state
+}
+
+func (m *MTProto) UpdatesState() UpdatesState {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.updatesState
+}
+
+// версия формата, в котором сессия хранится в файле. у файлов, сохраненных
+// до появления версий, ее нет (то есть 0), они обновляются при чтении
+const sessionFormatVersion = 1
+
 type tokenStorageFormat struct {
-	Key      string `json:"key"`
-	Hash     string `json:"hash"`
-	Salt     string `json:"salt"`
-	Hostname string `json:"hostname"`
+	Version     int                `json:"version"`
+	Key         string             `json:"key"`
+	Hash        string             `json:"hash"`
+	Salt        string             `json:"salt"`
+	Hostname    string             `json:"hostname"`
+	FutureSalts []futureSaltFormat `json:"future_salts,omitempty"`
+
+	// с версии 1
+	DcID         int                 `json:"dc_id,omitempty"`
+	MainDC       bool                `json:"main_dc,omitempty"`
+	TimeOffsetMs int64               `json:"time_offset_ms,omitempty"`
+	Layer        int                 `json:"layer,omitempty"`
+	UserID       int64               `json:"user_id,omitempty"`
+	Updates      *updatesStateFormat `json:"updates,omitempty"`
 }
 
+type updatesStateFormat struct {
+	Pts  int32 `json:"pts"`
+	Qts  int32 `json:"qts"`
+	Date int32 `json:"date"`
+	Seq  int32 `json:"seq"`
+}
+
+// migrateSession обновляет формат файла до текущей версии
+func migrateSession(file *tokenStorageFormat) error {
+	if file.Version > sessionFormatVersion {
+		return errors.Errorf("session format version %v is newer than supported %v", file.Version, sessionFormatVersion)
+	}
+
+	if file.Version == 0 {
+		// в версии 0 были только ключ, соль и адрес. новые поля остаются
+		// пустыми, а хеш на всякий случай считаем заново, если его нет
+		if file.Hash == "" && file.Key != "" {
+			key, err := base64.StdEncoding.DecodeString(file.Key)
+			if err != nil {
+				return errors.Wrap(err, "invalid binary data of 'key'")
+			}
+			file.Hash = base64.StdEncoding.EncodeToString(utils.AuthKeyHash(key))
+		}
+		file.Version = 1
+	}
+
+	return nil
+}
+
+type futureSaltFormat struct {
+	ValidSince int32 `json:"valid_since"`
+	ValidUntil int32 `json:"valid_until"`
+	Salt       int64 `json:"salt"`
+}
+
+// Session это все, что сохраняется между запусками. Key всегда постоянный
+// ключ: временный ключ (см. Config.PFS) не сохраняется, после перезапуска
+// создается и привязывается новый
 type Session struct {
 	Key      []byte
 	Hash     []byte
 	Salt     []byte
 	Hostname string
+	// соли, которые сервер выдал заранее через get_future_salts
+	FutureSalts []*serialize.FutureSalt
+
+	// DcID номер датацентра (Config.DcID)
+	DcID int
+	// TimeOffset насколько часы сервера спешат относительно наших
+	TimeOffset time.Duration
+	// Layer слой API, с которым инициализировано соединение. запоминается
+	// сам в telegram.Client.InvokeWithLayer
+	Layer int
+
+	// остальное протоколу не нужно и библиотека об этом не знает: перед
+	// SaveSession это нужно задать через SetMainDC, SetUserID и
+	// SetUpdatesState, иначе сохранятся нули
+
+	// MainDC true, если это основной датацентр пользователя
+	MainDC bool
+	// UserID пользователь, который авторизован этим ключом, 0 если никто
+	UserID int64
+	// Updates последнее известное состояние обновлений
+	Updates UpdatesState
+}
+
+// UpdatesState это состояние обновлений (как в updates.state). по нему после
+// перезапуска можно получить пропущенные обновления через updates.getDifference
+type UpdatesState struct {
+	Pts  int32
+	Qts  int32
+	Date int32
+	Seq  int32
 }
 
+// LoadSession читает сессию из json файла, см. FileSession
 func LoadSession(path string) (*Session, error) {
-	if !dry.FileExists(path) {
-		return nil, errs.NotFound("file", path)
-	}
+	return NewFileSession(path).Load()
+}
 
-	data, err := ioutil.ReadFile(path)
-	if err != nil {
-		return nil, errors.Wrap(err, "reading file")
-	}
+// SaveSession сохраняет сессию в json файл, см. FileSession
+func SaveSession(s *Session, path string) error {
+	return NewFileSession(path).Save(s)
+}
 
+func decodeSession(data []byte) (*Session, error) {
 	file := new(tokenStorageFormat)
-	err = json.Unmarshal(data, file)
+	err := json.Unmarshal(data, file)
 	if err != nil {
 		return nil, errors.Wrap(err, "parsing file")
 	}
+	err = migrateSession(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "migrating session")
+	}
 
 	res := new(Session)
 
@@ -87,18 +265,49 @@ func LoadSession(path string) (*Session, error) {
 		return nil, errors.Wrap(err, "invalid binary data of 'salt'")
 	}
 	res.Hostname = file.Hostname
+	for _, salt := range file.FutureSalts {
+		res.FutureSalts = append(res.FutureSalts, &serialize.FutureSalt{
+			ValidSince: salt.ValidSince,
+			ValidUntil: salt.ValidUntil,
+			Salt:       salt.Salt,
+		})
+	}
+	res.DcID = file.DcID
+	res.MainDC = file.MainDC
+	res.TimeOffset = time.Duration(file.TimeOffsetMs) * time.Millisecond
+	res.Layer = file.Layer
+	res.UserID = file.UserID
+	if file.Updates != nil {
+		res.Updates = UpdatesState(*file.Updates)
+	}
 
 	return res, nil
 }
 
-func SaveSession(s *Session, path string) error {
+func encodeSession(s *Session) []byte {
 	file := new(tokenStorageFormat)
+	file.Version = sessionFormatVersion
 	file.Key = base64.StdEncoding.EncodeToString(s.Key)
 	file.Hash = base64.StdEncoding.EncodeToString(s.Hash)
 	file.Salt = base64.StdEncoding.EncodeToString(s.Salt)
 	file.Hostname = s.Hostname
+	for _, salt := range s.FutureSalts {
+		file.FutureSalts = append(file.FutureSalts, futureSaltFormat{
+			ValidSince: salt.ValidSince,
+			ValidUntil: salt.ValidUntil,
+			Salt:       salt.Salt,
+		})
+	}
+	file.DcID = s.DcID
+	file.MainDC = s.MainDC
+	file.TimeOffsetMs = int64(s.TimeOffset / time.Millisecond)
+	file.Layer = s.Layer
+	file.UserID = s.UserID
+	if s.Updates != (UpdatesState{}) {
+		updates := updatesStateFormat(s.Updates)
+		file.Updates = &updates
+	}
 
 	data, _ := json.Marshal(file)
-
-	return ioutil.WriteFile(path, data, 0600)
+	return data
 }
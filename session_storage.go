@@ -0,0 +1,246 @@
+package mtproto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/xelaj/errs"
+	"github.com/xelaj/go-dry"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/xelaj/mtproto/serialize"
+)
+
+// SessionStorage хранит ключ авторизации и все, что нужно для восстановления
+// сессии после перезапуска. Load должен вернуть errs.NotFound, если
+// сохраненной сессии еще нет
+type SessionStorage interface {
+	Save(s *Session) error
+	Load() (*Session, error)
+}
+
+// MemorySession хранит сессию в памяти, пока работает процесс. на диск ничего
+// не пишется
+type MemorySession struct {
+	mutex   sync.Mutex
+	session *Session
+}
+
+func NewMemorySession() *MemorySession {
+	return &MemorySession{}
+}
+
+func (m *MemorySession) Save(s *Session) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.session = copySession(s)
+	return nil
+}
+
+func (m *MemorySession) Load() (*Session, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.session == nil {
+		return nil, errs.NotFound("session", "memory")
+	}
+	return copySession(m.session), nil
+}
+
+func copySession(s *Session) *Session {
+	res := *s
+	res.Key = append([]byte{}, s.Key...)
+	res.Hash = append([]byte{}, s.Hash...)
+	res.Salt = append([]byte{}, s.Salt...)
+	if s.FutureSalts != nil {
+		res.FutureSalts = append([]*serialize.FutureSalt{}, s.FutureSalts...)
+	}
+	return &res
+}
+
+// FileSession хранит сессию в json файле. файл перезаписывается атомарно:
+// сначала пишется временный файл рядом, потом переименовывается
+type FileSession struct {
+	path string
+}
+
+// NewFileSession создает хранилище в файле path, ~ в начале пути заменяется
+// на домашнюю папку
+func NewFileSession(path string) *FileSession {
+	return &FileSession{path: path}
+}
+
+func (f *FileSession) Save(s *Session) error {
+	path, err := expandHome(f.path)
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(path, encodeSession(s))
+}
+
+func (f *FileSession) Load() (*Session, error) {
+	path, err := expandHome(f.path)
+	if err != nil {
+		return nil, err
+	}
+	if !dry.FileExists(path) {
+		return nil, errs.NotFound("file", path)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading file")
+	}
+
+	return decodeSession(data)
+}
+
+// https://pkg.go.dev/golang.org/x/crypto/scrypt
+// EncryptedFileSession хранит сессию в файле, зашифрованном AES-GCM. ключ
+// шифрования получается из пароля через scrypt, соль и nonce случайные для
+// каждой записи и лежат в начале файла
+type EncryptedFileSession struct {
+	path       string
+	passphrase []byte
+}
+
+const (
+	encryptedSessionMagic = "MTPS1"
+	scryptSaltLen         = 16
+
+	// рекомендованные параметры scrypt для интерактивного входа
+	scryptN      = 1 << 15
+	scryptR      = 8
+	scryptP      = 1
+	scryptKeyLen = 32
+)
+
+// NewEncryptedFileSession создает хранилище в файле path, зашифрованное
+// паролем passphrase
+func NewEncryptedFileSession(path, passphrase string) *EncryptedFileSession {
+	return &EncryptedFileSession{path: path, passphrase: []byte(passphrase)}
+}
+
+func (e *EncryptedFileSession) Save(s *Session) error {
+	path, err := expandHome(e.path)
+	if err != nil {
+		return err
+	}
+
+	salt := dry.RandomBytes(scryptSaltLen)
+	aead, err := e.cipher(salt)
+	if err != nil {
+		return err
+	}
+	nonce := dry.RandomBytes(aead.NonceSize())
+
+	// заголовок тоже защищен: его подмена сломает расшифровку
+	header := bytes.Join([][]byte{[]byte(encryptedSessionMagic), salt, nonce}, nil)
+	data := aead.Seal(header, nonce, encodeSession(s), header)
+
+	return writeFileAtomic(path, data)
+}
+
+func (e *EncryptedFileSession) Load() (*Session, error) {
+	path, err := expandHome(e.path)
+	if err != nil {
+		return nil, err
+	}
+	if !dry.FileExists(path) {
+		return nil, errs.NotFound("file", path)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading file")
+	}
+	if !bytes.HasPrefix(data, []byte(encryptedSessionMagic)) {
+		return nil, errors.New("file is not an encrypted session")
+	}
+
+	saltEnd := len(encryptedSessionMagic) + scryptSaltLen
+	if len(data) < saltEnd {
+		return nil, errors.New("encrypted session is too short")
+	}
+	aead, err := e.cipher(data[len(encryptedSessionMagic):saltEnd])
+	if err != nil {
+		return nil, err
+	}
+
+	headerEnd := saltEnd + aead.NonceSize()
+	if len(data) < headerEnd+aead.Overhead() {
+		return nil, errors.New("encrypted session is too short")
+	}
+
+	plain, err := aead.Open(nil, data[saltEnd:headerEnd], data[headerEnd:], data[:headerEnd])
+	if err != nil {
+		return nil, errors.Wrap(err, "decrypting session (wrong passphrase?)")
+	}
+
+	return decodeSession(plain)
+}
+
+func (e *EncryptedFileSession) cipher(salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key(e.passphrase, salt, scryptN, scryptR, scryptP, scryptKeyLen)
+	if err != nil {
+		return nil, errors.Wrap(err, "deriving key")
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating cipher")
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// expandHome заменяет ~ в начале пути на домашнюю папку пользователя
+func expandHome(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", errors.Wrap(err, "getting home directory")
+	}
+
+	return filepath.Join(home, path[1:]), nil
+}
+
+// writeFileAtomic пишет data во временный файл рядом с path и переименовывает
+// его, так что при падении посреди записи старый файл останется целым
+func writeFileAtomic(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return errors.Wrap(err, "creating directory")
+	}
+
+	tmp, err := ioutil.TempFile(dir, filepath.Base(path)+".tmp")
+	if err != nil {
+		return errors.Wrap(err, "creating temporary file")
+	}
+	// после переименования удалять нечего, ошибку игнорируем
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.Wrap(err, "writing temporary file")
+	}
+
+	return errors.Wrap(os.Rename(tmp.Name(), path), "renaming temporary file")
+}
@@ -0,0 +1,176 @@
+package mtproto
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xelaj/errs"
+
+	"github.com/xelaj/mtproto/serialize"
+	"github.com/xelaj/mtproto/utils"
+)
+
+func testSession() *Session {
+	return &Session{
+		Key:         bytes.Repeat([]byte{0xab}, 256),
+		Hash:        []byte{4, 5},
+		Salt:        []byte{6, 7, 8, 9, 10, 11, 12, 13},
+		Hostname:    "127.0.0.1:443",
+		FutureSalts: []*serialize.FutureSalt{{ValidSince: 10, ValidUntil: 20, Salt: -30}},
+		DcID:        2,
+		MainDC:      true,
+		TimeOffset:  -1500 * time.Millisecond,
+		Layer:       117,
+		UserID:      1234567890123,
+		Updates:     UpdatesState{Pts: 1, Qts: 2, Date: 3, Seq: 4},
+	}
+}
+
+func TestMemorySession(t *testing.T) {
+	storage := NewMemorySession()
+	_, err := storage.Load()
+	assert.True(t, errs.IsNotFound(err))
+
+	s := testSession()
+	assert.NoError(t, storage.Save(s))
+	s.Key[0] = 0 // хранилище не должно зависеть от изменений после сохранения
+
+	loaded, err := storage.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, testSession(), loaded)
+}
+
+func TestFileSession(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mtproto")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	// ~ раскрывается в домашнюю папку
+	home := os.Getenv("HOME")
+	defer os.Setenv("HOME", home)
+	os.Setenv("HOME", dir)
+
+	storage := NewFileSession("~/sessions/session.json")
+	_, err = storage.Load()
+	assert.True(t, errs.IsNotFound(err))
+
+	assert.NoError(t, storage.Save(testSession()))
+	assert.NoError(t, storage.Save(testSession()))
+
+	files, err := ioutil.ReadDir(filepath.Join(dir, "sessions"))
+	assert.NoError(t, err)
+	if assert.Len(t, files, 1, "temporary files must be removed") {
+		assert.Equal(t, "session.json", files[0].Name())
+		assert.Equal(t, os.FileMode(0600), files[0].Mode().Perm())
+	}
+
+	loaded, err := storage.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, testSession(), loaded)
+}
+
+func TestEncryptedFileSession(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mtproto")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "session.enc")
+
+	storage := NewEncryptedFileSession(path, "correct horse")
+	assert.NoError(t, storage.Save(testSession()))
+
+	data, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), string(testSession().Key[:16]))
+	assert.NotContains(t, string(data), "127.0.0.1")
+
+	loaded, err := storage.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, testSession(), loaded)
+
+	_, err = NewEncryptedFileSession(path, "battery staple").Load()
+	assert.Error(t, err)
+
+	// обычный json файл не принимается за зашифрованный
+	assert.NoError(t, NewFileSession(path).Save(testSession()))
+	_, err = storage.Load()
+	assert.Error(t, err)
+}
+
+func TestSessionMigration(t *testing.T) {
+	// так сессия хранилась до появления версий
+	key := bytes.Repeat([]byte{1}, 256)
+	old := `{"key":"` + base64.StdEncoding.EncodeToString(key) + `","hash":"","salt":"AQIDBAUGBwg=","hostname":"149.154.167.50:443"}`
+
+	s, err := decodeSession([]byte(old))
+	assert.NoError(t, err)
+	assert.Equal(t, key, s.Key)
+	assert.Equal(t, utils.AuthKeyHash(key), s.Hash)
+	assert.Equal(t, "149.154.167.50:443", s.Hostname)
+	assert.Zero(t, s.DcID)
+
+	// после сохранения файл уже в новом формате
+	assert.Contains(t, string(encodeSession(s)), `"version":1`)
+
+	_, err = decodeSession([]byte(`{"version":100}`))
+	assert.Error(t, err, "newer formats can't be read")
+}
+
+func TestSaveLoadSessionState(t *testing.T) {
+	storage := NewMemorySession()
+	m := newTestMTProto()
+	m.sessionStorage = storage
+	m.SetAuthKey(bytes.Repeat([]byte{2}, 256))
+	m.dcID = 4
+	m.seq.SetTimeOffset(3 * time.Second)
+	m.SetMainDC(true)
+	m.SetLayer(117)
+	m.SetUserID(42)
+	m.SetUpdatesState(UpdatesState{Pts: 10, Qts: 20, Date: 30, Seq: 40})
+	assert.NoError(t, m.SaveSession())
+
+	restored := newTestMTProto()
+	restored.sessionStorage = storage
+	assert.NoError(t, restored.LoadSession())
+	assert.Equal(t, m.authKey, restored.authKey)
+	assert.Equal(t, 4, restored.dcID)
+	assert.Equal(t, 3*time.Second, restored.seq.TimeOffset())
+	assert.True(t, restored.IsMainDC())
+	assert.Equal(t, 117, restored.Layer())
+	assert.Equal(t, int64(42), restored.UserID())
+	assert.Equal(t, UpdatesState{Pts: 10, Qts: 20, Date: 30, Seq: 40}, restored.UpdatesState())
+}
+
+func TestSaveSessionWithPFS(t *testing.T) {
+	storage := NewMemorySession()
+	m := newTestMTProto()
+	m.sessionStorage = storage
+	perm := bytes.Repeat([]byte{3}, 256)
+	m.permAuthKey = perm
+	m.SetAuthKey(bytes.Repeat([]byte{4}, 256))
+	m.setServerSalt(12345)
+	m.salts.set([]*serialize.FutureSalt{{ValidSince: 10, ValidUntil: 20, Salt: 30}})
+	assert.NoError(t, m.SaveSession())
+
+	// соли временного ключа рядом с постоянным не сохраняются
+	s, err := storage.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, perm, s.Key)
+	assert.Empty(t, s.Salt)
+	assert.Empty(t, s.FutureSalts)
+
+	restored := newTestMTProto()
+	restored.sessionStorage = storage
+	assert.NoError(t, restored.LoadSession())
+	assert.Equal(t, perm, restored.authKey)
+	assert.Zero(t, restored.serverSalt)
+}
@@ -1,25 +1,14 @@
 package telegram
 
 import (
-	"crypto/rsa"
+	"context"
 
 	"github.com/pkg/errors"
-	dry "github.com/xelaj/go-dry"
 
 	"github.com/xelaj/mtproto"
-	"github.com/xelaj/mtproto/keys"
 	"github.com/xelaj/mtproto/serialize"
 )
 
-func init() {
-	keyfile := "~/go/src/github.com/xelaj/mtproto/keys/keys.pem"
-	TelegramPublicKeys, err := keys.ReadFromFile(keyfile)
-	dry.PanicIfErr(err)
-	choosedKey := dry.RandomChoose(dry.InterfaceSlice(TelegramPublicKeys)...).(*rsa.PublicKey)
-
-	_ = choosedKey
-}
-
 type Client struct {
 	*mtproto.MTProto
 }
@@ -47,14 +36,16 @@ func (t *InvokeWithLayerParams) DecodeFrom(d *serialize.Decoder) {
 	panic("makes no sense")
 }
 
-func (m *Client) InvokeWithLayer(layer int, query serialize.TLEncoder) (serialize.TL, error) {
-	data, err := m.MakeRequest(&InvokeWithLayerParams{
+func (m *Client) InvokeWithLayer(ctx context.Context, layer int, query serialize.TLEncoder) (serialize.TL, error) {
+	data, err := m.MakeRequestContext(ctx, &InvokeWithLayerParams{
 		Layer: int32(layer),
 		Query: query,
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "sending InvokeWithLayer")
 	}
+	// слой сохраняется вместе с сессией
+	m.SetLayer(layer)
 
 	return data, nil
 }
@@ -110,8 +101,8 @@ func (t *InitConnectionParams) DecodeFrom(d *serialize.Decoder) {
 	panic("makes no sense")
 }
 
-func (m *Client) InitConnection(params InitConnectionParams) (serialize.TL, error) {
-	data, err := m.MakeRequest(&params)
+func (m *Client) InitConnection(ctx context.Context, params InitConnectionParams) (serialize.TL, error) {
+	data, err := m.MakeRequestContext(ctx, &params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sending InitConnection")
 	}
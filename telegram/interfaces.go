@@ -3,9 +3,7 @@
 package telegram
 
 import (
-	validator "github.com/go-playground/validator"
 	zero "github.com/vikyd/zero"
-	dry "github.com/xelaj/go-dry"
 	serialize "github.com/xelaj/mtproto/serialize"
 )
 
@@ -26,9 +24,6 @@ func (*ReplyKeyboardHide) CRC() uint32 {
 func (*ReplyKeyboardHide) ImplementsReplyMarkup() {}
 
 func (e *ReplyKeyboardHide) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Selective) {
 		flag |= 1 << 2
@@ -53,9 +48,6 @@ func (*ReplyKeyboardForceReply) CRC() uint32 {
 func (*ReplyKeyboardForceReply) ImplementsReplyMarkup() {}
 
 func (e *ReplyKeyboardForceReply) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.SingleUse) {
 		flag |= 1 << 1
@@ -87,9 +79,6 @@ func (*ReplyKeyboardMarkup) CRC() uint32 {
 func (*ReplyKeyboardMarkup) ImplementsReplyMarkup() {}
 
 func (e *ReplyKeyboardMarkup) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Resize) {
 		flag |= 1 << 0
@@ -123,9 +112,6 @@ func (*ReplyInlineMarkup) CRC() uint32 {
 func (*ReplyInlineMarkup) ImplementsReplyMarkup() {}
 
 func (e *ReplyInlineMarkup) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Rows)
@@ -148,9 +134,6 @@ func (*KeyboardButtonObj) CRC() uint32 {
 func (*KeyboardButtonObj) ImplementsKeyboardButton() {}
 
 func (e *KeyboardButtonObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Text)
@@ -169,9 +152,6 @@ func (*KeyboardButtonUrl) CRC() uint32 {
 func (*KeyboardButtonUrl) ImplementsKeyboardButton() {}
 
 func (e *KeyboardButtonUrl) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Text)
@@ -191,9 +171,6 @@ func (*KeyboardButtonCallback) CRC() uint32 {
 func (*KeyboardButtonCallback) ImplementsKeyboardButton() {}
 
 func (e *KeyboardButtonCallback) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Text)
@@ -212,9 +189,6 @@ func (*KeyboardButtonRequestPhone) CRC() uint32 {
 func (*KeyboardButtonRequestPhone) ImplementsKeyboardButton() {}
 
 func (e *KeyboardButtonRequestPhone) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Text)
@@ -232,9 +206,6 @@ func (*KeyboardButtonRequestGeoLocation) CRC() uint32 {
 func (*KeyboardButtonRequestGeoLocation) ImplementsKeyboardButton() {}
 
 func (e *KeyboardButtonRequestGeoLocation) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Text)
@@ -255,9 +226,6 @@ func (*KeyboardButtonSwitchInline) CRC() uint32 {
 func (*KeyboardButtonSwitchInline) ImplementsKeyboardButton() {}
 
 func (e *KeyboardButtonSwitchInline) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.SamePeer) {
 		flag |= 1 << 0
@@ -282,9 +250,6 @@ func (*KeyboardButtonGame) CRC() uint32 {
 func (*KeyboardButtonGame) ImplementsKeyboardButton() {}
 
 func (e *KeyboardButtonGame) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Text)
@@ -302,9 +267,6 @@ func (*KeyboardButtonBuy) CRC() uint32 {
 func (*KeyboardButtonBuy) ImplementsKeyboardButton() {}
 
 func (e *KeyboardButtonBuy) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Text)
@@ -326,9 +288,6 @@ func (*KeyboardButtonUrlAuth) CRC() uint32 {
 func (*KeyboardButtonUrlAuth) ImplementsKeyboardButton() {}
 
 func (e *KeyboardButtonUrlAuth) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.FwdText) {
 		flag |= 1 << 0
@@ -360,9 +319,6 @@ func (*InputKeyboardButtonUrlAuth) CRC() uint32 {
 func (*InputKeyboardButtonUrlAuth) ImplementsKeyboardButton() {}
 
 func (e *InputKeyboardButtonUrlAuth) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.RequestWriteAccess) {
 		flag |= 1 << 0
@@ -396,9 +352,6 @@ func (*KeyboardButtonRequestPoll) CRC() uint32 {
 func (*KeyboardButtonRequestPoll) ImplementsKeyboardButton() {}
 
 func (e *KeyboardButtonRequestPoll) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Quiz) {
 		flag |= 1 << 0
@@ -429,9 +382,6 @@ func (*PageListOrderedItemText) CRC() uint32 {
 func (*PageListOrderedItemText) ImplementsPageListOrderedItem() {}
 
 func (e *PageListOrderedItemText) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Num)
@@ -451,9 +401,6 @@ func (*PageListOrderedItemBlocks) CRC() uint32 {
 func (*PageListOrderedItemBlocks) ImplementsPageListOrderedItem() {}
 
 func (e *PageListOrderedItemBlocks) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Num)
@@ -477,9 +424,6 @@ func (*PageListItemText) CRC() uint32 {
 func (*PageListItemText) ImplementsPageListItem() {}
 
 func (e *PageListItemText) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Text.Encode())
@@ -497,9 +441,6 @@ func (*PageListItemBlocks) CRC() uint32 {
 func (*PageListItemBlocks) ImplementsPageListItem() {}
 
 func (e *PageListItemBlocks) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Blocks)
@@ -520,9 +461,6 @@ func (*InputChatPhotoEmpty) CRC() uint32 {
 func (*InputChatPhotoEmpty) ImplementsInputChatPhoto() {}
 
 func (e *InputChatPhotoEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -542,9 +480,6 @@ func (*InputChatUploadedPhoto) CRC() uint32 {
 func (*InputChatUploadedPhoto) ImplementsInputChatPhoto() {}
 
 func (e *InputChatUploadedPhoto) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.File) {
 		flag |= 1 << 0
@@ -580,9 +515,6 @@ func (*InputChatPhotoObj) CRC() uint32 {
 func (*InputChatPhotoObj) ImplementsInputChatPhoto() {}
 
 func (e *InputChatPhotoObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Id.Encode())
@@ -609,9 +541,6 @@ func (*InputSecureFileUploaded) CRC() uint32 {
 func (*InputSecureFileUploaded) ImplementsInputSecureFile() {}
 
 func (e *InputSecureFileUploaded) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -634,9 +563,6 @@ func (*InputSecureFileObj) CRC() uint32 {
 func (*InputSecureFileObj) ImplementsInputSecureFile() {}
 
 func (e *InputSecureFileObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -658,9 +584,6 @@ func (*MessageActionEmpty) CRC() uint32 {
 func (*MessageActionEmpty) ImplementsMessageAction() {}
 
 func (e *MessageActionEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -678,9 +601,6 @@ func (*MessageActionChatCreate) CRC() uint32 {
 func (*MessageActionChatCreate) ImplementsMessageAction() {}
 
 func (e *MessageActionChatCreate) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Title)
@@ -699,9 +619,6 @@ func (*MessageActionChatEditTitle) CRC() uint32 {
 func (*MessageActionChatEditTitle) ImplementsMessageAction() {}
 
 func (e *MessageActionChatEditTitle) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Title)
@@ -719,9 +636,6 @@ func (*MessageActionChatEditPhoto) CRC() uint32 {
 func (*MessageActionChatEditPhoto) ImplementsMessageAction() {}
 
 func (e *MessageActionChatEditPhoto) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Photo.Encode())
@@ -737,9 +651,6 @@ func (*MessageActionChatDeletePhoto) CRC() uint32 {
 func (*MessageActionChatDeletePhoto) ImplementsMessageAction() {}
 
 func (e *MessageActionChatDeletePhoto) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -756,9 +667,6 @@ func (*MessageActionChatAddUser) CRC() uint32 {
 func (*MessageActionChatAddUser) ImplementsMessageAction() {}
 
 func (e *MessageActionChatAddUser) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Users)
@@ -776,9 +684,6 @@ func (*MessageActionChatDeleteUser) CRC() uint32 {
 func (*MessageActionChatDeleteUser) ImplementsMessageAction() {}
 
 func (e *MessageActionChatDeleteUser) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -796,9 +701,6 @@ func (*MessageActionChatJoinedByLink) CRC() uint32 {
 func (*MessageActionChatJoinedByLink) ImplementsMessageAction() {}
 
 func (e *MessageActionChatJoinedByLink) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.InviterId)
@@ -816,9 +718,6 @@ func (*MessageActionChannelCreate) CRC() uint32 {
 func (*MessageActionChannelCreate) ImplementsMessageAction() {}
 
 func (e *MessageActionChannelCreate) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Title)
@@ -836,9 +735,6 @@ func (*MessageActionChatMigrateTo) CRC() uint32 {
 func (*MessageActionChatMigrateTo) ImplementsMessageAction() {}
 
 func (e *MessageActionChatMigrateTo) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChannelId)
@@ -857,9 +753,6 @@ func (*MessageActionChannelMigrateFrom) CRC() uint32 {
 func (*MessageActionChannelMigrateFrom) ImplementsMessageAction() {}
 
 func (e *MessageActionChannelMigrateFrom) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Title)
@@ -876,9 +769,6 @@ func (*MessageActionPinMessage) CRC() uint32 {
 func (*MessageActionPinMessage) ImplementsMessageAction() {}
 
 func (e *MessageActionPinMessage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -893,9 +783,6 @@ func (*MessageActionHistoryClear) CRC() uint32 {
 func (*MessageActionHistoryClear) ImplementsMessageAction() {}
 
 func (e *MessageActionHistoryClear) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -913,9 +800,6 @@ func (*MessageActionGameScore) CRC() uint32 {
 func (*MessageActionGameScore) ImplementsMessageAction() {}
 
 func (e *MessageActionGameScore) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.GameId)
@@ -940,9 +824,6 @@ func (*MessageActionPaymentSentMe) CRC() uint32 {
 func (*MessageActionPaymentSentMe) ImplementsMessageAction() {}
 
 func (e *MessageActionPaymentSentMe) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Info) {
 		flag |= 1 << 0
@@ -977,9 +858,6 @@ func (*MessageActionPaymentSent) CRC() uint32 {
 func (*MessageActionPaymentSent) ImplementsMessageAction() {}
 
 func (e *MessageActionPaymentSent) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Currency)
@@ -1002,9 +880,6 @@ func (*MessageActionPhoneCall) CRC() uint32 {
 func (*MessageActionPhoneCall) ImplementsMessageAction() {}
 
 func (e *MessageActionPhoneCall) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Reason) {
 		flag |= 1 << 0
@@ -1038,9 +913,6 @@ func (*MessageActionScreenshotTaken) CRC() uint32 {
 func (*MessageActionScreenshotTaken) ImplementsMessageAction() {}
 
 func (e *MessageActionScreenshotTaken) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -1057,9 +929,6 @@ func (*MessageActionCustomAction) CRC() uint32 {
 func (*MessageActionCustomAction) ImplementsMessageAction() {}
 
 func (e *MessageActionCustomAction) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Message)
@@ -1077,9 +946,6 @@ func (*MessageActionBotAllowed) CRC() uint32 {
 func (*MessageActionBotAllowed) ImplementsMessageAction() {}
 
 func (e *MessageActionBotAllowed) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Domain)
@@ -1098,9 +964,6 @@ func (*MessageActionSecureValuesSentMe) CRC() uint32 {
 func (*MessageActionSecureValuesSentMe) ImplementsMessageAction() {}
 
 func (e *MessageActionSecureValuesSentMe) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Values)
@@ -1119,9 +982,6 @@ func (*MessageActionSecureValuesSent) CRC() uint32 {
 func (*MessageActionSecureValuesSent) ImplementsMessageAction() {}
 
 func (e *MessageActionSecureValuesSent) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Types)
@@ -1137,9 +997,6 @@ func (*MessageActionContactSignUp) CRC() uint32 {
 func (*MessageActionContactSignUp) ImplementsMessageAction() {}
 
 func (e *MessageActionContactSignUp) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -1162,9 +1019,6 @@ func (*AuthLoginTokenObj) CRC() uint32 {
 func (*AuthLoginTokenObj) ImplementsAuthLoginToken() {}
 
 func (e *AuthLoginTokenObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Expires)
@@ -1184,9 +1038,6 @@ func (*AuthLoginTokenMigrateTo) CRC() uint32 {
 func (*AuthLoginTokenMigrateTo) ImplementsAuthLoginToken() {}
 
 func (e *AuthLoginTokenMigrateTo) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.DcId)
@@ -1205,9 +1056,6 @@ func (*AuthLoginTokenSuccess) CRC() uint32 {
 func (*AuthLoginTokenSuccess) ImplementsAuthLoginToken() {}
 
 func (e *AuthLoginTokenSuccess) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Authorization.Encode())
@@ -1233,9 +1081,6 @@ func (*BotInlineMessageMediaAuto) CRC() uint32 {
 func (*BotInlineMessageMediaAuto) ImplementsBotInlineMessage() {}
 
 func (e *BotInlineMessageMediaAuto) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Entities) {
 		flag |= 1 << 1
@@ -1270,9 +1115,6 @@ func (*BotInlineMessageText) CRC() uint32 {
 func (*BotInlineMessageText) ImplementsBotInlineMessage() {}
 
 func (e *BotInlineMessageText) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.NoWebpage) {
 		flag |= 1 << 0
@@ -1311,9 +1153,6 @@ func (*BotInlineMessageMediaGeo) CRC() uint32 {
 func (*BotInlineMessageMediaGeo) ImplementsBotInlineMessage() {}
 
 func (e *BotInlineMessageMediaGeo) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ReplyMarkup) {
 		flag |= 1 << 2
@@ -1346,9 +1185,6 @@ func (*BotInlineMessageMediaVenue) CRC() uint32 {
 func (*BotInlineMessageMediaVenue) ImplementsBotInlineMessage() {}
 
 func (e *BotInlineMessageMediaVenue) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ReplyMarkup) {
 		flag |= 1 << 2
@@ -1383,9 +1219,6 @@ func (*BotInlineMessageMediaContact) CRC() uint32 {
 func (*BotInlineMessageMediaContact) ImplementsBotInlineMessage() {}
 
 func (e *BotInlineMessageMediaContact) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ReplyMarkup) {
 		flag |= 1 << 2
@@ -1418,9 +1251,6 @@ func (*PhoneCallEmpty) CRC() uint32 {
 func (*PhoneCallEmpty) ImplementsPhoneCall() {}
 
 func (e *PhoneCallEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -1446,9 +1276,6 @@ func (*PhoneCallWaiting) CRC() uint32 {
 func (*PhoneCallWaiting) ImplementsPhoneCall() {}
 
 func (e *PhoneCallWaiting) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ReceiveDate) {
 		flag |= 1 << 0
@@ -1491,9 +1318,6 @@ func (*PhoneCallRequested) CRC() uint32 {
 func (*PhoneCallRequested) ImplementsPhoneCall() {}
 
 func (e *PhoneCallRequested) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Video) {
 		flag |= 1 << 6
@@ -1531,9 +1355,6 @@ func (*PhoneCallAccepted) CRC() uint32 {
 func (*PhoneCallAccepted) ImplementsPhoneCall() {}
 
 func (e *PhoneCallAccepted) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Video) {
 		flag |= 1 << 6
@@ -1575,9 +1396,6 @@ func (*PhoneCallObj) CRC() uint32 {
 func (*PhoneCallObj) ImplementsPhoneCall() {}
 
 func (e *PhoneCallObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.P2PAllowed) {
 		flag |= 1 << 5
@@ -1621,9 +1439,6 @@ func (*PhoneCallDiscarded) CRC() uint32 {
 func (*PhoneCallDiscarded) ImplementsPhoneCall() {}
 
 func (e *PhoneCallDiscarded) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Reason) {
 		flag |= 1 << 0
@@ -1675,9 +1490,6 @@ func (*UpdatesDifferenceEmpty) CRC() uint32 {
 func (*UpdatesDifferenceEmpty) ImplementsUpdatesDifference() {}
 
 func (e *UpdatesDifferenceEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Date)
@@ -1701,9 +1513,6 @@ func (*UpdatesDifferenceObj) CRC() uint32 {
 func (*UpdatesDifferenceObj) ImplementsUpdatesDifference() {}
 
 func (e *UpdatesDifferenceObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.NewMessages)
@@ -1731,9 +1540,6 @@ func (*UpdatesDifferenceSlice) CRC() uint32 {
 func (*UpdatesDifferenceSlice) ImplementsUpdatesDifference() {}
 
 func (e *UpdatesDifferenceSlice) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.NewMessages)
@@ -1756,9 +1562,6 @@ func (*UpdatesDifferenceTooLong) CRC() uint32 {
 func (*UpdatesDifferenceTooLong) ImplementsUpdatesDifference() {}
 
 func (e *UpdatesDifferenceTooLong) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Pts)
@@ -1784,9 +1587,6 @@ func (*InputBotInlineMessageMediaAuto) CRC() uint32 {
 func (*InputBotInlineMessageMediaAuto) ImplementsInputBotInlineMessage() {}
 
 func (e *InputBotInlineMessageMediaAuto) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Entities) {
 		flag |= 1 << 1
@@ -1821,9 +1621,6 @@ func (*InputBotInlineMessageText) CRC() uint32 {
 func (*InputBotInlineMessageText) ImplementsInputBotInlineMessage() {}
 
 func (e *InputBotInlineMessageText) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.NoWebpage) {
 		flag |= 1 << 0
@@ -1862,9 +1659,6 @@ func (*InputBotInlineMessageMediaGeo) CRC() uint32 {
 func (*InputBotInlineMessageMediaGeo) ImplementsInputBotInlineMessage() {}
 
 func (e *InputBotInlineMessageMediaGeo) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ReplyMarkup) {
 		flag |= 1 << 2
@@ -1897,9 +1691,6 @@ func (*InputBotInlineMessageMediaVenue) CRC() uint32 {
 func (*InputBotInlineMessageMediaVenue) ImplementsInputBotInlineMessage() {}
 
 func (e *InputBotInlineMessageMediaVenue) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ReplyMarkup) {
 		flag |= 1 << 2
@@ -1934,9 +1725,6 @@ func (*InputBotInlineMessageMediaContact) CRC() uint32 {
 func (*InputBotInlineMessageMediaContact) ImplementsInputBotInlineMessage() {}
 
 func (e *InputBotInlineMessageMediaContact) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ReplyMarkup) {
 		flag |= 1 << 2
@@ -1965,9 +1753,6 @@ func (*InputBotInlineMessageGame) CRC() uint32 {
 func (*InputBotInlineMessageGame) ImplementsInputBotInlineMessage() {}
 
 func (e *InputBotInlineMessageGame) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ReplyMarkup) {
 		flag |= 1 << 2
@@ -1994,9 +1779,6 @@ func (*InputPhotoEmpty) CRC() uint32 {
 func (*InputPhotoEmpty) ImplementsInputPhoto() {}
 
 func (e *InputPhotoEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -2015,9 +1797,6 @@ func (*InputPhotoObj) CRC() uint32 {
 func (*InputPhotoObj) ImplementsInputPhoto() {}
 
 func (e *InputPhotoObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -2042,9 +1821,6 @@ func (*MessagesSentEncryptedMessageObj) CRC() uint32 {
 func (*MessagesSentEncryptedMessageObj) ImplementsMessagesSentEncryptedMessage() {}
 
 func (e *MessagesSentEncryptedMessageObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Date)
@@ -2063,9 +1839,6 @@ func (*MessagesSentEncryptedFile) CRC() uint32 {
 func (*MessagesSentEncryptedFile) ImplementsMessagesSentEncryptedMessage() {}
 
 func (e *MessagesSentEncryptedFile) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Date)
@@ -2089,9 +1862,6 @@ func (*WebPageEmpty) CRC() uint32 {
 func (*WebPageEmpty) ImplementsWebPage() {}
 
 func (e *WebPageEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -2110,9 +1880,6 @@ func (*WebPagePending) CRC() uint32 {
 func (*WebPagePending) ImplementsWebPage() {}
 
 func (e *WebPagePending) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -2149,9 +1916,6 @@ func (*WebPageObj) CRC() uint32 {
 func (*WebPageObj) ImplementsWebPage() {}
 
 func (e *WebPageObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Type) {
 		flag |= 1 << 0
@@ -2252,9 +2016,6 @@ func (*WebPageNotModified) CRC() uint32 {
 func (*WebPageNotModified) ImplementsWebPage() {}
 
 func (e *WebPageNotModified) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.CachedPageViews) {
 		flag |= 1 << 0
@@ -2281,9 +2042,6 @@ func (*MessagesRecentStickersNotModified) CRC() uint32 {
 func (*MessagesRecentStickersNotModified) ImplementsMessagesRecentStickers() {}
 
 func (e *MessagesRecentStickersNotModified) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -2303,9 +2061,6 @@ func (*MessagesRecentStickersObj) CRC() uint32 {
 func (*MessagesRecentStickersObj) ImplementsMessagesRecentStickers() {}
 
 func (e *MessagesRecentStickersObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Hash)
@@ -2332,9 +2087,6 @@ func (*InputThemeObj) CRC() uint32 {
 func (*InputThemeObj) ImplementsInputTheme() {}
 
 func (e *InputThemeObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -2353,9 +2105,6 @@ func (*InputThemeSlug) CRC() uint32 {
 func (*InputThemeSlug) ImplementsInputTheme() {}
 
 func (e *InputThemeSlug) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Slug)
@@ -2376,9 +2125,6 @@ func (*PrivacyValueAllowContacts) CRC() uint32 {
 func (*PrivacyValueAllowContacts) ImplementsPrivacyRule() {}
 
 func (e *PrivacyValueAllowContacts) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -2393,9 +2139,6 @@ func (*PrivacyValueAllowAll) CRC() uint32 {
 func (*PrivacyValueAllowAll) ImplementsPrivacyRule() {}
 
 func (e *PrivacyValueAllowAll) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -2412,9 +2155,6 @@ func (*PrivacyValueAllowUsers) CRC() uint32 {
 func (*PrivacyValueAllowUsers) ImplementsPrivacyRule() {}
 
 func (e *PrivacyValueAllowUsers) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Users)
@@ -2430,9 +2170,6 @@ func (*PrivacyValueDisallowContacts) CRC() uint32 {
 func (*PrivacyValueDisallowContacts) ImplementsPrivacyRule() {}
 
 func (e *PrivacyValueDisallowContacts) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -2447,9 +2184,6 @@ func (*PrivacyValueDisallowAll) CRC() uint32 {
 func (*PrivacyValueDisallowAll) ImplementsPrivacyRule() {}
 
 func (e *PrivacyValueDisallowAll) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -2466,9 +2200,6 @@ func (*PrivacyValueDisallowUsers) CRC() uint32 {
 func (*PrivacyValueDisallowUsers) ImplementsPrivacyRule() {}
 
 func (e *PrivacyValueDisallowUsers) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Users)
@@ -2486,9 +2217,6 @@ func (*PrivacyValueAllowChatParticipants) CRC() uint32 {
 func (*PrivacyValueAllowChatParticipants) ImplementsPrivacyRule() {}
 
 func (e *PrivacyValueAllowChatParticipants) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Chats)
@@ -2506,9 +2234,6 @@ func (*PrivacyValueDisallowChatParticipants) CRC() uint32 {
 func (*PrivacyValueDisallowChatParticipants) ImplementsPrivacyRule() {}
 
 func (e *PrivacyValueDisallowChatParticipants) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Chats)
@@ -2532,9 +2257,6 @@ func (*InputWallPaperObj) CRC() uint32 {
 func (*InputWallPaperObj) ImplementsInputWallPaper() {}
 
 func (e *InputWallPaperObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -2553,9 +2275,6 @@ func (*InputWallPaperSlug) CRC() uint32 {
 func (*InputWallPaperSlug) ImplementsInputWallPaper() {}
 
 func (e *InputWallPaperSlug) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Slug)
@@ -2571,9 +2290,6 @@ func (*InputWallPaperNoFile) CRC() uint32 {
 func (*InputWallPaperNoFile) ImplementsInputWallPaper() {}
 
 func (e *InputWallPaperNoFile) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -2593,9 +2309,6 @@ func (*PasswordKdfAlgoUnknown) CRC() uint32 {
 func (*PasswordKdfAlgoUnknown) ImplementsPasswordKdfAlgo() {}
 
 func (e *PasswordKdfAlgoUnknown) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -2616,9 +2329,6 @@ func (*PasswordKdfAlgoSHA256SHA256PBKDF2HMACSHA512iter100000SHA256ModPow) Implem
 }
 
 func (e *PasswordKdfAlgoSHA256SHA256PBKDF2HMACSHA512iter100000SHA256ModPow) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutMessage(e.Salt1)
@@ -2647,9 +2357,6 @@ func (*InputFileObj) CRC() uint32 {
 func (*InputFileObj) ImplementsInputFile() {}
 
 func (e *InputFileObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -2672,9 +2379,6 @@ func (*InputFileBig) CRC() uint32 {
 func (*InputFileBig) ImplementsInputFile() {}
 
 func (e *InputFileBig) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -2697,9 +2401,6 @@ func (*MessagesSavedGifsNotModified) CRC() uint32 {
 func (*MessagesSavedGifsNotModified) ImplementsMessagesSavedGifs() {}
 
 func (e *MessagesSavedGifsNotModified) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -2717,9 +2418,6 @@ func (*MessagesSavedGifsObj) CRC() uint32 {
 func (*MessagesSavedGifsObj) ImplementsMessagesSavedGifs() {}
 
 func (e *MessagesSavedGifsObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Hash)
@@ -2743,9 +2441,6 @@ func (*MessageEmpty) CRC() uint32 {
 func (*MessageEmpty) ImplementsMessage() {}
 
 func (e *MessageEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Id)
@@ -2787,9 +2482,6 @@ func (*MessageObj) CRC() uint32 {
 func (*MessageObj) ImplementsMessage() {}
 
 func (e *MessageObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Out) {
 		flag |= 1 << 1
@@ -2935,9 +2627,6 @@ func (*MessageService) CRC() uint32 {
 func (*MessageService) ImplementsMessage() {}
 
 func (e *MessageService) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Out) {
 		flag |= 1 << 1
@@ -3004,9 +2693,6 @@ func (*InputUserEmpty) CRC() uint32 {
 func (*InputUserEmpty) ImplementsInputUser() {}
 
 func (e *InputUserEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -3021,9 +2707,6 @@ func (*InputUserSelf) CRC() uint32 {
 func (*InputUserSelf) ImplementsInputUser() {}
 
 func (e *InputUserSelf) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -3041,9 +2724,6 @@ func (*InputUserObj) CRC() uint32 {
 func (*InputUserObj) ImplementsInputUser() {}
 
 func (e *InputUserObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -3064,9 +2744,6 @@ func (*InputUserFromMessage) CRC() uint32 {
 func (*InputUserFromMessage) ImplementsInputUser() {}
 
 func (e *InputUserFromMessage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -3089,9 +2766,6 @@ func (*ChannelLocationEmpty) CRC() uint32 {
 func (*ChannelLocationEmpty) ImplementsChannelLocation() {}
 
 func (e *ChannelLocationEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -3109,9 +2783,6 @@ func (*ChannelLocationObj) CRC() uint32 {
 func (*ChannelLocationObj) ImplementsChannelLocation() {}
 
 func (e *ChannelLocationObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.GeoPoint.Encode())
@@ -3135,9 +2806,6 @@ func (*AuthSentCodeTypeApp) CRC() uint32 {
 func (*AuthSentCodeTypeApp) ImplementsAuthSentCodeType() {}
 
 func (e *AuthSentCodeTypeApp) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Length)
@@ -3155,9 +2823,6 @@ func (*AuthSentCodeTypeSms) CRC() uint32 {
 func (*AuthSentCodeTypeSms) ImplementsAuthSentCodeType() {}
 
 func (e *AuthSentCodeTypeSms) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Length)
@@ -3175,9 +2840,6 @@ func (*AuthSentCodeTypeCall) CRC() uint32 {
 func (*AuthSentCodeTypeCall) ImplementsAuthSentCodeType() {}
 
 func (e *AuthSentCodeTypeCall) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Length)
@@ -3195,9 +2857,6 @@ func (*AuthSentCodeTypeFlashCall) CRC() uint32 {
 func (*AuthSentCodeTypeFlashCall) ImplementsAuthSentCodeType() {}
 
 func (e *AuthSentCodeTypeFlashCall) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Pattern)
@@ -3221,9 +2880,6 @@ func (*PhotosPhotosObj) CRC() uint32 {
 func (*PhotosPhotosObj) ImplementsPhotosPhotos() {}
 
 func (e *PhotosPhotosObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Photos)
@@ -3244,9 +2900,6 @@ func (*PhotosPhotosSlice) CRC() uint32 {
 func (*PhotosPhotosSlice) ImplementsPhotosPhotos() {}
 
 func (e *PhotosPhotosSlice) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Count)
@@ -3272,9 +2925,6 @@ func (*DocumentAttributeImageSize) CRC() uint32 {
 func (*DocumentAttributeImageSize) ImplementsDocumentAttribute() {}
 
 func (e *DocumentAttributeImageSize) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.W)
@@ -3291,9 +2941,6 @@ func (*DocumentAttributeAnimated) CRC() uint32 {
 func (*DocumentAttributeAnimated) ImplementsDocumentAttribute() {}
 
 func (e *DocumentAttributeAnimated) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -3314,9 +2961,6 @@ func (*DocumentAttributeSticker) CRC() uint32 {
 func (*DocumentAttributeSticker) ImplementsDocumentAttribute() {}
 
 func (e *DocumentAttributeSticker) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.MaskCoords) {
 		flag |= 1 << 0
@@ -3352,9 +2996,6 @@ func (*DocumentAttributeVideo) CRC() uint32 {
 func (*DocumentAttributeVideo) ImplementsDocumentAttribute() {}
 
 func (e *DocumentAttributeVideo) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.RoundMessage) {
 		flag |= 1 << 0
@@ -3390,9 +3031,6 @@ func (*DocumentAttributeAudio) CRC() uint32 {
 func (*DocumentAttributeAudio) ImplementsDocumentAttribute() {}
 
 func (e *DocumentAttributeAudio) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Title) {
 		flag |= 1 << 0
@@ -3434,9 +3072,6 @@ func (*DocumentAttributeFilename) CRC() uint32 {
 func (*DocumentAttributeFilename) ImplementsDocumentAttribute() {}
 
 func (e *DocumentAttributeFilename) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.FileName)
@@ -3452,9 +3087,6 @@ func (*DocumentAttributeHasStickers) CRC() uint32 {
 func (*DocumentAttributeHasStickers) ImplementsDocumentAttribute() {}
 
 func (e *DocumentAttributeHasStickers) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -3477,9 +3109,6 @@ func (*EmojiKeywordObj) CRC() uint32 {
 func (*EmojiKeywordObj) ImplementsEmojiKeyword() {}
 
 func (e *EmojiKeywordObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Keyword)
@@ -3499,9 +3128,6 @@ func (*EmojiKeywordDeleted) CRC() uint32 {
 func (*EmojiKeywordDeleted) ImplementsEmojiKeyword() {}
 
 func (e *EmojiKeywordDeleted) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Keyword)
@@ -3523,9 +3149,6 @@ func (*ChatInviteEmpty) CRC() uint32 {
 func (*ChatInviteEmpty) ImplementsExportedChatInvite() {}
 
 func (e *ChatInviteEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -3542,9 +3165,6 @@ func (*ChatInviteExported) CRC() uint32 {
 func (*ChatInviteExported) ImplementsExportedChatInvite() {}
 
 func (e *ChatInviteExported) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Link)
@@ -3565,9 +3185,6 @@ func (*MessageMediaEmpty) CRC() uint32 {
 func (*MessageMediaEmpty) ImplementsMessageMedia() {}
 
 func (e *MessageMediaEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -3586,9 +3203,6 @@ func (*MessageMediaPhoto) CRC() uint32 {
 func (*MessageMediaPhoto) ImplementsMessageMedia() {}
 
 func (e *MessageMediaPhoto) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Photo) {
 		flag |= 1 << 0
@@ -3618,9 +3232,6 @@ func (*MessageMediaGeo) CRC() uint32 {
 func (*MessageMediaGeo) ImplementsMessageMedia() {}
 
 func (e *MessageMediaGeo) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Geo.Encode())
@@ -3642,9 +3253,6 @@ func (*MessageMediaContact) CRC() uint32 {
 func (*MessageMediaContact) ImplementsMessageMedia() {}
 
 func (e *MessageMediaContact) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.PhoneNumber)
@@ -3664,9 +3272,6 @@ func (*MessageMediaUnsupported) CRC() uint32 {
 func (*MessageMediaUnsupported) ImplementsMessageMedia() {}
 
 func (e *MessageMediaUnsupported) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -3685,9 +3290,6 @@ func (*MessageMediaDocument) CRC() uint32 {
 func (*MessageMediaDocument) ImplementsMessageMedia() {}
 
 func (e *MessageMediaDocument) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Document) {
 		flag |= 1 << 0
@@ -3717,9 +3319,6 @@ func (*MessageMediaWebPage) CRC() uint32 {
 func (*MessageMediaWebPage) ImplementsMessageMedia() {}
 
 func (e *MessageMediaWebPage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Webpage.Encode())
@@ -3742,9 +3341,6 @@ func (*MessageMediaVenue) CRC() uint32 {
 func (*MessageMediaVenue) ImplementsMessageMedia() {}
 
 func (e *MessageMediaVenue) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Geo.Encode())
@@ -3767,9 +3363,6 @@ func (*MessageMediaGame) CRC() uint32 {
 func (*MessageMediaGame) ImplementsMessageMedia() {}
 
 func (e *MessageMediaGame) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Game.Encode())
@@ -3796,9 +3389,6 @@ func (*MessageMediaInvoice) CRC() uint32 {
 func (*MessageMediaInvoice) ImplementsMessageMedia() {}
 
 func (e *MessageMediaInvoice) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Photo) {
 		flag |= 1 << 0
@@ -3844,9 +3434,6 @@ func (*MessageMediaGeoLive) CRC() uint32 {
 func (*MessageMediaGeoLive) ImplementsMessageMedia() {}
 
 func (e *MessageMediaGeoLive) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Geo.Encode())
@@ -3866,9 +3453,6 @@ func (*MessageMediaPoll) CRC() uint32 {
 func (*MessageMediaPoll) ImplementsMessageMedia() {}
 
 func (e *MessageMediaPoll) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Poll.Encode())
@@ -3888,9 +3472,6 @@ func (*MessageMediaDice) CRC() uint32 {
 func (*MessageMediaDice) ImplementsMessageMedia() {}
 
 func (e *MessageMediaDice) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Value)
@@ -3914,9 +3495,6 @@ func (*ChatEmpty) CRC() uint32 {
 func (*ChatEmpty) ImplementsChat() {}
 
 func (e *ChatEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Id)
@@ -3947,9 +3525,6 @@ func (*ChatObj) CRC() uint32 {
 func (*ChatObj) ImplementsChat() {}
 
 func (e *ChatObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Creator) {
 		flag |= 1 << 0
@@ -4012,9 +3587,6 @@ func (*ChatForbidden) CRC() uint32 {
 func (*ChatForbidden) ImplementsChat() {}
 
 func (e *ChatForbidden) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Id)
@@ -4057,9 +3629,6 @@ func (*Channel) CRC() uint32 {
 func (*Channel) ImplementsChat() {}
 
 func (e *Channel) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Creator) {
 		flag |= 1 << 0
@@ -4187,9 +3756,6 @@ func (*ChannelForbidden) CRC() uint32 {
 func (*ChannelForbidden) ImplementsChat() {}
 
 func (e *ChannelForbidden) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Broadcast) {
 		flag |= 1 << 5
@@ -4229,9 +3795,6 @@ func (*GeoPointEmpty) CRC() uint32 {
 func (*GeoPointEmpty) ImplementsGeoPoint() {}
 
 func (e *GeoPointEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -4250,9 +3813,6 @@ func (*GeoPointObj) CRC() uint32 {
 func (*GeoPointObj) ImplementsGeoPoint() {}
 
 func (e *GeoPointObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutDouble(e.Long)
@@ -4280,9 +3840,6 @@ func (*InputFileLocationObj) CRC() uint32 {
 func (*InputFileLocationObj) ImplementsInputFileLocation() {}
 
 func (e *InputFileLocationObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.VolumeId)
@@ -4304,9 +3861,6 @@ func (*InputEncryptedFileLocation) CRC() uint32 {
 func (*InputEncryptedFileLocation) ImplementsInputFileLocation() {}
 
 func (e *InputEncryptedFileLocation) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -4328,9 +3882,6 @@ func (*InputDocumentFileLocation) CRC() uint32 {
 func (*InputDocumentFileLocation) ImplementsInputFileLocation() {}
 
 func (e *InputDocumentFileLocation) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -4352,9 +3903,6 @@ func (*InputSecureFileLocation) CRC() uint32 {
 func (*InputSecureFileLocation) ImplementsInputFileLocation() {}
 
 func (e *InputSecureFileLocation) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -4371,9 +3919,6 @@ func (*InputTakeoutFileLocation) CRC() uint32 {
 func (*InputTakeoutFileLocation) ImplementsInputFileLocation() {}
 
 func (e *InputTakeoutFileLocation) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -4393,9 +3938,6 @@ func (*InputPhotoFileLocation) CRC() uint32 {
 func (*InputPhotoFileLocation) ImplementsInputFileLocation() {}
 
 func (e *InputPhotoFileLocation) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -4421,9 +3963,6 @@ func (*InputPhotoLegacyFileLocation) CRC() uint32 {
 func (*InputPhotoLegacyFileLocation) ImplementsInputFileLocation() {}
 
 func (e *InputPhotoLegacyFileLocation) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -4450,9 +3989,6 @@ func (*InputPeerPhotoFileLocation) CRC() uint32 {
 func (*InputPeerPhotoFileLocation) ImplementsInputFileLocation() {}
 
 func (e *InputPeerPhotoFileLocation) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Big) {
 		flag |= 1 << 0
@@ -4480,9 +4016,6 @@ func (*InputStickerSetThumb) CRC() uint32 {
 func (*InputStickerSetThumb) ImplementsInputFileLocation() {}
 
 func (e *InputStickerSetThumb) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Stickerset.Encode())
@@ -4507,9 +4040,6 @@ func (*UploadCdnFileReuploadNeeded) CRC() uint32 {
 func (*UploadCdnFileReuploadNeeded) ImplementsUploadCdnFile() {}
 
 func (e *UploadCdnFileReuploadNeeded) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutMessage(e.RequestToken)
@@ -4527,9 +4057,6 @@ func (*UploadCdnFileObj) CRC() uint32 {
 func (*UploadCdnFileObj) ImplementsUploadCdnFile() {}
 
 func (e *UploadCdnFileObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutMessage(e.Bytes)
@@ -4563,9 +4090,6 @@ func (*ChatFullObj) CRC() uint32 {
 func (*ChatFullObj) ImplementsChatFull() {}
 
 func (e *ChatFullObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ChatPhoto) {
 		flag |= 1 << 2
@@ -4655,9 +4179,6 @@ func (*ChannelFull) CRC() uint32 {
 func (*ChannelFull) ImplementsChatFull() {}
 
 func (e *ChannelFull) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ParticipantsCount) {
 		flag |= 1 << 0
@@ -4817,9 +4338,6 @@ func (*UploadFileObj) CRC() uint32 {
 func (*UploadFileObj) ImplementsUploadFile() {}
 
 func (e *UploadFileObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Type.Encode())
@@ -4843,9 +4361,6 @@ func (*UploadFileCdnRedirect) CRC() uint32 {
 func (*UploadFileCdnRedirect) ImplementsUploadFile() {}
 
 func (e *UploadFileCdnRedirect) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.DcId)
@@ -4870,9 +4385,6 @@ func (*InputPeerEmpty) CRC() uint32 {
 func (*InputPeerEmpty) ImplementsInputPeer() {}
 
 func (e *InputPeerEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -4887,9 +4399,6 @@ func (*InputPeerSelf) CRC() uint32 {
 func (*InputPeerSelf) ImplementsInputPeer() {}
 
 func (e *InputPeerSelf) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -4906,9 +4415,6 @@ func (*InputPeerChat) CRC() uint32 {
 func (*InputPeerChat) ImplementsInputPeer() {}
 
 func (e *InputPeerChat) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChatId)
@@ -4927,9 +4433,6 @@ func (*InputPeerUser) CRC() uint32 {
 func (*InputPeerUser) ImplementsInputPeer() {}
 
 func (e *InputPeerUser) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -4949,9 +4452,6 @@ func (*InputPeerChannel) CRC() uint32 {
 func (*InputPeerChannel) ImplementsInputPeer() {}
 
 func (e *InputPeerChannel) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChannelId)
@@ -4972,9 +4472,6 @@ func (*InputPeerUserFromMessage) CRC() uint32 {
 func (*InputPeerUserFromMessage) ImplementsInputPeer() {}
 
 func (e *InputPeerUserFromMessage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -4996,9 +4493,6 @@ func (*InputPeerChannelFromMessage) CRC() uint32 {
 func (*InputPeerChannelFromMessage) ImplementsInputPeer() {}
 
 func (e *InputPeerChannelFromMessage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -5021,9 +4515,6 @@ func (*InputDocumentEmpty) CRC() uint32 {
 func (*InputDocumentEmpty) ImplementsInputDocument() {}
 
 func (e *InputDocumentEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -5042,9 +4533,6 @@ func (*InputDocumentObj) CRC() uint32 {
 func (*InputDocumentObj) ImplementsInputDocument() {}
 
 func (e *InputDocumentObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -5067,9 +4555,6 @@ func (*UserStatusEmpty) CRC() uint32 {
 func (*UserStatusEmpty) ImplementsUserStatus() {}
 
 func (e *UserStatusEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -5086,9 +4571,6 @@ func (*UserStatusOnline) CRC() uint32 {
 func (*UserStatusOnline) ImplementsUserStatus() {}
 
 func (e *UserStatusOnline) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Expires)
@@ -5106,9 +4588,6 @@ func (*UserStatusOffline) CRC() uint32 {
 func (*UserStatusOffline) ImplementsUserStatus() {}
 
 func (e *UserStatusOffline) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.WasOnline)
@@ -5124,9 +4603,6 @@ func (*UserStatusRecently) CRC() uint32 {
 func (*UserStatusRecently) ImplementsUserStatus() {}
 
 func (e *UserStatusRecently) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -5141,9 +4617,6 @@ func (*UserStatusLastWeek) CRC() uint32 {
 func (*UserStatusLastWeek) ImplementsUserStatus() {}
 
 func (e *UserStatusLastWeek) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -5158,9 +4631,6 @@ func (*UserStatusLastMonth) CRC() uint32 {
 func (*UserStatusLastMonth) ImplementsUserStatus() {}
 
 func (e *UserStatusLastMonth) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -5186,9 +4656,6 @@ func (*WebDocumentObj) CRC() uint32 {
 func (*WebDocumentObj) ImplementsWebDocument() {}
 
 func (e *WebDocumentObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Url)
@@ -5213,9 +4680,6 @@ func (*WebDocumentNoProxy) CRC() uint32 {
 func (*WebDocumentNoProxy) ImplementsWebDocument() {}
 
 func (e *WebDocumentNoProxy) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Url)
@@ -5244,9 +4708,6 @@ func (*SecureValueErrorData) CRC() uint32 {
 func (*SecureValueErrorData) ImplementsSecureValueError() {}
 
 func (e *SecureValueErrorData) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Type.Encode())
@@ -5269,9 +4730,6 @@ func (*SecureValueErrorFrontSide) CRC() uint32 {
 func (*SecureValueErrorFrontSide) ImplementsSecureValueError() {}
 
 func (e *SecureValueErrorFrontSide) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Type.Encode())
@@ -5293,9 +4751,6 @@ func (*SecureValueErrorReverseSide) CRC() uint32 {
 func (*SecureValueErrorReverseSide) ImplementsSecureValueError() {}
 
 func (e *SecureValueErrorReverseSide) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Type.Encode())
@@ -5317,9 +4772,6 @@ func (*SecureValueErrorSelfie) CRC() uint32 {
 func (*SecureValueErrorSelfie) ImplementsSecureValueError() {}
 
 func (e *SecureValueErrorSelfie) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Type.Encode())
@@ -5341,9 +4793,6 @@ func (*SecureValueErrorFile) CRC() uint32 {
 func (*SecureValueErrorFile) ImplementsSecureValueError() {}
 
 func (e *SecureValueErrorFile) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Type.Encode())
@@ -5365,9 +4814,6 @@ func (*SecureValueErrorFiles) CRC() uint32 {
 func (*SecureValueErrorFiles) ImplementsSecureValueError() {}
 
 func (e *SecureValueErrorFiles) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Type.Encode())
@@ -5389,9 +4835,6 @@ func (*SecureValueErrorObj) CRC() uint32 {
 func (*SecureValueErrorObj) ImplementsSecureValueError() {}
 
 func (e *SecureValueErrorObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Type.Encode())
@@ -5413,9 +4856,6 @@ func (*SecureValueErrorTranslationFile) CRC() uint32 {
 func (*SecureValueErrorTranslationFile) ImplementsSecureValueError() {}
 
 func (e *SecureValueErrorTranslationFile) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Type.Encode())
@@ -5437,9 +4877,6 @@ func (*SecureValueErrorTranslationFiles) CRC() uint32 {
 func (*SecureValueErrorTranslationFiles) ImplementsSecureValueError() {}
 
 func (e *SecureValueErrorTranslationFiles) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Type.Encode())
@@ -5464,9 +4901,6 @@ func (*InputDialogPeerObj) CRC() uint32 {
 func (*InputDialogPeerObj) ImplementsInputDialogPeer() {}
 
 func (e *InputDialogPeerObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -5484,9 +4918,6 @@ func (*InputDialogPeerFolder) CRC() uint32 {
 func (*InputDialogPeerFolder) ImplementsInputDialogPeer() {}
 
 func (e *InputDialogPeerFolder) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.FolderId)
@@ -5509,9 +4940,6 @@ func (*InputNotifyPeerObj) CRC() uint32 {
 func (*InputNotifyPeerObj) ImplementsInputNotifyPeer() {}
 
 func (e *InputNotifyPeerObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -5527,9 +4955,6 @@ func (*InputNotifyUsers) CRC() uint32 {
 func (*InputNotifyUsers) ImplementsInputNotifyPeer() {}
 
 func (e *InputNotifyUsers) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -5544,9 +4969,6 @@ func (*InputNotifyChats) CRC() uint32 {
 func (*InputNotifyChats) ImplementsInputNotifyPeer() {}
 
 func (e *InputNotifyChats) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -5561,9 +4983,6 @@ func (*InputNotifyBroadcasts) CRC() uint32 {
 func (*InputNotifyBroadcasts) ImplementsInputNotifyPeer() {}
 
 func (e *InputNotifyBroadcasts) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -5587,9 +5006,6 @@ func (*PeerLocatedObj) CRC() uint32 {
 func (*PeerLocatedObj) ImplementsPeerLocated() {}
 
 func (e *PeerLocatedObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -5609,9 +5025,6 @@ func (*PeerSelfLocated) CRC() uint32 {
 func (*PeerSelfLocated) ImplementsPeerLocated() {}
 
 func (e *PeerSelfLocated) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Expires)
@@ -5634,9 +5047,6 @@ func (*InputMessageID) CRC() uint32 {
 func (*InputMessageID) ImplementsInputMessage() {}
 
 func (e *InputMessageID) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Id)
@@ -5654,9 +5064,6 @@ func (*InputMessageReplyTo) CRC() uint32 {
 func (*InputMessageReplyTo) ImplementsInputMessage() {}
 
 func (e *InputMessageReplyTo) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Id)
@@ -5672,9 +5079,6 @@ func (*InputMessagePinned) CRC() uint32 {
 func (*InputMessagePinned) ImplementsInputMessage() {}
 
 func (e *InputMessagePinned) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -5696,9 +5100,6 @@ func (*PhotoEmpty) CRC() uint32 {
 func (*PhotoEmpty) ImplementsPhoto() {}
 
 func (e *PhotoEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -5724,9 +5125,6 @@ func (*PhotoObj) CRC() uint32 {
 func (*PhotoObj) ImplementsPhoto() {}
 
 func (e *PhotoObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.HasStickers) {
 		flag |= 1 << 0
@@ -5766,9 +5164,6 @@ func (*StatsGraphAsync) CRC() uint32 {
 func (*StatsGraphAsync) ImplementsStatsGraph() {}
 
 func (e *StatsGraphAsync) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Token)
@@ -5786,9 +5181,6 @@ func (*StatsGraphError) CRC() uint32 {
 func (*StatsGraphError) ImplementsStatsGraph() {}
 
 func (e *StatsGraphError) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Error)
@@ -5808,9 +5200,6 @@ func (*StatsGraphObj) CRC() uint32 {
 func (*StatsGraphObj) ImplementsStatsGraph() {}
 
 func (e *StatsGraphObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ZoomToken) {
 		flag |= 1 << 0
@@ -5838,9 +5227,6 @@ func (*MessagesFoundStickerSetsNotModified) CRC() uint32 {
 func (*MessagesFoundStickerSetsNotModified) ImplementsMessagesFoundStickerSets() {}
 
 func (e *MessagesFoundStickerSetsNotModified) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -5858,9 +5244,6 @@ func (*MessagesFoundStickerSetsObj) CRC() uint32 {
 func (*MessagesFoundStickerSetsObj) ImplementsMessagesFoundStickerSets() {}
 
 func (e *MessagesFoundStickerSetsObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Hash)
@@ -5882,9 +5265,6 @@ func (*ChannelMessagesFilterEmpty) CRC() uint32 {
 func (*ChannelMessagesFilterEmpty) ImplementsChannelMessagesFilter() {}
 
 func (e *ChannelMessagesFilterEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -5903,9 +5283,6 @@ func (*ChannelMessagesFilterObj) CRC() uint32 {
 func (*ChannelMessagesFilterObj) ImplementsChannelMessagesFilter() {}
 
 func (e *ChannelMessagesFilterObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ExcludeNewMessages) {
 		flag |= 1 << 1
@@ -5934,9 +5311,6 @@ func (*RecentMeUrlUnknown) CRC() uint32 {
 func (*RecentMeUrlUnknown) ImplementsRecentMeUrl() {}
 
 func (e *RecentMeUrlUnknown) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Url)
@@ -5955,9 +5329,6 @@ func (*RecentMeUrlUser) CRC() uint32 {
 func (*RecentMeUrlUser) ImplementsRecentMeUrl() {}
 
 func (e *RecentMeUrlUser) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Url)
@@ -5977,9 +5348,6 @@ func (*RecentMeUrlChat) CRC() uint32 {
 func (*RecentMeUrlChat) ImplementsRecentMeUrl() {}
 
 func (e *RecentMeUrlChat) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Url)
@@ -5999,9 +5367,6 @@ func (*RecentMeUrlChatInvite) CRC() uint32 {
 func (*RecentMeUrlChatInvite) ImplementsRecentMeUrl() {}
 
 func (e *RecentMeUrlChatInvite) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Url)
@@ -6021,9 +5386,6 @@ func (*RecentMeUrlStickerSet) CRC() uint32 {
 func (*RecentMeUrlStickerSet) ImplementsRecentMeUrl() {}
 
 func (e *RecentMeUrlStickerSet) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Url)
@@ -6059,9 +5421,6 @@ func (*DialogObj) CRC() uint32 {
 func (*DialogObj) ImplementsDialog() {}
 
 func (e *DialogObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Pts) {
 		flag |= 1 << 0
@@ -6122,9 +5481,6 @@ func (*DialogFolder) CRC() uint32 {
 func (*DialogFolder) ImplementsDialog() {}
 
 func (e *DialogFolder) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Pinned) {
 		flag |= 1 << 2
@@ -6157,9 +5513,6 @@ func (*SecurePasswordKdfAlgoUnknown) CRC() uint32 {
 func (*SecurePasswordKdfAlgoUnknown) ImplementsSecurePasswordKdfAlgo() {}
 
 func (e *SecurePasswordKdfAlgoUnknown) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -6176,9 +5529,6 @@ func (*SecurePasswordKdfAlgoPBKDF2HMACSHA512iter100000) CRC() uint32 {
 func (*SecurePasswordKdfAlgoPBKDF2HMACSHA512iter100000) ImplementsSecurePasswordKdfAlgo() {}
 
 func (e *SecurePasswordKdfAlgoPBKDF2HMACSHA512iter100000) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutMessage(e.Salt)
@@ -6196,9 +5546,6 @@ func (*SecurePasswordKdfAlgoSHA512) CRC() uint32 {
 func (*SecurePasswordKdfAlgoSHA512) ImplementsSecurePasswordKdfAlgo() {}
 
 func (e *SecurePasswordKdfAlgoSHA512) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutMessage(e.Salt)
@@ -6221,9 +5568,6 @@ func (*SecurePlainPhone) CRC() uint32 {
 func (*SecurePlainPhone) ImplementsSecurePlainData() {}
 
 func (e *SecurePlainPhone) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Phone)
@@ -6241,9 +5585,6 @@ func (*SecurePlainEmail) CRC() uint32 {
 func (*SecurePlainEmail) ImplementsSecurePlainData() {}
 
 func (e *SecurePlainEmail) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Email)
@@ -6274,9 +5615,6 @@ func (*InputBotInlineResultObj) CRC() uint32 {
 func (*InputBotInlineResultObj) ImplementsInputBotInlineResult() {}
 
 func (e *InputBotInlineResultObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Title) {
 		flag |= 1 << 1
@@ -6330,9 +5668,6 @@ func (*InputBotInlineResultPhoto) CRC() uint32 {
 func (*InputBotInlineResultPhoto) ImplementsInputBotInlineResult() {}
 
 func (e *InputBotInlineResultPhoto) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Id)
@@ -6359,9 +5694,6 @@ func (*InputBotInlineResultDocument) CRC() uint32 {
 func (*InputBotInlineResultDocument) ImplementsInputBotInlineResult() {}
 
 func (e *InputBotInlineResultDocument) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Title) {
 		flag |= 1 << 1
@@ -6397,9 +5729,6 @@ func (*InputBotInlineResultGame) CRC() uint32 {
 func (*InputBotInlineResultGame) ImplementsInputBotInlineResult() {}
 
 func (e *InputBotInlineResultGame) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Id)
@@ -6428,9 +5757,6 @@ func (*EncryptedMessageObj) CRC() uint32 {
 func (*EncryptedMessageObj) ImplementsEncryptedMessage() {}
 
 func (e *EncryptedMessageObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.RandomId)
@@ -6455,9 +5781,6 @@ func (*EncryptedMessageService) CRC() uint32 {
 func (*EncryptedMessageService) ImplementsEncryptedMessage() {}
 
 func (e *EncryptedMessageService) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.RandomId)
@@ -6481,9 +5804,6 @@ func (*InputCheckPasswordEmpty) CRC() uint32 {
 func (*InputCheckPasswordEmpty) ImplementsInputCheckPasswordSRP() {}
 
 func (e *InputCheckPasswordEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -6502,9 +5822,6 @@ func (*InputCheckPasswordSRPObj) CRC() uint32 {
 func (*InputCheckPasswordSRPObj) ImplementsInputCheckPasswordSRP() {}
 
 func (e *InputCheckPasswordSRPObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.SrpId)
@@ -6527,9 +5844,6 @@ func (*AccountThemesNotModified) CRC() uint32 {
 func (*AccountThemesNotModified) ImplementsAccountThemes() {}
 
 func (e *AccountThemesNotModified) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -6547,9 +5861,6 @@ func (*AccountThemesObj) CRC() uint32 {
 func (*AccountThemesObj) ImplementsAccountThemes() {}
 
 func (e *AccountThemesObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Hash)
@@ -6574,9 +5885,6 @@ func (*InputPaymentCredentialsSaved) CRC() uint32 {
 func (*InputPaymentCredentialsSaved) ImplementsInputPaymentCredentials() {}
 
 func (e *InputPaymentCredentialsSaved) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Id)
@@ -6597,9 +5905,6 @@ func (*InputPaymentCredentialsObj) CRC() uint32 {
 func (*InputPaymentCredentialsObj) ImplementsInputPaymentCredentials() {}
 
 func (e *InputPaymentCredentialsObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Save) {
 		flag |= 1 << 0
@@ -6623,9 +5928,6 @@ func (*InputPaymentCredentialsApplePay) CRC() uint32 {
 func (*InputPaymentCredentialsApplePay) ImplementsInputPaymentCredentials() {}
 
 func (e *InputPaymentCredentialsApplePay) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.PaymentData.Encode())
@@ -6644,9 +5946,6 @@ func (*InputPaymentCredentialsAndroidPay) CRC() uint32 {
 func (*InputPaymentCredentialsAndroidPay) ImplementsInputPaymentCredentials() {}
 
 func (e *InputPaymentCredentialsAndroidPay) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.PaymentToken.Encode())
@@ -6672,9 +5971,6 @@ func (*ChatParticipantObj) CRC() uint32 {
 func (*ChatParticipantObj) ImplementsChatParticipant() {}
 
 func (e *ChatParticipantObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -6694,9 +5990,6 @@ func (*ChatParticipantCreator) CRC() uint32 {
 func (*ChatParticipantCreator) ImplementsChatParticipant() {}
 
 func (e *ChatParticipantCreator) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -6716,9 +6009,6 @@ func (*ChatParticipantAdmin) CRC() uint32 {
 func (*ChatParticipantAdmin) ImplementsChatParticipant() {}
 
 func (e *ChatParticipantAdmin) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -6743,9 +6033,6 @@ func (*ChatInviteAlready) CRC() uint32 {
 func (*ChatInviteAlready) ImplementsChatInvite() {}
 
 func (e *ChatInviteAlready) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Chat.Encode())
@@ -6771,9 +6058,6 @@ func (*ChatInviteObj) CRC() uint32 {
 func (*ChatInviteObj) ImplementsChatInvite() {}
 
 func (e *ChatInviteObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Channel) {
 		flag |= 1 << 0
@@ -6821,9 +6105,6 @@ func (*ChatInvitePeek) CRC() uint32 {
 func (*ChatInvitePeek) ImplementsChatInvite() {}
 
 func (e *ChatInvitePeek) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Chat.Encode())
@@ -6849,9 +6130,6 @@ func (*AuthAuthorizationObj) CRC() uint32 {
 func (*AuthAuthorizationObj) ImplementsAuthAuthorization() {}
 
 func (e *AuthAuthorizationObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.TmpSessions) {
 		flag |= 1 << 0
@@ -6877,9 +6155,6 @@ func (*AuthAuthorizationSignUpRequired) CRC() uint32 {
 func (*AuthAuthorizationSignUpRequired) ImplementsAuthAuthorization() {}
 
 func (e *AuthAuthorizationSignUpRequired) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.TermsOfService) {
 		flag |= 1 << 0
@@ -6906,9 +6181,6 @@ func (*InputPrivacyValueAllowContacts) CRC() uint32 {
 func (*InputPrivacyValueAllowContacts) ImplementsInputPrivacyRule() {}
 
 func (e *InputPrivacyValueAllowContacts) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -6923,9 +6195,6 @@ func (*InputPrivacyValueAllowAll) CRC() uint32 {
 func (*InputPrivacyValueAllowAll) ImplementsInputPrivacyRule() {}
 
 func (e *InputPrivacyValueAllowAll) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -6942,9 +6211,6 @@ func (*InputPrivacyValueAllowUsers) CRC() uint32 {
 func (*InputPrivacyValueAllowUsers) ImplementsInputPrivacyRule() {}
 
 func (e *InputPrivacyValueAllowUsers) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Users)
@@ -6960,9 +6226,6 @@ func (*InputPrivacyValueDisallowContacts) CRC() uint32 {
 func (*InputPrivacyValueDisallowContacts) ImplementsInputPrivacyRule() {}
 
 func (e *InputPrivacyValueDisallowContacts) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -6977,9 +6240,6 @@ func (*InputPrivacyValueDisallowAll) CRC() uint32 {
 func (*InputPrivacyValueDisallowAll) ImplementsInputPrivacyRule() {}
 
 func (e *InputPrivacyValueDisallowAll) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -6996,9 +6256,6 @@ func (*InputPrivacyValueDisallowUsers) CRC() uint32 {
 func (*InputPrivacyValueDisallowUsers) ImplementsInputPrivacyRule() {}
 
 func (e *InputPrivacyValueDisallowUsers) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Users)
@@ -7016,9 +6273,6 @@ func (*InputPrivacyValueAllowChatParticipants) CRC() uint32 {
 func (*InputPrivacyValueAllowChatParticipants) ImplementsInputPrivacyRule() {}
 
 func (e *InputPrivacyValueAllowChatParticipants) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Chats)
@@ -7036,9 +6290,6 @@ func (*InputPrivacyValueDisallowChatParticipants) CRC() uint32 {
 func (*InputPrivacyValueDisallowChatParticipants) ImplementsInputPrivacyRule() {}
 
 func (e *InputPrivacyValueDisallowChatParticipants) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Chats)
@@ -7059,9 +6310,6 @@ func (*ChannelParticipantsRecent) CRC() uint32 {
 func (*ChannelParticipantsRecent) ImplementsChannelParticipantsFilter() {}
 
 func (e *ChannelParticipantsRecent) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -7076,9 +6324,6 @@ func (*ChannelParticipantsAdmins) CRC() uint32 {
 func (*ChannelParticipantsAdmins) ImplementsChannelParticipantsFilter() {}
 
 func (e *ChannelParticipantsAdmins) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -7095,9 +6340,6 @@ func (*ChannelParticipantsKicked) CRC() uint32 {
 func (*ChannelParticipantsKicked) ImplementsChannelParticipantsFilter() {}
 
 func (e *ChannelParticipantsKicked) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Q)
@@ -7113,9 +6355,6 @@ func (*ChannelParticipantsBots) CRC() uint32 {
 func (*ChannelParticipantsBots) ImplementsChannelParticipantsFilter() {}
 
 func (e *ChannelParticipantsBots) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -7132,9 +6371,6 @@ func (*ChannelParticipantsBanned) CRC() uint32 {
 func (*ChannelParticipantsBanned) ImplementsChannelParticipantsFilter() {}
 
 func (e *ChannelParticipantsBanned) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Q)
@@ -7152,9 +6388,6 @@ func (*ChannelParticipantsSearch) CRC() uint32 {
 func (*ChannelParticipantsSearch) ImplementsChannelParticipantsFilter() {}
 
 func (e *ChannelParticipantsSearch) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Q)
@@ -7172,9 +6405,6 @@ func (*ChannelParticipantsContacts) CRC() uint32 {
 func (*ChannelParticipantsContacts) ImplementsChannelParticipantsFilter() {}
 
 func (e *ChannelParticipantsContacts) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Q)
@@ -7195,9 +6425,6 @@ func (*InputChannelEmpty) CRC() uint32 {
 func (*InputChannelEmpty) ImplementsInputChannel() {}
 
 func (e *InputChannelEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -7215,9 +6442,6 @@ func (*InputChannelObj) CRC() uint32 {
 func (*InputChannelObj) ImplementsInputChannel() {}
 
 func (e *InputChannelObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChannelId)
@@ -7238,9 +6462,6 @@ func (*InputChannelFromMessage) CRC() uint32 {
 func (*InputChannelFromMessage) ImplementsInputChannel() {}
 
 func (e *InputChannelFromMessage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -7265,9 +6486,6 @@ func (*EncryptedChatEmpty) CRC() uint32 {
 func (*EncryptedChatEmpty) ImplementsEncryptedChat() {}
 
 func (e *EncryptedChatEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Id)
@@ -7289,9 +6507,6 @@ func (*EncryptedChatWaiting) CRC() uint32 {
 func (*EncryptedChatWaiting) ImplementsEncryptedChat() {}
 
 func (e *EncryptedChatWaiting) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Id)
@@ -7320,9 +6535,6 @@ func (*EncryptedChatRequested) CRC() uint32 {
 func (*EncryptedChatRequested) ImplementsEncryptedChat() {}
 
 func (e *EncryptedChatRequested) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.FolderId) {
 		flag |= 1 << 0
@@ -7358,9 +6570,6 @@ func (*EncryptedChatObj) CRC() uint32 {
 func (*EncryptedChatObj) ImplementsEncryptedChat() {}
 
 func (e *EncryptedChatObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Id)
@@ -7384,9 +6593,6 @@ func (*EncryptedChatDiscarded) CRC() uint32 {
 func (*EncryptedChatDiscarded) ImplementsEncryptedChat() {}
 
 func (e *EncryptedChatDiscarded) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Id)
@@ -7407,9 +6613,6 @@ func (*InputMediaEmpty) CRC() uint32 {
 func (*InputMediaEmpty) ImplementsInputMedia() {}
 
 func (e *InputMediaEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -7429,9 +6632,6 @@ func (*InputMediaUploadedPhoto) CRC() uint32 {
 func (*InputMediaUploadedPhoto) ImplementsInputMedia() {}
 
 func (e *InputMediaUploadedPhoto) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Stickers) {
 		flag |= 1 << 0
@@ -7464,9 +6664,6 @@ func (*InputMediaPhoto) CRC() uint32 {
 func (*InputMediaPhoto) ImplementsInputMedia() {}
 
 func (e *InputMediaPhoto) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.TtlSeconds) {
 		flag |= 1 << 0
@@ -7491,9 +6688,6 @@ func (*InputMediaGeoPoint) CRC() uint32 {
 func (*InputMediaGeoPoint) ImplementsInputMedia() {}
 
 func (e *InputMediaGeoPoint) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.GeoPoint.Encode())
@@ -7514,9 +6708,6 @@ func (*InputMediaContact) CRC() uint32 {
 func (*InputMediaContact) ImplementsInputMedia() {}
 
 func (e *InputMediaContact) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.PhoneNumber)
@@ -7545,9 +6736,6 @@ func (*InputMediaUploadedDocument) CRC() uint32 {
 func (*InputMediaUploadedDocument) ImplementsInputMedia() {}
 
 func (e *InputMediaUploadedDocument) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Stickers) {
 		flag |= 1 << 0
@@ -7598,9 +6786,6 @@ func (*InputMediaDocument) CRC() uint32 {
 func (*InputMediaDocument) ImplementsInputMedia() {}
 
 func (e *InputMediaDocument) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.TtlSeconds) {
 		flag |= 1 << 0
@@ -7630,9 +6815,6 @@ func (*InputMediaVenue) CRC() uint32 {
 func (*InputMediaVenue) ImplementsInputMedia() {}
 
 func (e *InputMediaVenue) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.GeoPoint.Encode())
@@ -7657,9 +6839,6 @@ func (*InputMediaPhotoExternal) CRC() uint32 {
 func (*InputMediaPhotoExternal) ImplementsInputMedia() {}
 
 func (e *InputMediaPhotoExternal) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.TtlSeconds) {
 		flag |= 1 << 0
@@ -7686,9 +6865,6 @@ func (*InputMediaDocumentExternal) CRC() uint32 {
 func (*InputMediaDocumentExternal) ImplementsInputMedia() {}
 
 func (e *InputMediaDocumentExternal) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.TtlSeconds) {
 		flag |= 1 << 0
@@ -7713,9 +6889,6 @@ func (*InputMediaGame) CRC() uint32 {
 func (*InputMediaGame) ImplementsInputMedia() {}
 
 func (e *InputMediaGame) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Id.Encode())
@@ -7741,9 +6914,6 @@ func (*InputMediaInvoice) CRC() uint32 {
 func (*InputMediaInvoice) ImplementsInputMedia() {}
 
 func (e *InputMediaInvoice) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Photo) {
 		flag |= 1 << 0
@@ -7777,9 +6947,6 @@ func (*InputMediaGeoLive) CRC() uint32 {
 func (*InputMediaGeoLive) ImplementsInputMedia() {}
 
 func (e *InputMediaGeoLive) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Stopped) {
 		flag |= 1 << 0
@@ -7813,9 +6980,6 @@ func (*InputMediaPoll) CRC() uint32 {
 func (*InputMediaPoll) ImplementsInputMedia() {}
 
 func (e *InputMediaPoll) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.CorrectAnswers) {
 		flag |= 1 << 0
@@ -7849,9 +7013,6 @@ func (*InputMediaDice) CRC() uint32 {
 func (*InputMediaDice) ImplementsInputMedia() {}
 
 func (e *InputMediaDice) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Emoticon)
@@ -7875,9 +7036,6 @@ func (*MessageEntityUnknown) CRC() uint32 {
 func (*MessageEntityUnknown) ImplementsMessageEntity() {}
 
 func (e *MessageEntityUnknown) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Offset)
@@ -7897,9 +7055,6 @@ func (*MessageEntityMention) CRC() uint32 {
 func (*MessageEntityMention) ImplementsMessageEntity() {}
 
 func (e *MessageEntityMention) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Offset)
@@ -7919,9 +7074,6 @@ func (*MessageEntityHashtag) CRC() uint32 {
 func (*MessageEntityHashtag) ImplementsMessageEntity() {}
 
 func (e *MessageEntityHashtag) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Offset)
@@ -7941,9 +7093,6 @@ func (*MessageEntityBotCommand) CRC() uint32 {
 func (*MessageEntityBotCommand) ImplementsMessageEntity() {}
 
 func (e *MessageEntityBotCommand) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Offset)
@@ -7963,9 +7112,6 @@ func (*MessageEntityUrl) CRC() uint32 {
 func (*MessageEntityUrl) ImplementsMessageEntity() {}
 
 func (e *MessageEntityUrl) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Offset)
@@ -7985,9 +7131,6 @@ func (*MessageEntityEmail) CRC() uint32 {
 func (*MessageEntityEmail) ImplementsMessageEntity() {}
 
 func (e *MessageEntityEmail) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Offset)
@@ -8007,9 +7150,6 @@ func (*MessageEntityBold) CRC() uint32 {
 func (*MessageEntityBold) ImplementsMessageEntity() {}
 
 func (e *MessageEntityBold) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Offset)
@@ -8029,9 +7169,6 @@ func (*MessageEntityItalic) CRC() uint32 {
 func (*MessageEntityItalic) ImplementsMessageEntity() {}
 
 func (e *MessageEntityItalic) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Offset)
@@ -8051,9 +7188,6 @@ func (*MessageEntityCode) CRC() uint32 {
 func (*MessageEntityCode) ImplementsMessageEntity() {}
 
 func (e *MessageEntityCode) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Offset)
@@ -8074,9 +7208,6 @@ func (*MessageEntityPre) CRC() uint32 {
 func (*MessageEntityPre) ImplementsMessageEntity() {}
 
 func (e *MessageEntityPre) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Offset)
@@ -8098,9 +7229,6 @@ func (*MessageEntityTextUrl) CRC() uint32 {
 func (*MessageEntityTextUrl) ImplementsMessageEntity() {}
 
 func (e *MessageEntityTextUrl) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Offset)
@@ -8122,9 +7250,6 @@ func (*MessageEntityMentionName) CRC() uint32 {
 func (*MessageEntityMentionName) ImplementsMessageEntity() {}
 
 func (e *MessageEntityMentionName) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Offset)
@@ -8146,9 +7271,6 @@ func (*InputMessageEntityMentionName) CRC() uint32 {
 func (*InputMessageEntityMentionName) ImplementsMessageEntity() {}
 
 func (e *InputMessageEntityMentionName) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Offset)
@@ -8169,9 +7291,6 @@ func (*MessageEntityPhone) CRC() uint32 {
 func (*MessageEntityPhone) ImplementsMessageEntity() {}
 
 func (e *MessageEntityPhone) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Offset)
@@ -8191,9 +7310,6 @@ func (*MessageEntityCashtag) CRC() uint32 {
 func (*MessageEntityCashtag) ImplementsMessageEntity() {}
 
 func (e *MessageEntityCashtag) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Offset)
@@ -8213,9 +7329,6 @@ func (*MessageEntityUnderline) CRC() uint32 {
 func (*MessageEntityUnderline) ImplementsMessageEntity() {}
 
 func (e *MessageEntityUnderline) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Offset)
@@ -8235,9 +7348,6 @@ func (*MessageEntityStrike) CRC() uint32 {
 func (*MessageEntityStrike) ImplementsMessageEntity() {}
 
 func (e *MessageEntityStrike) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Offset)
@@ -8257,9 +7367,6 @@ func (*MessageEntityBlockquote) CRC() uint32 {
 func (*MessageEntityBlockquote) ImplementsMessageEntity() {}
 
 func (e *MessageEntityBlockquote) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Offset)
@@ -8279,9 +7386,6 @@ func (*MessageEntityBankCard) CRC() uint32 {
 func (*MessageEntityBankCard) ImplementsMessageEntity() {}
 
 func (e *MessageEntityBankCard) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Offset)
@@ -8307,9 +7411,6 @@ func (*ChatParticipantsForbidden) CRC() uint32 {
 func (*ChatParticipantsForbidden) ImplementsChatParticipants() {}
 
 func (e *ChatParticipantsForbidden) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.SelfParticipant) {
 		flag |= 1 << 0
@@ -8336,9 +7437,6 @@ func (*ChatParticipantsObj) CRC() uint32 {
 func (*ChatParticipantsObj) ImplementsChatParticipants() {}
 
 func (e *ChatParticipantsObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChatId)
@@ -8363,9 +7461,6 @@ func (*MessagesChatsObj) CRC() uint32 {
 func (*MessagesChatsObj) ImplementsMessagesChats() {}
 
 func (e *MessagesChatsObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Chats)
@@ -8384,9 +7479,6 @@ func (*MessagesChatsSlice) CRC() uint32 {
 func (*MessagesChatsSlice) ImplementsMessagesChats() {}
 
 func (e *MessagesChatsSlice) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Count)
@@ -8410,9 +7502,6 @@ func (*MessagesFeaturedStickersNotModified) CRC() uint32 {
 func (*MessagesFeaturedStickersNotModified) ImplementsMessagesFeaturedStickers() {}
 
 func (e *MessagesFeaturedStickersNotModified) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Count)
@@ -8433,9 +7522,6 @@ func (*MessagesFeaturedStickersObj) CRC() uint32 {
 func (*MessagesFeaturedStickersObj) ImplementsMessagesFeaturedStickers() {}
 
 func (e *MessagesFeaturedStickersObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Hash)
@@ -8459,9 +7545,6 @@ func (*MessagesStickersNotModified) CRC() uint32 {
 func (*MessagesStickersNotModified) ImplementsMessagesStickers() {}
 
 func (e *MessagesStickersNotModified) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -8479,9 +7562,6 @@ func (*MessagesStickersObj) CRC() uint32 {
 func (*MessagesStickersObj) ImplementsMessagesStickers() {}
 
 func (e *MessagesStickersObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Hash)
@@ -8508,9 +7588,6 @@ func (*UpdatesChannelDifferenceEmpty) CRC() uint32 {
 func (*UpdatesChannelDifferenceEmpty) ImplementsUpdatesChannelDifference() {}
 
 func (e *UpdatesChannelDifferenceEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Final) {
 		flag |= 1 << 0
@@ -8546,9 +7623,6 @@ func (*UpdatesChannelDifferenceTooLong) CRC() uint32 {
 func (*UpdatesChannelDifferenceTooLong) ImplementsUpdatesChannelDifference() {}
 
 func (e *UpdatesChannelDifferenceTooLong) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Final) {
 		flag |= 1 << 0
@@ -8588,9 +7662,6 @@ func (*UpdatesChannelDifferenceObj) CRC() uint32 {
 func (*UpdatesChannelDifferenceObj) ImplementsUpdatesChannelDifference() {}
 
 func (e *UpdatesChannelDifferenceObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Final) {
 		flag |= 1 << 0
@@ -8627,9 +7698,6 @@ func (*UpdatesTooLong) CRC() uint32 {
 func (*UpdatesTooLong) ImplementsUpdates() {}
 
 func (e *UpdatesTooLong) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -8660,9 +7728,6 @@ func (*UpdateShortMessage) CRC() uint32 {
 func (*UpdateShortMessage) ImplementsUpdates() {}
 
 func (e *UpdateShortMessage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Out) {
 		flag |= 1 << 1
@@ -8745,9 +7810,6 @@ func (*UpdateShortChatMessage) CRC() uint32 {
 func (*UpdateShortChatMessage) ImplementsUpdates() {}
 
 func (e *UpdateShortChatMessage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Out) {
 		flag |= 1 << 1
@@ -8817,9 +7879,6 @@ func (*UpdateShort) CRC() uint32 {
 func (*UpdateShort) ImplementsUpdates() {}
 
 func (e *UpdateShort) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Update.Encode())
@@ -8843,9 +7902,6 @@ func (*UpdatesCombined) CRC() uint32 {
 func (*UpdatesCombined) ImplementsUpdates() {}
 
 func (e *UpdatesCombined) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Updates)
@@ -8872,9 +7928,6 @@ func (*UpdatesObj) CRC() uint32 {
 func (*UpdatesObj) ImplementsUpdates() {}
 
 func (e *UpdatesObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Updates)
@@ -8903,9 +7956,6 @@ func (*UpdateShortSentMessage) CRC() uint32 {
 func (*UpdateShortSentMessage) ImplementsUpdates() {}
 
 func (e *UpdateShortSentMessage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Out) {
 		flag |= 1 << 1
@@ -8951,9 +8001,6 @@ func (*UpdateNewMessage) CRC() uint32 {
 func (*UpdateNewMessage) ImplementsUpdate() {}
 
 func (e *UpdateNewMessage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Message.Encode())
@@ -8974,9 +8021,6 @@ func (*UpdateMessageID) CRC() uint32 {
 func (*UpdateMessageID) ImplementsUpdate() {}
 
 func (e *UpdateMessageID) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Id)
@@ -8997,9 +8041,6 @@ func (*UpdateDeleteMessages) CRC() uint32 {
 func (*UpdateDeleteMessages) ImplementsUpdate() {}
 
 func (e *UpdateDeleteMessages) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Messages)
@@ -9020,9 +8061,6 @@ func (*UpdateUserTyping) CRC() uint32 {
 func (*UpdateUserTyping) ImplementsUpdate() {}
 
 func (e *UpdateUserTyping) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -9043,9 +8081,6 @@ func (*UpdateChatUserTyping) CRC() uint32 {
 func (*UpdateChatUserTyping) ImplementsUpdate() {}
 
 func (e *UpdateChatUserTyping) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChatId)
@@ -9065,9 +8100,6 @@ func (*UpdateChatParticipants) CRC() uint32 {
 func (*UpdateChatParticipants) ImplementsUpdate() {}
 
 func (e *UpdateChatParticipants) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Participants.Encode())
@@ -9086,9 +8118,6 @@ func (*UpdateUserStatus) CRC() uint32 {
 func (*UpdateUserStatus) ImplementsUpdate() {}
 
 func (e *UpdateUserStatus) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -9110,9 +8139,6 @@ func (*UpdateUserName) CRC() uint32 {
 func (*UpdateUserName) ImplementsUpdate() {}
 
 func (e *UpdateUserName) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -9136,9 +8162,6 @@ func (*UpdateUserPhoto) CRC() uint32 {
 func (*UpdateUserPhoto) ImplementsUpdate() {}
 
 func (e *UpdateUserPhoto) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -9160,9 +8183,6 @@ func (*UpdateNewEncryptedMessage) CRC() uint32 {
 func (*UpdateNewEncryptedMessage) ImplementsUpdate() {}
 
 func (e *UpdateNewEncryptedMessage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Message.Encode())
@@ -9181,9 +8201,6 @@ func (*UpdateEncryptedChatTyping) CRC() uint32 {
 func (*UpdateEncryptedChatTyping) ImplementsUpdate() {}
 
 func (e *UpdateEncryptedChatTyping) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChatId)
@@ -9202,9 +8219,6 @@ func (*UpdateEncryption) CRC() uint32 {
 func (*UpdateEncryption) ImplementsUpdate() {}
 
 func (e *UpdateEncryption) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Chat.Encode())
@@ -9225,9 +8239,6 @@ func (*UpdateEncryptedMessagesRead) CRC() uint32 {
 func (*UpdateEncryptedMessagesRead) ImplementsUpdate() {}
 
 func (e *UpdateEncryptedMessagesRead) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChatId)
@@ -9251,9 +8262,6 @@ func (*UpdateChatParticipantAdd) CRC() uint32 {
 func (*UpdateChatParticipantAdd) ImplementsUpdate() {}
 
 func (e *UpdateChatParticipantAdd) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChatId)
@@ -9277,9 +8285,6 @@ func (*UpdateChatParticipantDelete) CRC() uint32 {
 func (*UpdateChatParticipantDelete) ImplementsUpdate() {}
 
 func (e *UpdateChatParticipantDelete) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChatId)
@@ -9299,9 +8304,6 @@ func (*UpdateDcOptions) CRC() uint32 {
 func (*UpdateDcOptions) ImplementsUpdate() {}
 
 func (e *UpdateDcOptions) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.DcOptions)
@@ -9320,9 +8322,6 @@ func (*UpdateUserBlocked) CRC() uint32 {
 func (*UpdateUserBlocked) ImplementsUpdate() {}
 
 func (e *UpdateUserBlocked) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -9342,9 +8341,6 @@ func (*UpdateNotifySettings) CRC() uint32 {
 func (*UpdateNotifySettings) ImplementsUpdate() {}
 
 func (e *UpdateNotifySettings) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -9369,9 +8365,6 @@ func (*UpdateServiceNotification) CRC() uint32 {
 func (*UpdateServiceNotification) ImplementsUpdate() {}
 
 func (e *UpdateServiceNotification) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Popup) {
 		flag |= 1 << 0
@@ -9405,9 +8398,6 @@ func (*UpdatePrivacy) CRC() uint32 {
 func (*UpdatePrivacy) ImplementsUpdate() {}
 
 func (e *UpdatePrivacy) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Key.Encode())
@@ -9427,9 +8417,6 @@ func (*UpdateUserPhone) CRC() uint32 {
 func (*UpdateUserPhone) ImplementsUpdate() {}
 
 func (e *UpdateUserPhone) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -9454,9 +8441,6 @@ func (*UpdateReadHistoryInbox) CRC() uint32 {
 func (*UpdateReadHistoryInbox) ImplementsUpdate() {}
 
 func (e *UpdateReadHistoryInbox) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.FolderId) {
 		flag |= 1 << 0
@@ -9488,9 +8472,6 @@ func (*UpdateReadHistoryOutbox) CRC() uint32 {
 func (*UpdateReadHistoryOutbox) ImplementsUpdate() {}
 
 func (e *UpdateReadHistoryOutbox) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -9513,9 +8494,6 @@ func (*UpdateWebPage) CRC() uint32 {
 func (*UpdateWebPage) ImplementsUpdate() {}
 
 func (e *UpdateWebPage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Webpage.Encode())
@@ -9537,9 +8515,6 @@ func (*UpdateReadMessagesContents) CRC() uint32 {
 func (*UpdateReadMessagesContents) ImplementsUpdate() {}
 
 func (e *UpdateReadMessagesContents) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Messages)
@@ -9561,9 +8536,6 @@ func (*UpdateChannelTooLong) CRC() uint32 {
 func (*UpdateChannelTooLong) ImplementsUpdate() {}
 
 func (e *UpdateChannelTooLong) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Pts) {
 		flag |= 1 << 0
@@ -9588,9 +8560,6 @@ func (*UpdateChannel) CRC() uint32 {
 func (*UpdateChannel) ImplementsUpdate() {}
 
 func (e *UpdateChannel) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChannelId)
@@ -9610,9 +8579,6 @@ func (*UpdateNewChannelMessage) CRC() uint32 {
 func (*UpdateNewChannelMessage) ImplementsUpdate() {}
 
 func (e *UpdateNewChannelMessage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Message.Encode())
@@ -9637,9 +8603,6 @@ func (*UpdateReadChannelInbox) CRC() uint32 {
 func (*UpdateReadChannelInbox) ImplementsUpdate() {}
 
 func (e *UpdateReadChannelInbox) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.FolderId) {
 		flag |= 1 << 0
@@ -9670,9 +8633,6 @@ func (*UpdateDeleteChannelMessages) CRC() uint32 {
 func (*UpdateDeleteChannelMessages) ImplementsUpdate() {}
 
 func (e *UpdateDeleteChannelMessages) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChannelId)
@@ -9695,9 +8655,6 @@ func (*UpdateChannelMessageViews) CRC() uint32 {
 func (*UpdateChannelMessageViews) ImplementsUpdate() {}
 
 func (e *UpdateChannelMessageViews) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChannelId)
@@ -9720,9 +8677,6 @@ func (*UpdateChatParticipantAdmin) CRC() uint32 {
 func (*UpdateChatParticipantAdmin) ImplementsUpdate() {}
 
 func (e *UpdateChatParticipantAdmin) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChatId)
@@ -9743,9 +8697,6 @@ func (*UpdateNewStickerSet) CRC() uint32 {
 func (*UpdateNewStickerSet) ImplementsUpdate() {}
 
 func (e *UpdateNewStickerSet) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Stickerset.Encode())
@@ -9765,9 +8716,6 @@ func (*UpdateStickerSetsOrder) CRC() uint32 {
 func (*UpdateStickerSetsOrder) ImplementsUpdate() {}
 
 func (e *UpdateStickerSetsOrder) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Masks) {
 		flag |= 1 << 0
@@ -9789,9 +8737,6 @@ func (*UpdateStickerSets) CRC() uint32 {
 func (*UpdateStickerSets) ImplementsUpdate() {}
 
 func (e *UpdateStickerSets) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -9806,9 +8751,6 @@ func (*UpdateSavedGifs) CRC() uint32 {
 func (*UpdateSavedGifs) ImplementsUpdate() {}
 
 func (e *UpdateSavedGifs) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -9830,9 +8772,6 @@ func (*UpdateBotInlineQuery) CRC() uint32 {
 func (*UpdateBotInlineQuery) ImplementsUpdate() {}
 
 func (e *UpdateBotInlineQuery) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Geo) {
 		flag |= 1 << 0
@@ -9865,9 +8804,6 @@ func (*UpdateBotInlineSend) CRC() uint32 {
 func (*UpdateBotInlineSend) ImplementsUpdate() {}
 
 func (e *UpdateBotInlineSend) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Geo) {
 		flag |= 1 << 0
@@ -9902,9 +8838,6 @@ func (*UpdateEditChannelMessage) CRC() uint32 {
 func (*UpdateEditChannelMessage) ImplementsUpdate() {}
 
 func (e *UpdateEditChannelMessage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Message.Encode())
@@ -9925,9 +8858,6 @@ func (*UpdateChannelPinnedMessage) CRC() uint32 {
 func (*UpdateChannelPinnedMessage) ImplementsUpdate() {}
 
 func (e *UpdateChannelPinnedMessage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChannelId)
@@ -9953,9 +8883,6 @@ func (*UpdateBotCallbackQuery) CRC() uint32 {
 func (*UpdateBotCallbackQuery) ImplementsUpdate() {}
 
 func (e *UpdateBotCallbackQuery) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Data) {
 		flag |= 1 << 0
@@ -9992,9 +8919,6 @@ func (*UpdateEditMessage) CRC() uint32 {
 func (*UpdateEditMessage) ImplementsUpdate() {}
 
 func (e *UpdateEditMessage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Message.Encode())
@@ -10020,9 +8944,6 @@ func (*UpdateInlineBotCallbackQuery) CRC() uint32 {
 func (*UpdateInlineBotCallbackQuery) ImplementsUpdate() {}
 
 func (e *UpdateInlineBotCallbackQuery) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Data) {
 		flag |= 1 << 0
@@ -10057,9 +8978,6 @@ func (*UpdateReadChannelOutbox) CRC() uint32 {
 func (*UpdateReadChannelOutbox) ImplementsUpdate() {}
 
 func (e *UpdateReadChannelOutbox) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChannelId)
@@ -10079,9 +8997,6 @@ func (*UpdateDraftMessage) CRC() uint32 {
 func (*UpdateDraftMessage) ImplementsUpdate() {}
 
 func (e *UpdateDraftMessage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -10098,9 +9013,6 @@ func (*UpdateReadFeaturedStickers) CRC() uint32 {
 func (*UpdateReadFeaturedStickers) ImplementsUpdate() {}
 
 func (e *UpdateReadFeaturedStickers) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -10115,9 +9027,6 @@ func (*UpdateRecentStickers) CRC() uint32 {
 func (*UpdateRecentStickers) ImplementsUpdate() {}
 
 func (e *UpdateRecentStickers) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -10132,9 +9041,6 @@ func (*UpdateConfig) CRC() uint32 {
 func (*UpdateConfig) ImplementsUpdate() {}
 
 func (e *UpdateConfig) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -10149,9 +9055,6 @@ func (*UpdatePtsChanged) CRC() uint32 {
 func (*UpdatePtsChanged) ImplementsUpdate() {}
 
 func (e *UpdatePtsChanged) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -10171,9 +9074,6 @@ func (*UpdateChannelWebPage) CRC() uint32 {
 func (*UpdateChannelWebPage) ImplementsUpdate() {}
 
 func (e *UpdateChannelWebPage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChannelId)
@@ -10197,9 +9097,6 @@ func (*UpdateDialogPinned) CRC() uint32 {
 func (*UpdateDialogPinned) ImplementsUpdate() {}
 
 func (e *UpdateDialogPinned) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Pinned) {
 		flag |= 1 << 0
@@ -10231,9 +9128,6 @@ func (*UpdatePinnedDialogs) CRC() uint32 {
 func (*UpdatePinnedDialogs) ImplementsUpdate() {}
 
 func (e *UpdatePinnedDialogs) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Order) {
 		flag |= 1 << 0
@@ -10263,9 +9157,6 @@ func (*UpdateBotWebhookJSON) CRC() uint32 {
 func (*UpdateBotWebhookJSON) ImplementsUpdate() {}
 
 func (e *UpdateBotWebhookJSON) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Data.Encode())
@@ -10285,9 +9176,6 @@ func (*UpdateBotWebhookJSONQuery) CRC() uint32 {
 func (*UpdateBotWebhookJSONQuery) ImplementsUpdate() {}
 
 func (e *UpdateBotWebhookJSONQuery) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.QueryId)
@@ -10310,9 +9198,6 @@ func (*UpdateBotShippingQuery) CRC() uint32 {
 func (*UpdateBotShippingQuery) ImplementsUpdate() {}
 
 func (e *UpdateBotShippingQuery) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.QueryId)
@@ -10340,9 +9225,6 @@ func (*UpdateBotPrecheckoutQuery) CRC() uint32 {
 func (*UpdateBotPrecheckoutQuery) ImplementsUpdate() {}
 
 func (e *UpdateBotPrecheckoutQuery) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Info) {
 		flag |= 1 << 0
@@ -10377,9 +9259,6 @@ func (*UpdatePhoneCall) CRC() uint32 {
 func (*UpdatePhoneCall) ImplementsUpdate() {}
 
 func (e *UpdatePhoneCall) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.PhoneCall.Encode())
@@ -10397,9 +9276,6 @@ func (*UpdateLangPackTooLong) CRC() uint32 {
 func (*UpdateLangPackTooLong) ImplementsUpdate() {}
 
 func (e *UpdateLangPackTooLong) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.LangCode)
@@ -10417,9 +9293,6 @@ func (*UpdateLangPack) CRC() uint32 {
 func (*UpdateLangPack) ImplementsUpdate() {}
 
 func (e *UpdateLangPack) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Difference.Encode())
@@ -10435,9 +9308,6 @@ func (*UpdateFavedStickers) CRC() uint32 {
 func (*UpdateFavedStickers) ImplementsUpdate() {}
 
 func (e *UpdateFavedStickers) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -10455,9 +9325,6 @@ func (*UpdateChannelReadMessagesContents) CRC() uint32 {
 func (*UpdateChannelReadMessagesContents) ImplementsUpdate() {}
 
 func (e *UpdateChannelReadMessagesContents) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChannelId)
@@ -10474,9 +9341,6 @@ func (*UpdateContactsReset) CRC() uint32 {
 func (*UpdateContactsReset) ImplementsUpdate() {}
 
 func (e *UpdateContactsReset) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -10494,9 +9358,6 @@ func (*UpdateChannelAvailableMessages) CRC() uint32 {
 func (*UpdateChannelAvailableMessages) ImplementsUpdate() {}
 
 func (e *UpdateChannelAvailableMessages) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChannelId)
@@ -10517,9 +9378,6 @@ func (*UpdateDialogUnreadMark) CRC() uint32 {
 func (*UpdateDialogUnreadMark) ImplementsUpdate() {}
 
 func (e *UpdateDialogUnreadMark) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Unread) {
 		flag |= 1 << 0
@@ -10544,9 +9402,6 @@ func (*UpdateUserPinnedMessage) CRC() uint32 {
 func (*UpdateUserPinnedMessage) ImplementsUpdate() {}
 
 func (e *UpdateUserPinnedMessage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -10567,9 +9422,6 @@ func (*UpdateChatPinnedMessage) CRC() uint32 {
 func (*UpdateChatPinnedMessage) ImplementsUpdate() {}
 
 func (e *UpdateChatPinnedMessage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChatId)
@@ -10592,9 +9444,6 @@ func (*UpdateMessagePoll) CRC() uint32 {
 func (*UpdateMessagePoll) ImplementsUpdate() {}
 
 func (e *UpdateMessagePoll) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Poll) {
 		flag |= 1 << 0
@@ -10622,9 +9471,6 @@ func (*UpdateChatDefaultBannedRights) CRC() uint32 {
 func (*UpdateChatDefaultBannedRights) ImplementsUpdate() {}
 
 func (e *UpdateChatDefaultBannedRights) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -10646,9 +9492,6 @@ func (*UpdateFolderPeers) CRC() uint32 {
 func (*UpdateFolderPeers) ImplementsUpdate() {}
 
 func (e *UpdateFolderPeers) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.FolderPeers)
@@ -10669,9 +9512,6 @@ func (*UpdatePeerSettings) CRC() uint32 {
 func (*UpdatePeerSettings) ImplementsUpdate() {}
 
 func (e *UpdatePeerSettings) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -10690,9 +9530,6 @@ func (*UpdatePeerLocated) CRC() uint32 {
 func (*UpdatePeerLocated) ImplementsUpdate() {}
 
 func (e *UpdatePeerLocated) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Peers)
@@ -10710,9 +9547,6 @@ func (*UpdateNewScheduledMessage) CRC() uint32 {
 func (*UpdateNewScheduledMessage) ImplementsUpdate() {}
 
 func (e *UpdateNewScheduledMessage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Message.Encode())
@@ -10731,9 +9565,6 @@ func (*UpdateDeleteScheduledMessages) CRC() uint32 {
 func (*UpdateDeleteScheduledMessages) ImplementsUpdate() {}
 
 func (e *UpdateDeleteScheduledMessages) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -10752,9 +9583,6 @@ func (*UpdateTheme) CRC() uint32 {
 func (*UpdateTheme) ImplementsUpdate() {}
 
 func (e *UpdateTheme) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Theme.Encode())
@@ -10773,9 +9601,6 @@ func (*UpdateGeoLiveViewed) CRC() uint32 {
 func (*UpdateGeoLiveViewed) ImplementsUpdate() {}
 
 func (e *UpdateGeoLiveViewed) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -10792,9 +9617,6 @@ func (*UpdateLoginToken) CRC() uint32 {
 func (*UpdateLoginToken) ImplementsUpdate() {}
 
 func (e *UpdateLoginToken) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -10813,9 +9635,6 @@ func (*UpdateMessagePollVote) CRC() uint32 {
 func (*UpdateMessagePollVote) ImplementsUpdate() {}
 
 func (e *UpdateMessagePollVote) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.PollId)
@@ -10837,9 +9656,6 @@ func (*UpdateDialogFilter) CRC() uint32 {
 func (*UpdateDialogFilter) ImplementsUpdate() {}
 
 func (e *UpdateDialogFilter) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Filter) {
 		flag |= 1 << 0
@@ -10864,9 +9680,6 @@ func (*UpdateDialogFilterOrder) CRC() uint32 {
 func (*UpdateDialogFilterOrder) ImplementsUpdate() {}
 
 func (e *UpdateDialogFilterOrder) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Order)
@@ -10882,9 +9695,6 @@ func (*UpdateDialogFilters) CRC() uint32 {
 func (*UpdateDialogFilters) ImplementsUpdate() {}
 
 func (e *UpdateDialogFilters) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -10902,9 +9712,6 @@ func (*UpdatePhoneCallSignalingData) CRC() uint32 {
 func (*UpdatePhoneCallSignalingData) ImplementsUpdate() {}
 
 func (e *UpdatePhoneCallSignalingData) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.PhoneCallId)
@@ -10929,9 +9736,6 @@ func (*UpdateChannelParticipant) CRC() uint32 {
 func (*UpdateChannelParticipant) ImplementsUpdate() {}
 
 func (e *UpdateChannelParticipant) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.PrevParticipant) {
 		flag |= 1 << 0
@@ -10968,9 +9772,6 @@ func (*InputEncryptedFileEmpty) CRC() uint32 {
 func (*InputEncryptedFileEmpty) ImplementsInputEncryptedFile() {}
 
 func (e *InputEncryptedFileEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -10990,9 +9791,6 @@ func (*InputEncryptedFileUploaded) CRC() uint32 {
 func (*InputEncryptedFileUploaded) ImplementsInputEncryptedFile() {}
 
 func (e *InputEncryptedFileUploaded) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -11014,9 +9812,6 @@ func (*InputEncryptedFileObj) CRC() uint32 {
 func (*InputEncryptedFileObj) ImplementsInputEncryptedFile() {}
 
 func (e *InputEncryptedFileObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -11037,9 +9832,6 @@ func (*InputEncryptedFileBigUploaded) CRC() uint32 {
 func (*InputEncryptedFileBigUploaded) ImplementsInputEncryptedFile() {}
 
 func (e *InputEncryptedFileBigUploaded) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -11066,9 +9858,6 @@ func (*MessagesMessagesObj) CRC() uint32 {
 func (*MessagesMessagesObj) ImplementsMessagesMessages() {}
 
 func (e *MessagesMessagesObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Messages)
@@ -11094,9 +9883,6 @@ func (*MessagesMessagesSlice) CRC() uint32 {
 func (*MessagesMessagesSlice) ImplementsMessagesMessages() {}
 
 func (e *MessagesMessagesSlice) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.NextRate) {
 		flag |= 1 << 0
@@ -11135,9 +9921,6 @@ func (*MessagesChannelMessages) CRC() uint32 {
 func (*MessagesChannelMessages) ImplementsMessagesMessages() {}
 
 func (e *MessagesChannelMessages) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Inexact) {
 		flag |= 1 << 1
@@ -11165,9 +9948,6 @@ func (*MessagesMessagesNotModified) CRC() uint32 {
 func (*MessagesMessagesNotModified) ImplementsMessagesMessages() {}
 
 func (e *MessagesMessagesNotModified) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Count)
@@ -11188,9 +9968,6 @@ func (*MessagesAllStickersNotModified) CRC() uint32 {
 func (*MessagesAllStickersNotModified) ImplementsMessagesAllStickers() {}
 
 func (e *MessagesAllStickersNotModified) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -11208,9 +9985,6 @@ func (*MessagesAllStickersObj) CRC() uint32 {
 func (*MessagesAllStickersObj) ImplementsMessagesAllStickers() {}
 
 func (e *MessagesAllStickersObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Hash)
@@ -11237,9 +10011,6 @@ func (*UrlAuthResultRequest) CRC() uint32 {
 func (*UrlAuthResultRequest) ImplementsUrlAuthResult() {}
 
 func (e *UrlAuthResultRequest) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.RequestWriteAccess) {
 		flag |= 1 << 0
@@ -11264,9 +10035,6 @@ func (*UrlAuthResultAccepted) CRC() uint32 {
 func (*UrlAuthResultAccepted) ImplementsUrlAuthResult() {}
 
 func (e *UrlAuthResultAccepted) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Url)
@@ -11282,9 +10050,6 @@ func (*UrlAuthResultDefault) CRC() uint32 {
 func (*UrlAuthResultDefault) ImplementsUrlAuthResult() {}
 
 func (e *UrlAuthResultDefault) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -11307,9 +10072,6 @@ func (*ChannelAdminLogEventActionChangeTitle) CRC() uint32 {
 func (*ChannelAdminLogEventActionChangeTitle) ImplementsChannelAdminLogEventAction() {}
 
 func (e *ChannelAdminLogEventActionChangeTitle) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.PrevValue)
@@ -11329,9 +10091,6 @@ func (*ChannelAdminLogEventActionChangeAbout) CRC() uint32 {
 func (*ChannelAdminLogEventActionChangeAbout) ImplementsChannelAdminLogEventAction() {}
 
 func (e *ChannelAdminLogEventActionChangeAbout) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.PrevValue)
@@ -11351,9 +10110,6 @@ func (*ChannelAdminLogEventActionChangeUsername) CRC() uint32 {
 func (*ChannelAdminLogEventActionChangeUsername) ImplementsChannelAdminLogEventAction() {}
 
 func (e *ChannelAdminLogEventActionChangeUsername) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.PrevValue)
@@ -11373,9 +10129,6 @@ func (*ChannelAdminLogEventActionChangePhoto) CRC() uint32 {
 func (*ChannelAdminLogEventActionChangePhoto) ImplementsChannelAdminLogEventAction() {}
 
 func (e *ChannelAdminLogEventActionChangePhoto) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.PrevPhoto.Encode())
@@ -11394,9 +10147,6 @@ func (*ChannelAdminLogEventActionToggleInvites) CRC() uint32 {
 func (*ChannelAdminLogEventActionToggleInvites) ImplementsChannelAdminLogEventAction() {}
 
 func (e *ChannelAdminLogEventActionToggleInvites) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutBool(e.NewValue)
@@ -11414,9 +10164,6 @@ func (*ChannelAdminLogEventActionToggleSignatures) CRC() uint32 {
 func (*ChannelAdminLogEventActionToggleSignatures) ImplementsChannelAdminLogEventAction() {}
 
 func (e *ChannelAdminLogEventActionToggleSignatures) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutBool(e.NewValue)
@@ -11434,9 +10181,6 @@ func (*ChannelAdminLogEventActionUpdatePinned) CRC() uint32 {
 func (*ChannelAdminLogEventActionUpdatePinned) ImplementsChannelAdminLogEventAction() {}
 
 func (e *ChannelAdminLogEventActionUpdatePinned) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Message.Encode())
@@ -11455,9 +10199,6 @@ func (*ChannelAdminLogEventActionEditMessage) CRC() uint32 {
 func (*ChannelAdminLogEventActionEditMessage) ImplementsChannelAdminLogEventAction() {}
 
 func (e *ChannelAdminLogEventActionEditMessage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.PrevMessage.Encode())
@@ -11476,9 +10217,6 @@ func (*ChannelAdminLogEventActionDeleteMessage) CRC() uint32 {
 func (*ChannelAdminLogEventActionDeleteMessage) ImplementsChannelAdminLogEventAction() {}
 
 func (e *ChannelAdminLogEventActionDeleteMessage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Message.Encode())
@@ -11494,9 +10232,6 @@ func (*ChannelAdminLogEventActionParticipantJoin) CRC() uint32 {
 func (*ChannelAdminLogEventActionParticipantJoin) ImplementsChannelAdminLogEventAction() {}
 
 func (e *ChannelAdminLogEventActionParticipantJoin) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -11511,9 +10246,6 @@ func (*ChannelAdminLogEventActionParticipantLeave) CRC() uint32 {
 func (*ChannelAdminLogEventActionParticipantLeave) ImplementsChannelAdminLogEventAction() {}
 
 func (e *ChannelAdminLogEventActionParticipantLeave) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -11530,9 +10262,6 @@ func (*ChannelAdminLogEventActionParticipantInvite) CRC() uint32 {
 func (*ChannelAdminLogEventActionParticipantInvite) ImplementsChannelAdminLogEventAction() {}
 
 func (e *ChannelAdminLogEventActionParticipantInvite) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Participant.Encode())
@@ -11551,9 +10280,6 @@ func (*ChannelAdminLogEventActionParticipantToggleBan) CRC() uint32 {
 func (*ChannelAdminLogEventActionParticipantToggleBan) ImplementsChannelAdminLogEventAction() {}
 
 func (e *ChannelAdminLogEventActionParticipantToggleBan) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.PrevParticipant.Encode())
@@ -11573,9 +10299,6 @@ func (*ChannelAdminLogEventActionParticipantToggleAdmin) CRC() uint32 {
 func (*ChannelAdminLogEventActionParticipantToggleAdmin) ImplementsChannelAdminLogEventAction() {}
 
 func (e *ChannelAdminLogEventActionParticipantToggleAdmin) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.PrevParticipant.Encode())
@@ -11595,9 +10318,6 @@ func (*ChannelAdminLogEventActionChangeStickerSet) CRC() uint32 {
 func (*ChannelAdminLogEventActionChangeStickerSet) ImplementsChannelAdminLogEventAction() {}
 
 func (e *ChannelAdminLogEventActionChangeStickerSet) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.PrevStickerset.Encode())
@@ -11616,9 +10336,6 @@ func (*ChannelAdminLogEventActionTogglePreHistoryHidden) CRC() uint32 {
 func (*ChannelAdminLogEventActionTogglePreHistoryHidden) ImplementsChannelAdminLogEventAction() {}
 
 func (e *ChannelAdminLogEventActionTogglePreHistoryHidden) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutBool(e.NewValue)
@@ -11637,9 +10354,6 @@ func (*ChannelAdminLogEventActionDefaultBannedRights) CRC() uint32 {
 func (*ChannelAdminLogEventActionDefaultBannedRights) ImplementsChannelAdminLogEventAction() {}
 
 func (e *ChannelAdminLogEventActionDefaultBannedRights) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.PrevBannedRights.Encode())
@@ -11658,9 +10372,6 @@ func (*ChannelAdminLogEventActionStopPoll) CRC() uint32 {
 func (*ChannelAdminLogEventActionStopPoll) ImplementsChannelAdminLogEventAction() {}
 
 func (e *ChannelAdminLogEventActionStopPoll) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Message.Encode())
@@ -11679,9 +10390,6 @@ func (*ChannelAdminLogEventActionChangeLinkedChat) CRC() uint32 {
 func (*ChannelAdminLogEventActionChangeLinkedChat) ImplementsChannelAdminLogEventAction() {}
 
 func (e *ChannelAdminLogEventActionChangeLinkedChat) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.PrevValue)
@@ -11701,9 +10409,6 @@ func (*ChannelAdminLogEventActionChangeLocation) CRC() uint32 {
 func (*ChannelAdminLogEventActionChangeLocation) ImplementsChannelAdminLogEventAction() {}
 
 func (e *ChannelAdminLogEventActionChangeLocation) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.PrevValue.Encode())
@@ -11723,9 +10428,6 @@ func (*ChannelAdminLogEventActionToggleSlowMode) CRC() uint32 {
 func (*ChannelAdminLogEventActionToggleSlowMode) ImplementsChannelAdminLogEventAction() {}
 
 func (e *ChannelAdminLogEventActionToggleSlowMode) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.PrevValue)
@@ -11751,9 +10453,6 @@ func (*ChannelsChannelParticipantsObj) CRC() uint32 {
 func (*ChannelsChannelParticipantsObj) ImplementsChannelsChannelParticipants() {}
 
 func (e *ChannelsChannelParticipantsObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Count)
@@ -11771,9 +10470,6 @@ func (*ChannelsChannelParticipantsNotModified) CRC() uint32 {
 func (*ChannelsChannelParticipantsNotModified) ImplementsChannelsChannelParticipants() {}
 
 func (e *ChannelsChannelParticipantsNotModified) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -11797,9 +10493,6 @@ func (*MessageUserVoteObj) CRC() uint32 {
 func (*MessageUserVoteObj) ImplementsMessageUserVote() {}
 
 func (e *MessageUserVoteObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -11820,9 +10513,6 @@ func (*MessageUserVoteInputOption) CRC() uint32 {
 func (*MessageUserVoteInputOption) ImplementsMessageUserVote() {}
 
 func (e *MessageUserVoteInputOption) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -11843,9 +10533,6 @@ func (*MessageUserVoteMultiple) CRC() uint32 {
 func (*MessageUserVoteMultiple) ImplementsMessageUserVote() {}
 
 func (e *MessageUserVoteMultiple) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -11870,9 +10557,6 @@ func (*DocumentEmpty) CRC() uint32 {
 func (*DocumentEmpty) ImplementsDocument() {}
 
 func (e *DocumentEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -11900,9 +10584,6 @@ func (*DocumentObj) CRC() uint32 {
 func (*DocumentObj) ImplementsDocument() {}
 
 func (e *DocumentObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Thumbs) {
 		flag |= 1 << 0
@@ -11948,9 +10629,6 @@ func (*MessagesDialogsObj) CRC() uint32 {
 func (*MessagesDialogsObj) ImplementsMessagesDialogs() {}
 
 func (e *MessagesDialogsObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Dialogs)
@@ -11975,9 +10653,6 @@ func (*MessagesDialogsSlice) CRC() uint32 {
 func (*MessagesDialogsSlice) ImplementsMessagesDialogs() {}
 
 func (e *MessagesDialogsSlice) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Count)
@@ -11999,9 +10674,6 @@ func (*MessagesDialogsNotModified) CRC() uint32 {
 func (*MessagesDialogsNotModified) ImplementsMessagesDialogs() {}
 
 func (e *MessagesDialogsNotModified) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Count)
@@ -12024,9 +10696,6 @@ func (*PhotoSizeEmpty) CRC() uint32 {
 func (*PhotoSizeEmpty) ImplementsPhotoSize() {}
 
 func (e *PhotoSizeEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Type)
@@ -12048,9 +10717,6 @@ func (*PhotoSizeObj) CRC() uint32 {
 func (*PhotoSizeObj) ImplementsPhotoSize() {}
 
 func (e *PhotoSizeObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Type)
@@ -12076,9 +10742,6 @@ func (*PhotoCachedSize) CRC() uint32 {
 func (*PhotoCachedSize) ImplementsPhotoSize() {}
 
 func (e *PhotoCachedSize) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Type)
@@ -12101,9 +10764,6 @@ func (*PhotoStrippedSize) CRC() uint32 {
 func (*PhotoStrippedSize) ImplementsPhotoSize() {}
 
 func (e *PhotoStrippedSize) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Type)
@@ -12128,9 +10788,6 @@ func (*InputWebFileLocationObj) CRC() uint32 {
 func (*InputWebFileLocationObj) ImplementsInputWebFileLocation() {}
 
 func (e *InputWebFileLocationObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Url)
@@ -12154,9 +10811,6 @@ func (*InputWebFileGeoPointLocation) CRC() uint32 {
 func (*InputWebFileGeoPointLocation) ImplementsInputWebFileLocation() {}
 
 func (e *InputWebFileGeoPointLocation) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.GeoPoint.Encode())
@@ -12182,9 +10836,6 @@ func (*ContactsTopPeersNotModified) CRC() uint32 {
 func (*ContactsTopPeersNotModified) ImplementsContactsTopPeers() {}
 
 func (e *ContactsTopPeersNotModified) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -12203,9 +10854,6 @@ func (*ContactsTopPeersObj) CRC() uint32 {
 func (*ContactsTopPeersObj) ImplementsContactsTopPeers() {}
 
 func (e *ContactsTopPeersObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Categories)
@@ -12223,9 +10871,6 @@ func (*ContactsTopPeersDisabled) CRC() uint32 {
 func (*ContactsTopPeersDisabled) ImplementsContactsTopPeers() {}
 
 func (e *ContactsTopPeersDisabled) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -12245,9 +10890,6 @@ func (*InputReportReasonSpam) CRC() uint32 {
 func (*InputReportReasonSpam) ImplementsReportReason() {}
 
 func (e *InputReportReasonSpam) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -12262,9 +10904,6 @@ func (*InputReportReasonViolence) CRC() uint32 {
 func (*InputReportReasonViolence) ImplementsReportReason() {}
 
 func (e *InputReportReasonViolence) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -12279,9 +10918,6 @@ func (*InputReportReasonPornography) CRC() uint32 {
 func (*InputReportReasonPornography) ImplementsReportReason() {}
 
 func (e *InputReportReasonPornography) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -12296,9 +10932,6 @@ func (*InputReportReasonChildAbuse) CRC() uint32 {
 func (*InputReportReasonChildAbuse) ImplementsReportReason() {}
 
 func (e *InputReportReasonChildAbuse) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -12315,9 +10948,6 @@ func (*InputReportReasonOther) CRC() uint32 {
 func (*InputReportReasonOther) ImplementsReportReason() {}
 
 func (e *InputReportReasonOther) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Text)
@@ -12333,9 +10963,6 @@ func (*InputReportReasonCopyright) CRC() uint32 {
 func (*InputReportReasonCopyright) ImplementsReportReason() {}
 
 func (e *InputReportReasonCopyright) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -12350,9 +10977,6 @@ func (*InputReportReasonGeoIrrelevant) CRC() uint32 {
 func (*InputReportReasonGeoIrrelevant) ImplementsReportReason() {}
 
 func (e *InputReportReasonGeoIrrelevant) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -12374,9 +10998,6 @@ func (*NotifyPeerObj) CRC() uint32 {
 func (*NotifyPeerObj) ImplementsNotifyPeer() {}
 
 func (e *NotifyPeerObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -12392,9 +11013,6 @@ func (*NotifyUsers) CRC() uint32 {
 func (*NotifyUsers) ImplementsNotifyPeer() {}
 
 func (e *NotifyUsers) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -12409,9 +11027,6 @@ func (*NotifyChats) CRC() uint32 {
 func (*NotifyChats) ImplementsNotifyPeer() {}
 
 func (e *NotifyChats) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -12426,9 +11041,6 @@ func (*NotifyBroadcasts) CRC() uint32 {
 func (*NotifyBroadcasts) ImplementsNotifyPeer() {}
 
 func (e *NotifyBroadcasts) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -12448,9 +11060,6 @@ func (*AccountWallPapersNotModified) CRC() uint32 {
 func (*AccountWallPapersNotModified) ImplementsAccountWallPapers() {}
 
 func (e *AccountWallPapersNotModified) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -12468,9 +11077,6 @@ func (*AccountWallPapersObj) CRC() uint32 {
 func (*AccountWallPapersObj) ImplementsAccountWallPapers() {}
 
 func (e *AccountWallPapersObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Hash)
@@ -12492,9 +11098,6 @@ func (*EncryptedFileEmpty) CRC() uint32 {
 func (*EncryptedFileEmpty) ImplementsEncryptedFile() {}
 
 func (e *EncryptedFileEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -12515,9 +11118,6 @@ func (*EncryptedFileObj) CRC() uint32 {
 func (*EncryptedFileObj) ImplementsEncryptedFile() {}
 
 func (e *EncryptedFileObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -12544,9 +11144,6 @@ func (*InputStickeredMediaPhoto) CRC() uint32 {
 func (*InputStickeredMediaPhoto) ImplementsInputStickeredMedia() {}
 
 func (e *InputStickeredMediaPhoto) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Id.Encode())
@@ -12564,9 +11161,6 @@ func (*InputStickeredMediaDocument) CRC() uint32 {
 func (*InputStickeredMediaDocument) ImplementsInputStickeredMedia() {}
 
 func (e *InputStickeredMediaDocument) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Id.Encode())
@@ -12589,9 +11183,6 @@ func (*MessagesDhConfigNotModified) CRC() uint32 {
 func (*MessagesDhConfigNotModified) ImplementsMessagesDhConfig() {}
 
 func (e *MessagesDhConfigNotModified) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutMessage(e.Random)
@@ -12612,9 +11203,6 @@ func (*MessagesDhConfigObj) CRC() uint32 {
 func (*MessagesDhConfigObj) ImplementsMessagesDhConfig() {}
 
 func (e *MessagesDhConfigObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.G)
@@ -12638,9 +11226,6 @@ func (*JsonNull) CRC() uint32 {
 func (*JsonNull) ImplementsJSONValue() {}
 
 func (e *JsonNull) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -12657,9 +11242,6 @@ func (*JsonBool) CRC() uint32 {
 func (*JsonBool) ImplementsJSONValue() {}
 
 func (e *JsonBool) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutBool(e.Value)
@@ -12677,9 +11259,6 @@ func (*JsonNumber) CRC() uint32 {
 func (*JsonNumber) ImplementsJSONValue() {}
 
 func (e *JsonNumber) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutDouble(e.Value)
@@ -12697,9 +11276,6 @@ func (*JsonString) CRC() uint32 {
 func (*JsonString) ImplementsJSONValue() {}
 
 func (e *JsonString) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Value)
@@ -12717,9 +11293,6 @@ func (*JsonArray) CRC() uint32 {
 func (*JsonArray) ImplementsJSONValue() {}
 
 func (e *JsonArray) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Value)
@@ -12737,9 +11310,6 @@ func (*JsonObject) CRC() uint32 {
 func (*JsonObject) ImplementsJSONValue() {}
 
 func (e *JsonObject) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Value)
@@ -12760,9 +11330,6 @@ func (*TextEmpty) CRC() uint32 {
 func (*TextEmpty) ImplementsRichText() {}
 
 func (e *TextEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -12779,9 +11346,6 @@ func (*TextPlain) CRC() uint32 {
 func (*TextPlain) ImplementsRichText() {}
 
 func (e *TextPlain) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Text)
@@ -12799,9 +11363,6 @@ func (*TextBold) CRC() uint32 {
 func (*TextBold) ImplementsRichText() {}
 
 func (e *TextBold) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Text.Encode())
@@ -12819,9 +11380,6 @@ func (*TextItalic) CRC() uint32 {
 func (*TextItalic) ImplementsRichText() {}
 
 func (e *TextItalic) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Text.Encode())
@@ -12839,9 +11397,6 @@ func (*TextUnderline) CRC() uint32 {
 func (*TextUnderline) ImplementsRichText() {}
 
 func (e *TextUnderline) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Text.Encode())
@@ -12859,9 +11414,6 @@ func (*TextStrike) CRC() uint32 {
 func (*TextStrike) ImplementsRichText() {}
 
 func (e *TextStrike) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Text.Encode())
@@ -12879,9 +11431,6 @@ func (*TextFixed) CRC() uint32 {
 func (*TextFixed) ImplementsRichText() {}
 
 func (e *TextFixed) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Text.Encode())
@@ -12901,9 +11450,6 @@ func (*TextUrl) CRC() uint32 {
 func (*TextUrl) ImplementsRichText() {}
 
 func (e *TextUrl) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Text.Encode())
@@ -12924,9 +11470,6 @@ func (*TextEmail) CRC() uint32 {
 func (*TextEmail) ImplementsRichText() {}
 
 func (e *TextEmail) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Text.Encode())
@@ -12945,9 +11488,6 @@ func (*TextConcat) CRC() uint32 {
 func (*TextConcat) ImplementsRichText() {}
 
 func (e *TextConcat) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Texts)
@@ -12965,9 +11505,6 @@ func (*TextSubscript) CRC() uint32 {
 func (*TextSubscript) ImplementsRichText() {}
 
 func (e *TextSubscript) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Text.Encode())
@@ -12985,9 +11522,6 @@ func (*TextSuperscript) CRC() uint32 {
 func (*TextSuperscript) ImplementsRichText() {}
 
 func (e *TextSuperscript) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Text.Encode())
@@ -13005,9 +11539,6 @@ func (*TextMarked) CRC() uint32 {
 func (*TextMarked) ImplementsRichText() {}
 
 func (e *TextMarked) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Text.Encode())
@@ -13026,9 +11557,6 @@ func (*TextPhone) CRC() uint32 {
 func (*TextPhone) ImplementsRichText() {}
 
 func (e *TextPhone) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Text.Encode())
@@ -13049,9 +11577,6 @@ func (*TextImage) CRC() uint32 {
 func (*TextImage) ImplementsRichText() {}
 
 func (e *TextImage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.DocumentId)
@@ -13072,9 +11597,6 @@ func (*TextAnchor) CRC() uint32 {
 func (*TextAnchor) ImplementsRichText() {}
 
 func (e *TextAnchor) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Text.Encode())
@@ -13096,9 +11618,6 @@ func (*PageBlockUnsupported) CRC() uint32 {
 func (*PageBlockUnsupported) ImplementsPageBlock() {}
 
 func (e *PageBlockUnsupported) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -13115,9 +11634,6 @@ func (*PageBlockTitle) CRC() uint32 {
 func (*PageBlockTitle) ImplementsPageBlock() {}
 
 func (e *PageBlockTitle) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Text.Encode())
@@ -13135,9 +11651,6 @@ func (*PageBlockSubtitle) CRC() uint32 {
 func (*PageBlockSubtitle) ImplementsPageBlock() {}
 
 func (e *PageBlockSubtitle) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Text.Encode())
@@ -13156,9 +11669,6 @@ func (*PageBlockAuthorDate) CRC() uint32 {
 func (*PageBlockAuthorDate) ImplementsPageBlock() {}
 
 func (e *PageBlockAuthorDate) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Author.Encode())
@@ -13177,9 +11687,6 @@ func (*PageBlockHeader) CRC() uint32 {
 func (*PageBlockHeader) ImplementsPageBlock() {}
 
 func (e *PageBlockHeader) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Text.Encode())
@@ -13197,9 +11704,6 @@ func (*PageBlockSubheader) CRC() uint32 {
 func (*PageBlockSubheader) ImplementsPageBlock() {}
 
 func (e *PageBlockSubheader) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Text.Encode())
@@ -13217,9 +11721,6 @@ func (*PageBlockParagraph) CRC() uint32 {
 func (*PageBlockParagraph) ImplementsPageBlock() {}
 
 func (e *PageBlockParagraph) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Text.Encode())
@@ -13238,9 +11739,6 @@ func (*PageBlockPreformatted) CRC() uint32 {
 func (*PageBlockPreformatted) ImplementsPageBlock() {}
 
 func (e *PageBlockPreformatted) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Text.Encode())
@@ -13259,9 +11757,6 @@ func (*PageBlockFooter) CRC() uint32 {
 func (*PageBlockFooter) ImplementsPageBlock() {}
 
 func (e *PageBlockFooter) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Text.Encode())
@@ -13277,9 +11772,6 @@ func (*PageBlockDivider) CRC() uint32 {
 func (*PageBlockDivider) ImplementsPageBlock() {}
 
 func (e *PageBlockDivider) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -13296,9 +11788,6 @@ func (*PageBlockAnchor) CRC() uint32 {
 func (*PageBlockAnchor) ImplementsPageBlock() {}
 
 func (e *PageBlockAnchor) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Name)
@@ -13316,9 +11805,6 @@ func (*PageBlockList) CRC() uint32 {
 func (*PageBlockList) ImplementsPageBlock() {}
 
 func (e *PageBlockList) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Items)
@@ -13337,9 +11823,6 @@ func (*PageBlockBlockquote) CRC() uint32 {
 func (*PageBlockBlockquote) ImplementsPageBlock() {}
 
 func (e *PageBlockBlockquote) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Text.Encode())
@@ -13359,9 +11842,6 @@ func (*PageBlockPullquote) CRC() uint32 {
 func (*PageBlockPullquote) ImplementsPageBlock() {}
 
 func (e *PageBlockPullquote) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Text.Encode())
@@ -13384,9 +11864,6 @@ func (*PageBlockPhoto) CRC() uint32 {
 func (*PageBlockPhoto) ImplementsPageBlock() {}
 
 func (e *PageBlockPhoto) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Url) || !zero.IsZeroVal(e.WebpageId) {
 		flag |= 1 << 0
@@ -13419,9 +11896,6 @@ func (*PageBlockVideo) CRC() uint32 {
 func (*PageBlockVideo) ImplementsPageBlock() {}
 
 func (e *PageBlockVideo) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Autoplay) {
 		flag |= 1 << 0
@@ -13451,9 +11925,6 @@ func (*PageBlockCover) CRC() uint32 {
 func (*PageBlockCover) ImplementsPageBlock() {}
 
 func (e *PageBlockCover) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Cover.Encode())
@@ -13479,9 +11950,6 @@ func (*PageBlockEmbed) CRC() uint32 {
 func (*PageBlockEmbed) ImplementsPageBlock() {}
 
 func (e *PageBlockEmbed) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.FullWidth) {
 		flag |= 1 << 0
@@ -13543,9 +12011,6 @@ func (*PageBlockEmbedPost) CRC() uint32 {
 func (*PageBlockEmbedPost) ImplementsPageBlock() {}
 
 func (e *PageBlockEmbedPost) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Url)
@@ -13570,9 +12035,6 @@ func (*PageBlockCollage) CRC() uint32 {
 func (*PageBlockCollage) ImplementsPageBlock() {}
 
 func (e *PageBlockCollage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Items)
@@ -13592,9 +12054,6 @@ func (*PageBlockSlideshow) CRC() uint32 {
 func (*PageBlockSlideshow) ImplementsPageBlock() {}
 
 func (e *PageBlockSlideshow) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Items)
@@ -13613,9 +12072,6 @@ func (*PageBlockChannel) CRC() uint32 {
 func (*PageBlockChannel) ImplementsPageBlock() {}
 
 func (e *PageBlockChannel) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
@@ -13634,9 +12090,6 @@ func (*PageBlockAudio) CRC() uint32 {
 func (*PageBlockAudio) ImplementsPageBlock() {}
 
 func (e *PageBlockAudio) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.AudioId)
@@ -13655,9 +12108,6 @@ func (*PageBlockKicker) CRC() uint32 {
 func (*PageBlockKicker) ImplementsPageBlock() {}
 
 func (e *PageBlockKicker) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Text.Encode())
@@ -13679,9 +12129,6 @@ func (*PageBlockTable) CRC() uint32 {
 func (*PageBlockTable) ImplementsPageBlock() {}
 
 func (e *PageBlockTable) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Bordered) {
 		flag |= 1 << 0
@@ -13711,9 +12158,6 @@ func (*PageBlockOrderedList) CRC() uint32 {
 func (*PageBlockOrderedList) ImplementsPageBlock() {}
 
 func (e *PageBlockOrderedList) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Items)
@@ -13734,9 +12178,6 @@ func (*PageBlockDetails) CRC() uint32 {
 func (*PageBlockDetails) ImplementsPageBlock() {}
 
 func (e *PageBlockDetails) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Open) {
 		flag |= 1 << 0
@@ -13762,9 +12203,6 @@ func (*PageBlockRelatedArticles) CRC() uint32 {
 func (*PageBlockRelatedArticles) ImplementsPageBlock() {}
 
 func (e *PageBlockRelatedArticles) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Title.Encode())
@@ -13787,9 +12225,6 @@ func (*PageBlockMap) CRC() uint32 {
 func (*PageBlockMap) ImplementsPageBlock() {}
 
 func (e *PageBlockMap) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Geo.Encode())
@@ -13816,9 +12251,6 @@ func (*UserEmpty) CRC() uint32 {
 func (*UserEmpty) ImplementsUser() {}
 
 func (e *UserEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Id)
@@ -13862,9 +12294,6 @@ func (*UserObj) CRC() uint32 {
 func (*UserObj) ImplementsUser() {}
 
 func (e *UserObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.AccessHash) {
 		flag |= 1 << 0
@@ -14022,9 +12451,6 @@ func (*SecureRequiredTypeObj) CRC() uint32 {
 func (*SecureRequiredTypeObj) ImplementsSecureRequiredType() {}
 
 func (e *SecureRequiredTypeObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.NativeNames) {
 		flag |= 1 << 0
@@ -14058,9 +12484,6 @@ func (*SecureRequiredTypeOneOf) CRC() uint32 {
 func (*SecureRequiredTypeOneOf) ImplementsSecureRequiredType() {}
 
 func (e *SecureRequiredTypeOneOf) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Types)
@@ -14081,9 +12504,6 @@ func (*InputGeoPointEmpty) CRC() uint32 {
 func (*InputGeoPointEmpty) ImplementsInputGeoPoint() {}
 
 func (e *InputGeoPointEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -14101,9 +12521,6 @@ func (*InputGeoPointObj) CRC() uint32 {
 func (*InputGeoPointObj) ImplementsInputGeoPoint() {}
 
 func (e *InputGeoPointObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutDouble(e.Lat)
@@ -14125,9 +12542,6 @@ func (*InputMessagesFilterEmpty) CRC() uint32 {
 func (*InputMessagesFilterEmpty) ImplementsMessagesFilter() {}
 
 func (e *InputMessagesFilterEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -14142,9 +12556,6 @@ func (*InputMessagesFilterPhotos) CRC() uint32 {
 func (*InputMessagesFilterPhotos) ImplementsMessagesFilter() {}
 
 func (e *InputMessagesFilterPhotos) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -14159,9 +12570,6 @@ func (*InputMessagesFilterVideo) CRC() uint32 {
 func (*InputMessagesFilterVideo) ImplementsMessagesFilter() {}
 
 func (e *InputMessagesFilterVideo) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -14176,9 +12584,6 @@ func (*InputMessagesFilterPhotoVideo) CRC() uint32 {
 func (*InputMessagesFilterPhotoVideo) ImplementsMessagesFilter() {}
 
 func (e *InputMessagesFilterPhotoVideo) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -14193,9 +12598,6 @@ func (*InputMessagesFilterDocument) CRC() uint32 {
 func (*InputMessagesFilterDocument) ImplementsMessagesFilter() {}
 
 func (e *InputMessagesFilterDocument) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -14210,9 +12612,6 @@ func (*InputMessagesFilterUrl) CRC() uint32 {
 func (*InputMessagesFilterUrl) ImplementsMessagesFilter() {}
 
 func (e *InputMessagesFilterUrl) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -14227,9 +12626,6 @@ func (*InputMessagesFilterGif) CRC() uint32 {
 func (*InputMessagesFilterGif) ImplementsMessagesFilter() {}
 
 func (e *InputMessagesFilterGif) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -14244,9 +12640,6 @@ func (*InputMessagesFilterVoice) CRC() uint32 {
 func (*InputMessagesFilterVoice) ImplementsMessagesFilter() {}
 
 func (e *InputMessagesFilterVoice) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -14261,9 +12654,6 @@ func (*InputMessagesFilterMusic) CRC() uint32 {
 func (*InputMessagesFilterMusic) ImplementsMessagesFilter() {}
 
 func (e *InputMessagesFilterMusic) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -14278,9 +12668,6 @@ func (*InputMessagesFilterChatPhotos) CRC() uint32 {
 func (*InputMessagesFilterChatPhotos) ImplementsMessagesFilter() {}
 
 func (e *InputMessagesFilterChatPhotos) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -14298,9 +12685,6 @@ func (*InputMessagesFilterPhoneCalls) CRC() uint32 {
 func (*InputMessagesFilterPhoneCalls) ImplementsMessagesFilter() {}
 
 func (e *InputMessagesFilterPhoneCalls) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Missed) {
 		flag |= 1 << 0
@@ -14321,9 +12705,6 @@ func (*InputMessagesFilterRoundVoice) CRC() uint32 {
 func (*InputMessagesFilterRoundVoice) ImplementsMessagesFilter() {}
 
 func (e *InputMessagesFilterRoundVoice) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -14338,9 +12719,6 @@ func (*InputMessagesFilterRoundVideo) CRC() uint32 {
 func (*InputMessagesFilterRoundVideo) ImplementsMessagesFilter() {}
 
 func (e *InputMessagesFilterRoundVideo) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -14355,9 +12733,6 @@ func (*InputMessagesFilterMyMentions) CRC() uint32 {
 func (*InputMessagesFilterMyMentions) ImplementsMessagesFilter() {}
 
 func (e *InputMessagesFilterMyMentions) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -14372,9 +12747,6 @@ func (*InputMessagesFilterGeo) CRC() uint32 {
 func (*InputMessagesFilterGeo) ImplementsMessagesFilter() {}
 
 func (e *InputMessagesFilterGeo) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -14389,9 +12761,6 @@ func (*InputMessagesFilterContacts) CRC() uint32 {
 func (*InputMessagesFilterContacts) ImplementsMessagesFilter() {}
 
 func (e *InputMessagesFilterContacts) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -14413,9 +12782,6 @@ func (*PeerUser) CRC() uint32 {
 func (*PeerUser) ImplementsPeer() {}
 
 func (e *PeerUser) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -14433,9 +12799,6 @@ func (*PeerChat) CRC() uint32 {
 func (*PeerChat) ImplementsPeer() {}
 
 func (e *PeerChat) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChatId)
@@ -14453,9 +12816,6 @@ func (*PeerChannel) CRC() uint32 {
 func (*PeerChannel) ImplementsPeer() {}
 
 func (e *PeerChannel) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChannelId)
@@ -14486,9 +12846,6 @@ func (*BotInlineResultObj) CRC() uint32 {
 func (*BotInlineResultObj) ImplementsBotInlineResult() {}
 
 func (e *BotInlineResultObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Title) {
 		flag |= 1 << 1
@@ -14546,9 +12903,6 @@ func (*BotInlineMediaResult) CRC() uint32 {
 func (*BotInlineMediaResult) ImplementsBotInlineResult() {}
 
 func (e *BotInlineMediaResult) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Photo) {
 		flag |= 1 << 0
@@ -14599,9 +12953,6 @@ func (*StickerSetCoveredObj) CRC() uint32 {
 func (*StickerSetCoveredObj) ImplementsStickerSetCovered() {}
 
 func (e *StickerSetCoveredObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Set.Encode())
@@ -14621,9 +12972,6 @@ func (*StickerSetMultiCovered) CRC() uint32 {
 func (*StickerSetMultiCovered) ImplementsStickerSetCovered() {}
 
 func (e *StickerSetMultiCovered) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Set.Encode())
@@ -14648,9 +12996,6 @@ func (*ChannelParticipantObj) CRC() uint32 {
 func (*ChannelParticipantObj) ImplementsChannelParticipant() {}
 
 func (e *ChannelParticipantObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -14671,9 +13016,6 @@ func (*ChannelParticipantSelf) CRC() uint32 {
 func (*ChannelParticipantSelf) ImplementsChannelParticipant() {}
 
 func (e *ChannelParticipantSelf) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -14695,9 +13037,6 @@ func (*ChannelParticipantCreator) CRC() uint32 {
 func (*ChannelParticipantCreator) ImplementsChannelParticipant() {}
 
 func (e *ChannelParticipantCreator) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Rank) {
 		flag |= 1 << 0
@@ -14730,9 +13069,6 @@ func (*ChannelParticipantAdmin) CRC() uint32 {
 func (*ChannelParticipantAdmin) ImplementsChannelParticipant() {}
 
 func (e *ChannelParticipantAdmin) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.CanEdit) {
 		flag |= 1 << 0
@@ -14778,9 +13114,6 @@ func (*ChannelParticipantBanned) CRC() uint32 {
 func (*ChannelParticipantBanned) ImplementsChannelParticipant() {}
 
 func (e *ChannelParticipantBanned) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Left) {
 		flag |= 1 << 0
@@ -14812,9 +13145,6 @@ func (*PaymentsPaymentResultObj) CRC() uint32 {
 func (*PaymentsPaymentResultObj) ImplementsPaymentsPaymentResult() {}
 
 func (e *PaymentsPaymentResultObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Updates.Encode())
@@ -14832,9 +13162,6 @@ func (*PaymentsPaymentVerificationNeeded) CRC() uint32 {
 func (*PaymentsPaymentVerificationNeeded) ImplementsPaymentsPaymentResult() {}
 
 func (e *PaymentsPaymentVerificationNeeded) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Url)
@@ -14855,9 +13182,6 @@ func (*MessagesFavedStickersNotModified) CRC() uint32 {
 func (*MessagesFavedStickersNotModified) ImplementsMessagesFavedStickers() {}
 
 func (e *MessagesFavedStickersNotModified) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -14876,9 +13200,6 @@ func (*MessagesFavedStickersObj) CRC() uint32 {
 func (*MessagesFavedStickersObj) ImplementsMessagesFavedStickers() {}
 
 func (e *MessagesFavedStickersObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Hash)
@@ -14904,9 +13225,6 @@ func (*ContactsBlockedObj) CRC() uint32 {
 func (*ContactsBlockedObj) ImplementsContactsBlocked() {}
 
 func (e *ContactsBlockedObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Blocked)
@@ -14927,9 +13245,6 @@ func (*ContactsBlockedSlice) CRC() uint32 {
 func (*ContactsBlockedSlice) ImplementsContactsBlocked() {}
 
 func (e *ContactsBlockedSlice) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Count)
@@ -14955,9 +13270,6 @@ func (*IpPortObj) CRC() uint32 {
 func (*IpPortObj) ImplementsIpPort() {}
 
 func (e *IpPortObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Ipv4)
@@ -14978,9 +13290,6 @@ func (*IpPortSecret) CRC() uint32 {
 func (*IpPortSecret) ImplementsIpPort() {}
 
 func (e *IpPortSecret) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Ipv4)
@@ -15003,9 +13312,6 @@ func (*UserProfilePhotoEmpty) CRC() uint32 {
 func (*UserProfilePhotoEmpty) ImplementsUserProfilePhoto() {}
 
 func (e *UserProfilePhotoEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -15027,9 +13333,6 @@ func (*UserProfilePhotoObj) CRC() uint32 {
 func (*UserProfilePhotoObj) ImplementsUserProfilePhoto() {}
 
 func (e *UserProfilePhotoObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.HasVideo) {
 		flag |= 1 << 0
@@ -15059,9 +13362,6 @@ func (*HelpDeepLinkInfoEmpty) CRC() uint32 {
 func (*HelpDeepLinkInfoEmpty) ImplementsHelpDeepLinkInfo() {}
 
 func (e *HelpDeepLinkInfoEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -15081,9 +13381,6 @@ func (*HelpDeepLinkInfoObj) CRC() uint32 {
 func (*HelpDeepLinkInfoObj) ImplementsHelpDeepLinkInfo() {}
 
 func (e *HelpDeepLinkInfoObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.UpdateApp) {
 		flag |= 1 << 0
@@ -15119,9 +13416,6 @@ func (*DraftMessageEmpty) CRC() uint32 {
 func (*DraftMessageEmpty) ImplementsDraftMessage() {}
 
 func (e *DraftMessageEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Date) {
 		flag |= 1 << 0
@@ -15150,9 +13444,6 @@ func (*DraftMessageObj) CRC() uint32 {
 func (*DraftMessageObj) ImplementsDraftMessage() {}
 
 func (e *DraftMessageObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ReplyToMsgId) {
 		flag |= 1 << 0
@@ -15195,9 +13486,6 @@ func (*LangPackStringObj) CRC() uint32 {
 func (*LangPackStringObj) ImplementsLangPackString() {}
 
 func (e *LangPackStringObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Key)
@@ -15223,9 +13511,6 @@ func (*LangPackStringPluralized) CRC() uint32 {
 func (*LangPackStringPluralized) ImplementsLangPackString() {}
 
 func (e *LangPackStringPluralized) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ZeroValue) {
 		flag |= 1 << 0
@@ -15275,9 +13560,6 @@ func (*LangPackStringDeleted) CRC() uint32 {
 func (*LangPackStringDeleted) ImplementsLangPackString() {}
 
 func (e *LangPackStringDeleted) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Key)
@@ -15301,9 +13583,6 @@ func (*InputGameID) CRC() uint32 {
 func (*InputGameID) ImplementsInputGame() {}
 
 func (e *InputGameID) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -15323,9 +13602,6 @@ func (*InputGameShortName) CRC() uint32 {
 func (*InputGameShortName) ImplementsInputGame() {}
 
 func (e *InputGameShortName) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.BotId.Encode())
@@ -15347,9 +13623,6 @@ func (*HelpPassportConfigNotModified) CRC() uint32 {
 func (*HelpPassportConfigNotModified) ImplementsHelpPassportConfig() {}
 
 func (e *HelpPassportConfigNotModified) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -15367,9 +13640,6 @@ func (*HelpPassportConfigObj) CRC() uint32 {
 func (*HelpPassportConfigObj) ImplementsHelpPassportConfig() {}
 
 func (e *HelpPassportConfigObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Hash)
@@ -15391,9 +13661,6 @@ func (*MessagesStickerSetInstallResultSuccess) CRC() uint32 {
 func (*MessagesStickerSetInstallResultSuccess) ImplementsMessagesStickerSetInstallResult() {}
 
 func (e *MessagesStickerSetInstallResultSuccess) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -15410,9 +13677,6 @@ func (*MessagesStickerSetInstallResultArchive) CRC() uint32 {
 func (*MessagesStickerSetInstallResultArchive) ImplementsMessagesStickerSetInstallResult() {}
 
 func (e *MessagesStickerSetInstallResultArchive) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Sets)
@@ -15442,9 +13706,6 @@ func (*HelpAppUpdateObj) CRC() uint32 {
 func (*HelpAppUpdateObj) ImplementsHelpAppUpdate() {}
 
 func (e *HelpAppUpdateObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.CanNotSkip) {
 		flag |= 1 << 0
@@ -15481,9 +13742,6 @@ func (*HelpNoAppUpdate) CRC() uint32 {
 func (*HelpNoAppUpdate) ImplementsHelpAppUpdate() {}
 
 func (e *HelpNoAppUpdate) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -15514,9 +13772,6 @@ func (*WallPaperObj) CRC() uint32 {
 func (*WallPaperObj) ImplementsWallPaper() {}
 
 func (e *WallPaperObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Creator) {
 		flag |= 1 << 0
@@ -15567,9 +13822,6 @@ func (*WallPaperNoFile) CRC() uint32 {
 func (*WallPaperNoFile) ImplementsWallPaper() {}
 
 func (e *WallPaperNoFile) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Default) {
 		flag |= 1 << 1
@@ -15608,9 +13860,6 @@ func (*HelpPromoDataEmpty) CRC() uint32 {
 func (*HelpPromoDataEmpty) ImplementsHelpPromoData() {}
 
 func (e *HelpPromoDataEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Expires)
@@ -15635,9 +13884,6 @@ func (*HelpPromoDataObj) CRC() uint32 {
 func (*HelpPromoDataObj) ImplementsHelpPromoData() {}
 
 func (e *HelpPromoDataObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Proxy) {
 		flag |= 1 << 0
@@ -15681,9 +13927,6 @@ func (*HelpTermsOfServiceUpdateEmpty) CRC() uint32 {
 func (*HelpTermsOfServiceUpdateEmpty) ImplementsHelpTermsOfServiceUpdate() {}
 
 func (e *HelpTermsOfServiceUpdateEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Expires)
@@ -15702,9 +13945,6 @@ func (*HelpTermsOfServiceUpdateObj) CRC() uint32 {
 func (*HelpTermsOfServiceUpdateObj) ImplementsHelpTermsOfServiceUpdate() {}
 
 func (e *HelpTermsOfServiceUpdateObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Expires)
@@ -15726,9 +13966,6 @@ func (*SendMessageTypingAction) CRC() uint32 {
 func (*SendMessageTypingAction) ImplementsSendMessageAction() {}
 
 func (e *SendMessageTypingAction) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -15743,9 +13980,6 @@ func (*SendMessageCancelAction) CRC() uint32 {
 func (*SendMessageCancelAction) ImplementsSendMessageAction() {}
 
 func (e *SendMessageCancelAction) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -15760,9 +13994,6 @@ func (*SendMessageRecordVideoAction) CRC() uint32 {
 func (*SendMessageRecordVideoAction) ImplementsSendMessageAction() {}
 
 func (e *SendMessageRecordVideoAction) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -15779,9 +14010,6 @@ func (*SendMessageUploadVideoAction) CRC() uint32 {
 func (*SendMessageUploadVideoAction) ImplementsSendMessageAction() {}
 
 func (e *SendMessageUploadVideoAction) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Progress)
@@ -15797,9 +14025,6 @@ func (*SendMessageRecordAudioAction) CRC() uint32 {
 func (*SendMessageRecordAudioAction) ImplementsSendMessageAction() {}
 
 func (e *SendMessageRecordAudioAction) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -15816,9 +14041,6 @@ func (*SendMessageUploadAudioAction) CRC() uint32 {
 func (*SendMessageUploadAudioAction) ImplementsSendMessageAction() {}
 
 func (e *SendMessageUploadAudioAction) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Progress)
@@ -15836,9 +14058,6 @@ func (*SendMessageUploadPhotoAction) CRC() uint32 {
 func (*SendMessageUploadPhotoAction) ImplementsSendMessageAction() {}
 
 func (e *SendMessageUploadPhotoAction) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Progress)
@@ -15856,9 +14075,6 @@ func (*SendMessageUploadDocumentAction) CRC() uint32 {
 func (*SendMessageUploadDocumentAction) ImplementsSendMessageAction() {}
 
 func (e *SendMessageUploadDocumentAction) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Progress)
@@ -15874,9 +14090,6 @@ func (*SendMessageGeoLocationAction) CRC() uint32 {
 func (*SendMessageGeoLocationAction) ImplementsSendMessageAction() {}
 
 func (e *SendMessageGeoLocationAction) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -15891,9 +14104,6 @@ func (*SendMessageChooseContactAction) CRC() uint32 {
 func (*SendMessageChooseContactAction) ImplementsSendMessageAction() {}
 
 func (e *SendMessageChooseContactAction) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -15908,9 +14118,6 @@ func (*SendMessageGamePlayAction) CRC() uint32 {
 func (*SendMessageGamePlayAction) ImplementsSendMessageAction() {}
 
 func (e *SendMessageGamePlayAction) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -15925,9 +14132,6 @@ func (*SendMessageRecordRoundAction) CRC() uint32 {
 func (*SendMessageRecordRoundAction) ImplementsSendMessageAction() {}
 
 func (e *SendMessageRecordRoundAction) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -15944,9 +14148,6 @@ func (*SendMessageUploadRoundAction) CRC() uint32 {
 func (*SendMessageUploadRoundAction) ImplementsSendMessageAction() {}
 
 func (e *SendMessageUploadRoundAction) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Progress)
@@ -15967,9 +14168,6 @@ func (*ContactsContactsNotModified) CRC() uint32 {
 func (*ContactsContactsNotModified) ImplementsContactsContacts() {}
 
 func (e *ContactsContactsNotModified) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -15988,9 +14186,6 @@ func (*ContactsContactsObj) CRC() uint32 {
 func (*ContactsContactsObj) ImplementsContactsContacts() {}
 
 func (e *ContactsContactsObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Contacts)
@@ -16013,9 +14208,6 @@ func (*ChatPhotoEmpty) CRC() uint32 {
 func (*ChatPhotoEmpty) ImplementsChatPhoto() {}
 
 func (e *ChatPhotoEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -16036,9 +14228,6 @@ func (*ChatPhotoObj) CRC() uint32 {
 func (*ChatPhotoObj) ImplementsChatPhoto() {}
 
 func (e *ChatPhotoObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.HasVideo) {
 		flag |= 1 << 0
@@ -16067,9 +14256,6 @@ func (*SecureFileEmpty) CRC() uint32 {
 func (*SecureFileEmpty) ImplementsSecureFile() {}
 
 func (e *SecureFileEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -16092,9 +14278,6 @@ func (*SecureFileObj) CRC() uint32 {
 func (*SecureFileObj) ImplementsSecureFile() {}
 
 func (e *SecureFileObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -16121,9 +14304,6 @@ func (*InputStickerSetEmpty) CRC() uint32 {
 func (*InputStickerSetEmpty) ImplementsInputStickerSet() {}
 
 func (e *InputStickerSetEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -16141,9 +14321,6 @@ func (*InputStickerSetID) CRC() uint32 {
 func (*InputStickerSetID) ImplementsInputStickerSet() {}
 
 func (e *InputStickerSetID) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -16162,9 +14339,6 @@ func (*InputStickerSetShortName) CRC() uint32 {
 func (*InputStickerSetShortName) ImplementsInputStickerSet() {}
 
 func (e *InputStickerSetShortName) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.ShortName)
@@ -16180,9 +14354,6 @@ func (*InputStickerSetAnimatedEmoji) CRC() uint32 {
 func (*InputStickerSetAnimatedEmoji) ImplementsInputStickerSet() {}
 
 func (e *InputStickerSetAnimatedEmoji) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -16199,9 +14370,6 @@ func (*InputStickerSetDice) CRC() uint32 {
 func (*InputStickerSetDice) ImplementsInputStickerSet() {}
 
 func (e *InputStickerSetDice) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Emoticon)
@@ -16228,9 +14396,6 @@ func (*PhoneConnectionObj) CRC() uint32 {
 func (*PhoneConnectionObj) ImplementsPhoneConnection() {}
 
 func (e *PhoneConnectionObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -16260,9 +14425,6 @@ func (*PhoneConnectionWebrtc) CRC() uint32 {
 func (*PhoneConnectionWebrtc) ImplementsPhoneConnection() {}
 
 func (e *PhoneConnectionWebrtc) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Turn) {
 		flag |= 1 << 0
@@ -16301,9 +14463,6 @@ func (*DialogPeerObj) CRC() uint32 {
 func (*DialogPeerObj) ImplementsDialogPeer() {}
 
 func (e *DialogPeerObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -16321,9 +14480,6 @@ func (*DialogPeerFolder) CRC() uint32 {
 func (*DialogPeerFolder) ImplementsDialogPeer() {}
 
 func (e *DialogPeerFolder) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.FolderId)
@@ -16344,9 +14500,6 @@ func (*HelpUserInfoEmpty) CRC() uint32 {
 func (*HelpUserInfoEmpty) ImplementsHelpUserInfo() {}
 
 func (e *HelpUserInfoEmpty) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	return buf.Result()
@@ -16366,9 +14519,6 @@ func (*HelpUserInfoObj) CRC() uint32 {
 func (*HelpUserInfoObj) ImplementsHelpUserInfo() {}
 
 func (e *HelpUserInfoObj) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Message)
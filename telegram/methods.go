@@ -3,10 +3,10 @@
 package telegram
 
 import (
+	"context"
 	validator "github.com/go-playground/validator"
 	errors "github.com/pkg/errors"
 	zero "github.com/vikyd/zero"
-	dry "github.com/xelaj/go-dry"
 	serialize "github.com/xelaj/mtproto/serialize"
 	"reflect"
 )
@@ -23,9 +23,6 @@ func (e *AuthSendCodeParams) CRC() uint32 {
 }
 
 func (e *AuthSendCodeParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.PhoneNumber)
@@ -35,15 +32,19 @@ func (e *AuthSendCodeParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AuthSendCode(params *AuthSendCodeParams) (*AuthSentCode, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AuthSendCode(ctx context.Context, params *AuthSendCodeParams) (*AuthSentCode, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AuthSendCodeParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AuthSendCode")
 	}
 
 	resp, ok := data.(*AuthSentCode)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -61,9 +62,6 @@ func (e *AuthSignUpParams) CRC() uint32 {
 }
 
 func (e *AuthSignUpParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.PhoneNumber)
@@ -73,15 +71,19 @@ func (e *AuthSignUpParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AuthSignUp(params *AuthSignUpParams) (AuthAuthorization, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AuthSignUp(ctx context.Context, params *AuthSignUpParams) (AuthAuthorization, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AuthSignUpParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AuthSignUp")
 	}
 
 	resp, ok := data.(AuthAuthorization)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -98,9 +100,6 @@ func (e *AuthSignInParams) CRC() uint32 {
 }
 
 func (e *AuthSignInParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.PhoneNumber)
@@ -109,15 +108,19 @@ func (e *AuthSignInParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AuthSignIn(params *AuthSignInParams) (AuthAuthorization, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AuthSignIn(ctx context.Context, params *AuthSignInParams) (AuthAuthorization, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AuthSignInParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AuthSignIn")
 	}
 
 	resp, ok := data.(AuthAuthorization)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -135,15 +138,15 @@ func (e *AuthLogOutParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AuthLogOut() (*serialize.Bool, error) {
-	data, err := c.MakeRequest(&AuthLogOutParams{})
+func (c *Client) AuthLogOut(ctx context.Context) (*serialize.Bool, error) {
+	data, err := c.MakeRequestContext(ctx, &AuthLogOutParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AuthLogOut")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -161,15 +164,15 @@ func (e *AuthResetAuthorizationsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AuthResetAuthorizations() (*serialize.Bool, error) {
-	data, err := c.MakeRequest(&AuthResetAuthorizationsParams{})
+func (c *Client) AuthResetAuthorizations(ctx context.Context) (*serialize.Bool, error) {
+	data, err := c.MakeRequestContext(ctx, &AuthResetAuthorizationsParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AuthResetAuthorizations")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -184,24 +187,25 @@ func (e *AuthExportAuthorizationParams) CRC() uint32 {
 }
 
 func (e *AuthExportAuthorizationParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.DcId)
 	return buf.Result()
 }
 
-func (c *Client) AuthExportAuthorization(params *AuthExportAuthorizationParams) (*AuthExportedAuthorization, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AuthExportAuthorization(ctx context.Context, params *AuthExportAuthorizationParams) (*AuthExportedAuthorization, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AuthExportAuthorizationParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AuthExportAuthorization")
 	}
 
 	resp, ok := data.(*AuthExportedAuthorization)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -217,9 +221,6 @@ func (e *AuthImportAuthorizationParams) CRC() uint32 {
 }
 
 func (e *AuthImportAuthorizationParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Id)
@@ -227,15 +228,19 @@ func (e *AuthImportAuthorizationParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AuthImportAuthorization(params *AuthImportAuthorizationParams) (AuthAuthorization, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AuthImportAuthorization(ctx context.Context, params *AuthImportAuthorizationParams) (AuthAuthorization, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AuthImportAuthorizationParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AuthImportAuthorization")
 	}
 
 	resp, ok := data.(AuthAuthorization)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -253,9 +258,6 @@ func (e *AuthBindTempAuthKeyParams) CRC() uint32 {
 }
 
 func (e *AuthBindTempAuthKeyParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.PermAuthKeyId)
@@ -265,15 +267,19 @@ func (e *AuthBindTempAuthKeyParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AuthBindTempAuthKey(params *AuthBindTempAuthKeyParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AuthBindTempAuthKey(ctx context.Context, params *AuthBindTempAuthKeyParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AuthBindTempAuthKeyParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AuthBindTempAuthKey")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -291,9 +297,6 @@ func (e *AuthImportBotAuthorizationParams) CRC() uint32 {
 }
 
 func (e *AuthImportBotAuthorizationParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Flags)
@@ -303,15 +306,19 @@ func (e *AuthImportBotAuthorizationParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AuthImportBotAuthorization(params *AuthImportBotAuthorizationParams) (AuthAuthorization, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AuthImportBotAuthorization(ctx context.Context, params *AuthImportBotAuthorizationParams) (AuthAuthorization, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AuthImportBotAuthorizationParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AuthImportBotAuthorization")
 	}
 
 	resp, ok := data.(AuthAuthorization)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -326,24 +333,25 @@ func (e *AuthCheckPasswordParams) CRC() uint32 {
 }
 
 func (e *AuthCheckPasswordParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Password.Encode())
 	return buf.Result()
 }
 
-func (c *Client) AuthCheckPassword(params *AuthCheckPasswordParams) (AuthAuthorization, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AuthCheckPassword(ctx context.Context, params *AuthCheckPasswordParams) (AuthAuthorization, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AuthCheckPasswordParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AuthCheckPassword")
 	}
 
 	resp, ok := data.(AuthAuthorization)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -361,15 +369,15 @@ func (e *AuthRequestPasswordRecoveryParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AuthRequestPasswordRecovery() (*AuthPasswordRecovery, error) {
-	data, err := c.MakeRequest(&AuthRequestPasswordRecoveryParams{})
+func (c *Client) AuthRequestPasswordRecovery(ctx context.Context) (*AuthPasswordRecovery, error) {
+	data, err := c.MakeRequestContext(ctx, &AuthRequestPasswordRecoveryParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AuthRequestPasswordRecovery")
 	}
 
 	resp, ok := data.(*AuthPasswordRecovery)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -384,24 +392,25 @@ func (e *AuthRecoverPasswordParams) CRC() uint32 {
 }
 
 func (e *AuthRecoverPasswordParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Code)
 	return buf.Result()
 }
 
-func (c *Client) AuthRecoverPassword(params *AuthRecoverPasswordParams) (AuthAuthorization, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AuthRecoverPassword(ctx context.Context, params *AuthRecoverPasswordParams) (AuthAuthorization, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AuthRecoverPasswordParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AuthRecoverPassword")
 	}
 
 	resp, ok := data.(AuthAuthorization)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -417,9 +426,6 @@ func (e *AuthResendCodeParams) CRC() uint32 {
 }
 
 func (e *AuthResendCodeParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.PhoneNumber)
@@ -427,15 +433,19 @@ func (e *AuthResendCodeParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AuthResendCode(params *AuthResendCodeParams) (*AuthSentCode, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AuthResendCode(ctx context.Context, params *AuthResendCodeParams) (*AuthSentCode, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AuthResendCodeParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AuthResendCode")
 	}
 
 	resp, ok := data.(*AuthSentCode)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -451,9 +461,6 @@ func (e *AuthCancelCodeParams) CRC() uint32 {
 }
 
 func (e *AuthCancelCodeParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.PhoneNumber)
@@ -461,15 +468,19 @@ func (e *AuthCancelCodeParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AuthCancelCode(params *AuthCancelCodeParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AuthCancelCode(ctx context.Context, params *AuthCancelCodeParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AuthCancelCodeParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AuthCancelCode")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -484,24 +495,25 @@ func (e *AuthDropTempAuthKeysParams) CRC() uint32 {
 }
 
 func (e *AuthDropTempAuthKeysParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.ExceptAuthKeys)
 	return buf.Result()
 }
 
-func (c *Client) AuthDropTempAuthKeys(params *AuthDropTempAuthKeysParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AuthDropTempAuthKeys(ctx context.Context, params *AuthDropTempAuthKeysParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AuthDropTempAuthKeysParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AuthDropTempAuthKeys")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -518,9 +530,6 @@ func (e *AuthExportLoginTokenParams) CRC() uint32 {
 }
 
 func (e *AuthExportLoginTokenParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ApiId)
@@ -529,15 +538,19 @@ func (e *AuthExportLoginTokenParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AuthExportLoginToken(params *AuthExportLoginTokenParams) (AuthLoginToken, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AuthExportLoginToken(ctx context.Context, params *AuthExportLoginTokenParams) (AuthLoginToken, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AuthExportLoginTokenParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AuthExportLoginToken")
 	}
 
 	resp, ok := data.(AuthLoginToken)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -552,24 +565,25 @@ func (e *AuthImportLoginTokenParams) CRC() uint32 {
 }
 
 func (e *AuthImportLoginTokenParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutMessage(e.Token)
 	return buf.Result()
 }
 
-func (c *Client) AuthImportLoginToken(params *AuthImportLoginTokenParams) (AuthLoginToken, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AuthImportLoginToken(ctx context.Context, params *AuthImportLoginTokenParams) (AuthLoginToken, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AuthImportLoginTokenParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AuthImportLoginToken")
 	}
 
 	resp, ok := data.(AuthLoginToken)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -584,24 +598,25 @@ func (e *AuthAcceptLoginTokenParams) CRC() uint32 {
 }
 
 func (e *AuthAcceptLoginTokenParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutMessage(e.Token)
 	return buf.Result()
 }
 
-func (c *Client) AuthAcceptLoginToken(params *AuthAcceptLoginTokenParams) (*Authorization, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AuthAcceptLoginToken(ctx context.Context, params *AuthAcceptLoginTokenParams) (*Authorization, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AuthAcceptLoginTokenParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AuthAcceptLoginToken")
 	}
 
 	resp, ok := data.(*Authorization)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -622,9 +637,6 @@ func (e *AccountRegisterDeviceParams) CRC() uint32 {
 }
 
 func (e *AccountRegisterDeviceParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.NoMuted) {
 		flag |= 1 << 0
@@ -640,15 +652,19 @@ func (e *AccountRegisterDeviceParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountRegisterDevice(params *AccountRegisterDeviceParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountRegisterDevice(ctx context.Context, params *AccountRegisterDeviceParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountRegisterDeviceParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountRegisterDevice")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -665,9 +681,6 @@ func (e *AccountUnregisterDeviceParams) CRC() uint32 {
 }
 
 func (e *AccountUnregisterDeviceParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.TokenType)
@@ -676,15 +689,19 @@ func (e *AccountUnregisterDeviceParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountUnregisterDevice(params *AccountUnregisterDeviceParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountUnregisterDevice(ctx context.Context, params *AccountUnregisterDeviceParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountUnregisterDeviceParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountUnregisterDevice")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -700,9 +717,6 @@ func (e *AccountUpdateNotifySettingsParams) CRC() uint32 {
 }
 
 func (e *AccountUpdateNotifySettingsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -710,15 +724,19 @@ func (e *AccountUpdateNotifySettingsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountUpdateNotifySettings(params *AccountUpdateNotifySettingsParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountUpdateNotifySettings(ctx context.Context, params *AccountUpdateNotifySettingsParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountUpdateNotifySettingsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountUpdateNotifySettings")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -733,24 +751,25 @@ func (e *AccountGetNotifySettingsParams) CRC() uint32 {
 }
 
 func (e *AccountGetNotifySettingsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
 	return buf.Result()
 }
 
-func (c *Client) AccountGetNotifySettings(params *AccountGetNotifySettingsParams) (*PeerNotifySettings, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountGetNotifySettings(ctx context.Context, params *AccountGetNotifySettingsParams) (*PeerNotifySettings, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountGetNotifySettingsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountGetNotifySettings")
 	}
 
 	resp, ok := data.(*PeerNotifySettings)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -768,15 +787,15 @@ func (e *AccountResetNotifySettingsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountResetNotifySettings() (*serialize.Bool, error) {
-	data, err := c.MakeRequest(&AccountResetNotifySettingsParams{})
+func (c *Client) AccountResetNotifySettings(ctx context.Context) (*serialize.Bool, error) {
+	data, err := c.MakeRequestContext(ctx, &AccountResetNotifySettingsParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountResetNotifySettings")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -794,9 +813,6 @@ func (e *AccountUpdateProfileParams) CRC() uint32 {
 }
 
 func (e *AccountUpdateProfileParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.FirstName) {
 		flag |= 1 << 0
@@ -822,15 +838,19 @@ func (e *AccountUpdateProfileParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountUpdateProfile(params *AccountUpdateProfileParams) (User, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountUpdateProfile(ctx context.Context, params *AccountUpdateProfileParams) (User, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountUpdateProfileParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountUpdateProfile")
 	}
 
 	resp, ok := data.(User)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -845,24 +865,25 @@ func (e *AccountUpdateStatusParams) CRC() uint32 {
 }
 
 func (e *AccountUpdateStatusParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutBool(e.Offline)
 	return buf.Result()
 }
 
-func (c *Client) AccountUpdateStatus(params *AccountUpdateStatusParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountUpdateStatus(ctx context.Context, params *AccountUpdateStatusParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountUpdateStatusParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountUpdateStatus")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -877,24 +898,25 @@ func (e *AccountGetWallPapersParams) CRC() uint32 {
 }
 
 func (e *AccountGetWallPapersParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Hash)
 	return buf.Result()
 }
 
-func (c *Client) AccountGetWallPapers(params *AccountGetWallPapersParams) (AccountWallPapers, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountGetWallPapers(ctx context.Context, params *AccountGetWallPapersParams) (AccountWallPapers, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountGetWallPapersParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountGetWallPapers")
 	}
 
 	resp, ok := data.(AccountWallPapers)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -910,9 +932,6 @@ func (e *AccountReportPeerParams) CRC() uint32 {
 }
 
 func (e *AccountReportPeerParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -920,15 +939,19 @@ func (e *AccountReportPeerParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountReportPeer(params *AccountReportPeerParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountReportPeer(ctx context.Context, params *AccountReportPeerParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountReportPeerParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountReportPeer")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -943,24 +966,25 @@ func (e *AccountCheckUsernameParams) CRC() uint32 {
 }
 
 func (e *AccountCheckUsernameParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Username)
 	return buf.Result()
 }
 
-func (c *Client) AccountCheckUsername(params *AccountCheckUsernameParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountCheckUsername(ctx context.Context, params *AccountCheckUsernameParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountCheckUsernameParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountCheckUsername")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -975,24 +999,25 @@ func (e *AccountUpdateUsernameParams) CRC() uint32 {
 }
 
 func (e *AccountUpdateUsernameParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Username)
 	return buf.Result()
 }
 
-func (c *Client) AccountUpdateUsername(params *AccountUpdateUsernameParams) (User, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountUpdateUsername(ctx context.Context, params *AccountUpdateUsernameParams) (User, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountUpdateUsernameParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountUpdateUsername")
 	}
 
 	resp, ok := data.(User)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1007,24 +1032,25 @@ func (e *AccountGetPrivacyParams) CRC() uint32 {
 }
 
 func (e *AccountGetPrivacyParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Key.Encode())
 	return buf.Result()
 }
 
-func (c *Client) AccountGetPrivacy(params *AccountGetPrivacyParams) (*AccountPrivacyRules, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountGetPrivacy(ctx context.Context, params *AccountGetPrivacyParams) (*AccountPrivacyRules, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountGetPrivacyParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountGetPrivacy")
 	}
 
 	resp, ok := data.(*AccountPrivacyRules)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1040,9 +1066,6 @@ func (e *AccountSetPrivacyParams) CRC() uint32 {
 }
 
 func (e *AccountSetPrivacyParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Key.Encode())
@@ -1050,15 +1073,19 @@ func (e *AccountSetPrivacyParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountSetPrivacy(params *AccountSetPrivacyParams) (*AccountPrivacyRules, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountSetPrivacy(ctx context.Context, params *AccountSetPrivacyParams) (*AccountPrivacyRules, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountSetPrivacyParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountSetPrivacy")
 	}
 
 	resp, ok := data.(*AccountPrivacyRules)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1073,24 +1100,25 @@ func (e *AccountDeleteAccountParams) CRC() uint32 {
 }
 
 func (e *AccountDeleteAccountParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Reason)
 	return buf.Result()
 }
 
-func (c *Client) AccountDeleteAccount(params *AccountDeleteAccountParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountDeleteAccount(ctx context.Context, params *AccountDeleteAccountParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountDeleteAccountParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountDeleteAccount")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1108,15 +1136,15 @@ func (e *AccountGetAccountTTLParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountGetAccountTTL() (*AccountDaysTTL, error) {
-	data, err := c.MakeRequest(&AccountGetAccountTTLParams{})
+func (c *Client) AccountGetAccountTTL(ctx context.Context) (*AccountDaysTTL, error) {
+	data, err := c.MakeRequestContext(ctx, &AccountGetAccountTTLParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountGetAccountTTL")
 	}
 
 	resp, ok := data.(*AccountDaysTTL)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1131,24 +1159,25 @@ func (e *AccountSetAccountTTLParams) CRC() uint32 {
 }
 
 func (e *AccountSetAccountTTLParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Ttl.Encode())
 	return buf.Result()
 }
 
-func (c *Client) AccountSetAccountTTL(params *AccountSetAccountTTLParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountSetAccountTTL(ctx context.Context, params *AccountSetAccountTTLParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountSetAccountTTLParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountSetAccountTTL")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1164,9 +1193,6 @@ func (e *AccountSendChangePhoneCodeParams) CRC() uint32 {
 }
 
 func (e *AccountSendChangePhoneCodeParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.PhoneNumber)
@@ -1174,15 +1200,19 @@ func (e *AccountSendChangePhoneCodeParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountSendChangePhoneCode(params *AccountSendChangePhoneCodeParams) (*AuthSentCode, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountSendChangePhoneCode(ctx context.Context, params *AccountSendChangePhoneCodeParams) (*AuthSentCode, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountSendChangePhoneCodeParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountSendChangePhoneCode")
 	}
 
 	resp, ok := data.(*AuthSentCode)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1199,9 +1229,6 @@ func (e *AccountChangePhoneParams) CRC() uint32 {
 }
 
 func (e *AccountChangePhoneParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.PhoneNumber)
@@ -1210,15 +1237,19 @@ func (e *AccountChangePhoneParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountChangePhone(params *AccountChangePhoneParams) (User, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountChangePhone(ctx context.Context, params *AccountChangePhoneParams) (User, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountChangePhoneParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountChangePhone")
 	}
 
 	resp, ok := data.(User)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1233,24 +1264,25 @@ func (e *AccountUpdateDeviceLockedParams) CRC() uint32 {
 }
 
 func (e *AccountUpdateDeviceLockedParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Period)
 	return buf.Result()
 }
 
-func (c *Client) AccountUpdateDeviceLocked(params *AccountUpdateDeviceLockedParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountUpdateDeviceLocked(ctx context.Context, params *AccountUpdateDeviceLockedParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountUpdateDeviceLockedParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountUpdateDeviceLocked")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1268,15 +1300,15 @@ func (e *AccountGetAuthorizationsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountGetAuthorizations() (*AccountAuthorizations, error) {
-	data, err := c.MakeRequest(&AccountGetAuthorizationsParams{})
+func (c *Client) AccountGetAuthorizations(ctx context.Context) (*AccountAuthorizations, error) {
+	data, err := c.MakeRequestContext(ctx, &AccountGetAuthorizationsParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountGetAuthorizations")
 	}
 
 	resp, ok := data.(*AccountAuthorizations)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1291,24 +1323,25 @@ func (e *AccountResetAuthorizationParams) CRC() uint32 {
 }
 
 func (e *AccountResetAuthorizationParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Hash)
 	return buf.Result()
 }
 
-func (c *Client) AccountResetAuthorization(params *AccountResetAuthorizationParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountResetAuthorization(ctx context.Context, params *AccountResetAuthorizationParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountResetAuthorizationParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountResetAuthorization")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1326,15 +1359,15 @@ func (e *AccountGetPasswordParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountGetPassword() (*AccountPassword, error) {
-	data, err := c.MakeRequest(&AccountGetPasswordParams{})
+func (c *Client) AccountGetPassword(ctx context.Context) (*AccountPassword, error) {
+	data, err := c.MakeRequestContext(ctx, &AccountGetPasswordParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountGetPassword")
 	}
 
 	resp, ok := data.(*AccountPassword)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1349,24 +1382,25 @@ func (e *AccountGetPasswordSettingsParams) CRC() uint32 {
 }
 
 func (e *AccountGetPasswordSettingsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Password.Encode())
 	return buf.Result()
 }
 
-func (c *Client) AccountGetPasswordSettings(params *AccountGetPasswordSettingsParams) (*AccountPasswordSettings, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountGetPasswordSettings(ctx context.Context, params *AccountGetPasswordSettingsParams) (*AccountPasswordSettings, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountGetPasswordSettingsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountGetPasswordSettings")
 	}
 
 	resp, ok := data.(*AccountPasswordSettings)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1382,9 +1416,6 @@ func (e *AccountUpdatePasswordSettingsParams) CRC() uint32 {
 }
 
 func (e *AccountUpdatePasswordSettingsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Password.Encode())
@@ -1392,15 +1423,19 @@ func (e *AccountUpdatePasswordSettingsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountUpdatePasswordSettings(params *AccountUpdatePasswordSettingsParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountUpdatePasswordSettings(ctx context.Context, params *AccountUpdatePasswordSettingsParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountUpdatePasswordSettingsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountUpdatePasswordSettings")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1416,9 +1451,6 @@ func (e *AccountSendConfirmPhoneCodeParams) CRC() uint32 {
 }
 
 func (e *AccountSendConfirmPhoneCodeParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Hash)
@@ -1426,15 +1458,19 @@ func (e *AccountSendConfirmPhoneCodeParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountSendConfirmPhoneCode(params *AccountSendConfirmPhoneCodeParams) (*AuthSentCode, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountSendConfirmPhoneCode(ctx context.Context, params *AccountSendConfirmPhoneCodeParams) (*AuthSentCode, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountSendConfirmPhoneCodeParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountSendConfirmPhoneCode")
 	}
 
 	resp, ok := data.(*AuthSentCode)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1450,9 +1486,6 @@ func (e *AccountConfirmPhoneParams) CRC() uint32 {
 }
 
 func (e *AccountConfirmPhoneParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.PhoneCodeHash)
@@ -1460,15 +1493,19 @@ func (e *AccountConfirmPhoneParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountConfirmPhone(params *AccountConfirmPhoneParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountConfirmPhone(ctx context.Context, params *AccountConfirmPhoneParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountConfirmPhoneParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountConfirmPhone")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1484,9 +1521,6 @@ func (e *AccountGetTmpPasswordParams) CRC() uint32 {
 }
 
 func (e *AccountGetTmpPasswordParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Password.Encode())
@@ -1494,15 +1528,19 @@ func (e *AccountGetTmpPasswordParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountGetTmpPassword(params *AccountGetTmpPasswordParams) (*AccountTmpPassword, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountGetTmpPassword(ctx context.Context, params *AccountGetTmpPasswordParams) (*AccountTmpPassword, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountGetTmpPasswordParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountGetTmpPassword")
 	}
 
 	resp, ok := data.(*AccountTmpPassword)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1520,15 +1558,15 @@ func (e *AccountGetWebAuthorizationsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountGetWebAuthorizations() (*AccountWebAuthorizations, error) {
-	data, err := c.MakeRequest(&AccountGetWebAuthorizationsParams{})
+func (c *Client) AccountGetWebAuthorizations(ctx context.Context) (*AccountWebAuthorizations, error) {
+	data, err := c.MakeRequestContext(ctx, &AccountGetWebAuthorizationsParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountGetWebAuthorizations")
 	}
 
 	resp, ok := data.(*AccountWebAuthorizations)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1543,24 +1581,25 @@ func (e *AccountResetWebAuthorizationParams) CRC() uint32 {
 }
 
 func (e *AccountResetWebAuthorizationParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Hash)
 	return buf.Result()
 }
 
-func (c *Client) AccountResetWebAuthorization(params *AccountResetWebAuthorizationParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountResetWebAuthorization(ctx context.Context, params *AccountResetWebAuthorizationParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountResetWebAuthorizationParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountResetWebAuthorization")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1578,15 +1617,15 @@ func (e *AccountResetWebAuthorizationsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountResetWebAuthorizations() (*serialize.Bool, error) {
-	data, err := c.MakeRequest(&AccountResetWebAuthorizationsParams{})
+func (c *Client) AccountResetWebAuthorizations(ctx context.Context) (*serialize.Bool, error) {
+	data, err := c.MakeRequestContext(ctx, &AccountResetWebAuthorizationsParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountResetWebAuthorizations")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1604,15 +1643,15 @@ func (e *AccountGetAllSecureValuesParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountGetAllSecureValues() (*SecureValue, error) {
-	data, err := c.MakeRequest(&AccountGetAllSecureValuesParams{})
+func (c *Client) AccountGetAllSecureValues(ctx context.Context) (*SecureValue, error) {
+	data, err := c.MakeRequestContext(ctx, &AccountGetAllSecureValuesParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountGetAllSecureValues")
 	}
 
 	resp, ok := data.(*SecureValue)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1627,24 +1666,25 @@ func (e *AccountGetSecureValueParams) CRC() uint32 {
 }
 
 func (e *AccountGetSecureValueParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Types)
 	return buf.Result()
 }
 
-func (c *Client) AccountGetSecureValue(params *AccountGetSecureValueParams) (*SecureValue, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountGetSecureValue(ctx context.Context, params *AccountGetSecureValueParams) (*SecureValue, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountGetSecureValueParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountGetSecureValue")
 	}
 
 	resp, ok := data.(*SecureValue)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1660,9 +1700,6 @@ func (e *AccountSaveSecureValueParams) CRC() uint32 {
 }
 
 func (e *AccountSaveSecureValueParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Value.Encode())
@@ -1670,15 +1707,19 @@ func (e *AccountSaveSecureValueParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountSaveSecureValue(params *AccountSaveSecureValueParams) (*SecureValue, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountSaveSecureValue(ctx context.Context, params *AccountSaveSecureValueParams) (*SecureValue, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountSaveSecureValueParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountSaveSecureValue")
 	}
 
 	resp, ok := data.(*SecureValue)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1693,24 +1734,25 @@ func (e *AccountDeleteSecureValueParams) CRC() uint32 {
 }
 
 func (e *AccountDeleteSecureValueParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Types)
 	return buf.Result()
 }
 
-func (c *Client) AccountDeleteSecureValue(params *AccountDeleteSecureValueParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountDeleteSecureValue(ctx context.Context, params *AccountDeleteSecureValueParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountDeleteSecureValueParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountDeleteSecureValue")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1727,9 +1769,6 @@ func (e *AccountGetAuthorizationFormParams) CRC() uint32 {
 }
 
 func (e *AccountGetAuthorizationFormParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.BotId)
@@ -1738,15 +1777,19 @@ func (e *AccountGetAuthorizationFormParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountGetAuthorizationForm(params *AccountGetAuthorizationFormParams) (*AccountAuthorizationForm, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountGetAuthorizationForm(ctx context.Context, params *AccountGetAuthorizationFormParams) (*AccountAuthorizationForm, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountGetAuthorizationFormParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountGetAuthorizationForm")
 	}
 
 	resp, ok := data.(*AccountAuthorizationForm)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1765,9 +1808,6 @@ func (e *AccountAcceptAuthorizationParams) CRC() uint32 {
 }
 
 func (e *AccountAcceptAuthorizationParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.BotId)
@@ -1778,15 +1818,19 @@ func (e *AccountAcceptAuthorizationParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountAcceptAuthorization(params *AccountAcceptAuthorizationParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountAcceptAuthorization(ctx context.Context, params *AccountAcceptAuthorizationParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountAcceptAuthorizationParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountAcceptAuthorization")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1802,9 +1846,6 @@ func (e *AccountSendVerifyPhoneCodeParams) CRC() uint32 {
 }
 
 func (e *AccountSendVerifyPhoneCodeParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.PhoneNumber)
@@ -1812,15 +1853,19 @@ func (e *AccountSendVerifyPhoneCodeParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountSendVerifyPhoneCode(params *AccountSendVerifyPhoneCodeParams) (*AuthSentCode, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountSendVerifyPhoneCode(ctx context.Context, params *AccountSendVerifyPhoneCodeParams) (*AuthSentCode, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountSendVerifyPhoneCodeParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountSendVerifyPhoneCode")
 	}
 
 	resp, ok := data.(*AuthSentCode)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1837,9 +1882,6 @@ func (e *AccountVerifyPhoneParams) CRC() uint32 {
 }
 
 func (e *AccountVerifyPhoneParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.PhoneNumber)
@@ -1848,15 +1890,19 @@ func (e *AccountVerifyPhoneParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountVerifyPhone(params *AccountVerifyPhoneParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountVerifyPhone(ctx context.Context, params *AccountVerifyPhoneParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountVerifyPhoneParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountVerifyPhone")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1871,24 +1917,25 @@ func (e *AccountSendVerifyEmailCodeParams) CRC() uint32 {
 }
 
 func (e *AccountSendVerifyEmailCodeParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Email)
 	return buf.Result()
 }
 
-func (c *Client) AccountSendVerifyEmailCode(params *AccountSendVerifyEmailCodeParams) (*AccountSentEmailCode, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountSendVerifyEmailCode(ctx context.Context, params *AccountSendVerifyEmailCodeParams) (*AccountSentEmailCode, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountSendVerifyEmailCodeParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountSendVerifyEmailCode")
 	}
 
 	resp, ok := data.(*AccountSentEmailCode)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1904,9 +1951,6 @@ func (e *AccountVerifyEmailParams) CRC() uint32 {
 }
 
 func (e *AccountVerifyEmailParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Email)
@@ -1914,15 +1958,19 @@ func (e *AccountVerifyEmailParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountVerifyEmail(params *AccountVerifyEmailParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountVerifyEmail(ctx context.Context, params *AccountVerifyEmailParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountVerifyEmailParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountVerifyEmail")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1944,9 +1992,6 @@ func (e *AccountInitTakeoutSessionParams) CRC() uint32 {
 }
 
 func (e *AccountInitTakeoutSessionParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Contacts) {
 		flag |= 1 << 0
@@ -1975,15 +2020,19 @@ func (e *AccountInitTakeoutSessionParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountInitTakeoutSession(params *AccountInitTakeoutSessionParams) (*AccountTakeout, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountInitTakeoutSession(ctx context.Context, params *AccountInitTakeoutSessionParams) (*AccountTakeout, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountInitTakeoutSessionParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountInitTakeoutSession")
 	}
 
 	resp, ok := data.(*AccountTakeout)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -1999,9 +2048,6 @@ func (e *AccountFinishTakeoutSessionParams) CRC() uint32 {
 }
 
 func (e *AccountFinishTakeoutSessionParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Success) {
 		flag |= 1 << 0
@@ -2012,15 +2058,19 @@ func (e *AccountFinishTakeoutSessionParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountFinishTakeoutSession(params *AccountFinishTakeoutSessionParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountFinishTakeoutSession(ctx context.Context, params *AccountFinishTakeoutSessionParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountFinishTakeoutSessionParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountFinishTakeoutSession")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2035,24 +2085,25 @@ func (e *AccountConfirmPasswordEmailParams) CRC() uint32 {
 }
 
 func (e *AccountConfirmPasswordEmailParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Code)
 	return buf.Result()
 }
 
-func (c *Client) AccountConfirmPasswordEmail(params *AccountConfirmPasswordEmailParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountConfirmPasswordEmail(ctx context.Context, params *AccountConfirmPasswordEmailParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountConfirmPasswordEmailParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountConfirmPasswordEmail")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2070,15 +2121,15 @@ func (e *AccountResendPasswordEmailParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountResendPasswordEmail() (*serialize.Bool, error) {
-	data, err := c.MakeRequest(&AccountResendPasswordEmailParams{})
+func (c *Client) AccountResendPasswordEmail(ctx context.Context) (*serialize.Bool, error) {
+	data, err := c.MakeRequestContext(ctx, &AccountResendPasswordEmailParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountResendPasswordEmail")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2096,15 +2147,15 @@ func (e *AccountCancelPasswordEmailParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountCancelPasswordEmail() (*serialize.Bool, error) {
-	data, err := c.MakeRequest(&AccountCancelPasswordEmailParams{})
+func (c *Client) AccountCancelPasswordEmail(ctx context.Context) (*serialize.Bool, error) {
+	data, err := c.MakeRequestContext(ctx, &AccountCancelPasswordEmailParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountCancelPasswordEmail")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2122,15 +2173,15 @@ func (e *AccountGetContactSignUpNotificationParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountGetContactSignUpNotification() (*serialize.Bool, error) {
-	data, err := c.MakeRequest(&AccountGetContactSignUpNotificationParams{})
+func (c *Client) AccountGetContactSignUpNotification(ctx context.Context) (*serialize.Bool, error) {
+	data, err := c.MakeRequestContext(ctx, &AccountGetContactSignUpNotificationParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountGetContactSignUpNotification")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2145,24 +2196,25 @@ func (e *AccountSetContactSignUpNotificationParams) CRC() uint32 {
 }
 
 func (e *AccountSetContactSignUpNotificationParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutBool(e.Silent)
 	return buf.Result()
 }
 
-func (c *Client) AccountSetContactSignUpNotification(params *AccountSetContactSignUpNotificationParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountSetContactSignUpNotification(ctx context.Context, params *AccountSetContactSignUpNotificationParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountSetContactSignUpNotificationParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountSetContactSignUpNotification")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2179,9 +2231,6 @@ func (e *AccountGetNotifyExceptionsParams) CRC() uint32 {
 }
 
 func (e *AccountGetNotifyExceptionsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Peer) {
 		flag |= 1 << 0
@@ -2198,15 +2247,19 @@ func (e *AccountGetNotifyExceptionsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountGetNotifyExceptions(params *AccountGetNotifyExceptionsParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountGetNotifyExceptions(ctx context.Context, params *AccountGetNotifyExceptionsParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountGetNotifyExceptionsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountGetNotifyExceptions")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2221,24 +2274,25 @@ func (e *AccountGetWallPaperParams) CRC() uint32 {
 }
 
 func (e *AccountGetWallPaperParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Wallpaper.Encode())
 	return buf.Result()
 }
 
-func (c *Client) AccountGetWallPaper(params *AccountGetWallPaperParams) (WallPaper, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountGetWallPaper(ctx context.Context, params *AccountGetWallPaperParams) (WallPaper, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountGetWallPaperParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountGetWallPaper")
 	}
 
 	resp, ok := data.(WallPaper)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2255,9 +2309,6 @@ func (e *AccountUploadWallPaperParams) CRC() uint32 {
 }
 
 func (e *AccountUploadWallPaperParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.File.Encode())
@@ -2266,15 +2317,19 @@ func (e *AccountUploadWallPaperParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountUploadWallPaper(params *AccountUploadWallPaperParams) (WallPaper, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountUploadWallPaper(ctx context.Context, params *AccountUploadWallPaperParams) (WallPaper, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountUploadWallPaperParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountUploadWallPaper")
 	}
 
 	resp, ok := data.(WallPaper)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2291,9 +2346,6 @@ func (e *AccountSaveWallPaperParams) CRC() uint32 {
 }
 
 func (e *AccountSaveWallPaperParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Wallpaper.Encode())
@@ -2302,15 +2354,19 @@ func (e *AccountSaveWallPaperParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountSaveWallPaper(params *AccountSaveWallPaperParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountSaveWallPaper(ctx context.Context, params *AccountSaveWallPaperParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountSaveWallPaperParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountSaveWallPaper")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2326,9 +2382,6 @@ func (e *AccountInstallWallPaperParams) CRC() uint32 {
 }
 
 func (e *AccountInstallWallPaperParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Wallpaper.Encode())
@@ -2336,15 +2389,19 @@ func (e *AccountInstallWallPaperParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountInstallWallPaper(params *AccountInstallWallPaperParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountInstallWallPaper(ctx context.Context, params *AccountInstallWallPaperParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountInstallWallPaperParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountInstallWallPaper")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2362,15 +2419,15 @@ func (e *AccountResetWallPapersParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountResetWallPapers() (*serialize.Bool, error) {
-	data, err := c.MakeRequest(&AccountResetWallPapersParams{})
+func (c *Client) AccountResetWallPapers(ctx context.Context) (*serialize.Bool, error) {
+	data, err := c.MakeRequestContext(ctx, &AccountResetWallPapersParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountResetWallPapers")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2388,15 +2445,15 @@ func (e *AccountGetAutoDownloadSettingsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountGetAutoDownloadSettings() (*AccountAutoDownloadSettings, error) {
-	data, err := c.MakeRequest(&AccountGetAutoDownloadSettingsParams{})
+func (c *Client) AccountGetAutoDownloadSettings(ctx context.Context) (*AccountAutoDownloadSettings, error) {
+	data, err := c.MakeRequestContext(ctx, &AccountGetAutoDownloadSettingsParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountGetAutoDownloadSettings")
 	}
 
 	resp, ok := data.(*AccountAutoDownloadSettings)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2414,9 +2471,6 @@ func (e *AccountSaveAutoDownloadSettingsParams) CRC() uint32 {
 }
 
 func (e *AccountSaveAutoDownloadSettingsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Low) {
 		flag |= 1 << 0
@@ -2431,15 +2485,19 @@ func (e *AccountSaveAutoDownloadSettingsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountSaveAutoDownloadSettings(params *AccountSaveAutoDownloadSettingsParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountSaveAutoDownloadSettings(ctx context.Context, params *AccountSaveAutoDownloadSettingsParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountSaveAutoDownloadSettingsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountSaveAutoDownloadSettings")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2458,9 +2516,6 @@ func (e *AccountUploadThemeParams) CRC() uint32 {
 }
 
 func (e *AccountUploadThemeParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Thumb) {
 		flag |= 1 << 0
@@ -2477,15 +2532,19 @@ func (e *AccountUploadThemeParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountUploadTheme(params *AccountUploadThemeParams) (Document, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountUploadTheme(ctx context.Context, params *AccountUploadThemeParams) (Document, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountUploadThemeParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountUploadTheme")
 	}
 
 	resp, ok := data.(Document)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2504,9 +2563,6 @@ func (e *AccountCreateThemeParams) CRC() uint32 {
 }
 
 func (e *AccountCreateThemeParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Document) {
 		flag |= 1 << 2
@@ -2528,15 +2584,19 @@ func (e *AccountCreateThemeParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountCreateTheme(params *AccountCreateThemeParams) (*Theme, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountCreateTheme(ctx context.Context, params *AccountCreateThemeParams) (*Theme, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountCreateThemeParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountCreateTheme")
 	}
 
 	resp, ok := data.(*Theme)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2557,9 +2617,6 @@ func (e *AccountUpdateThemeParams) CRC() uint32 {
 }
 
 func (e *AccountUpdateThemeParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Slug) {
 		flag |= 1 << 0
@@ -2593,15 +2650,19 @@ func (e *AccountUpdateThemeParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountUpdateTheme(params *AccountUpdateThemeParams) (*Theme, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountUpdateTheme(ctx context.Context, params *AccountUpdateThemeParams) (*Theme, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountUpdateThemeParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountUpdateTheme")
 	}
 
 	resp, ok := data.(*Theme)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2617,9 +2678,6 @@ func (e *AccountSaveThemeParams) CRC() uint32 {
 }
 
 func (e *AccountSaveThemeParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Theme.Encode())
@@ -2627,15 +2685,19 @@ func (e *AccountSaveThemeParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountSaveTheme(params *AccountSaveThemeParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountSaveTheme(ctx context.Context, params *AccountSaveThemeParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountSaveThemeParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountSaveTheme")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2653,9 +2715,6 @@ func (e *AccountInstallThemeParams) CRC() uint32 {
 }
 
 func (e *AccountInstallThemeParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Dark) {
 		flag |= 1 << 0
@@ -2675,15 +2734,19 @@ func (e *AccountInstallThemeParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountInstallTheme(params *AccountInstallThemeParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountInstallTheme(ctx context.Context, params *AccountInstallThemeParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountInstallThemeParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountInstallTheme")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2700,9 +2763,6 @@ func (e *AccountGetThemeParams) CRC() uint32 {
 }
 
 func (e *AccountGetThemeParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Format)
@@ -2711,15 +2771,19 @@ func (e *AccountGetThemeParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountGetTheme(params *AccountGetThemeParams) (*Theme, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountGetTheme(ctx context.Context, params *AccountGetThemeParams) (*Theme, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountGetThemeParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountGetTheme")
 	}
 
 	resp, ok := data.(*Theme)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2735,9 +2799,6 @@ func (e *AccountGetThemesParams) CRC() uint32 {
 }
 
 func (e *AccountGetThemesParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Format)
@@ -2745,15 +2806,19 @@ func (e *AccountGetThemesParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountGetThemes(params *AccountGetThemesParams) (AccountThemes, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountGetThemes(ctx context.Context, params *AccountGetThemesParams) (AccountThemes, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountGetThemesParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountGetThemes")
 	}
 
 	resp, ok := data.(AccountThemes)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2769,9 +2834,6 @@ func (e *AccountSetContentSettingsParams) CRC() uint32 {
 }
 
 func (e *AccountSetContentSettingsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.SensitiveEnabled) {
 		flag |= 1 << 0
@@ -2782,15 +2844,19 @@ func (e *AccountSetContentSettingsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountSetContentSettings(params *AccountSetContentSettingsParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountSetContentSettings(ctx context.Context, params *AccountSetContentSettingsParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountSetContentSettingsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountSetContentSettings")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2808,15 +2874,15 @@ func (e *AccountGetContentSettingsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountGetContentSettings() (*AccountContentSettings, error) {
-	data, err := c.MakeRequest(&AccountGetContentSettingsParams{})
+func (c *Client) AccountGetContentSettings(ctx context.Context) (*AccountContentSettings, error) {
+	data, err := c.MakeRequestContext(ctx, &AccountGetContentSettingsParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountGetContentSettings")
 	}
 
 	resp, ok := data.(*AccountContentSettings)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2831,24 +2897,25 @@ func (e *AccountGetMultiWallPapersParams) CRC() uint32 {
 }
 
 func (e *AccountGetMultiWallPapersParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Wallpapers)
 	return buf.Result()
 }
 
-func (c *Client) AccountGetMultiWallPapers(params *AccountGetMultiWallPapersParams) (WallPaper, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountGetMultiWallPapers(ctx context.Context, params *AccountGetMultiWallPapersParams) (WallPaper, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountGetMultiWallPapersParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountGetMultiWallPapers")
 	}
 
 	resp, ok := data.(WallPaper)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2866,15 +2933,15 @@ func (e *AccountGetGlobalPrivacySettingsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) AccountGetGlobalPrivacySettings() (*GlobalPrivacySettings, error) {
-	data, err := c.MakeRequest(&AccountGetGlobalPrivacySettingsParams{})
+func (c *Client) AccountGetGlobalPrivacySettings(ctx context.Context) (*GlobalPrivacySettings, error) {
+	data, err := c.MakeRequestContext(ctx, &AccountGetGlobalPrivacySettingsParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountGetGlobalPrivacySettings")
 	}
 
 	resp, ok := data.(*GlobalPrivacySettings)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2889,24 +2956,25 @@ func (e *AccountSetGlobalPrivacySettingsParams) CRC() uint32 {
 }
 
 func (e *AccountSetGlobalPrivacySettingsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Settings.Encode())
 	return buf.Result()
 }
 
-func (c *Client) AccountSetGlobalPrivacySettings(params *AccountSetGlobalPrivacySettingsParams) (*GlobalPrivacySettings, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) AccountSetGlobalPrivacySettings(ctx context.Context, params *AccountSetGlobalPrivacySettingsParams) (*GlobalPrivacySettings, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating AccountSetGlobalPrivacySettingsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning AccountSetGlobalPrivacySettings")
 	}
 
 	resp, ok := data.(*GlobalPrivacySettings)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2921,24 +2989,25 @@ func (e *UsersGetUsersParams) CRC() uint32 {
 }
 
 func (e *UsersGetUsersParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Id)
 	return buf.Result()
 }
 
-func (c *Client) UsersGetUsers(params *UsersGetUsersParams) (User, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) UsersGetUsers(ctx context.Context, params *UsersGetUsersParams) (User, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating UsersGetUsersParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning UsersGetUsers")
 	}
 
 	resp, ok := data.(User)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2953,24 +3022,25 @@ func (e *UsersGetFullUserParams) CRC() uint32 {
 }
 
 func (e *UsersGetFullUserParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Id.Encode())
 	return buf.Result()
 }
 
-func (c *Client) UsersGetFullUser(params *UsersGetFullUserParams) (*UserFull, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) UsersGetFullUser(ctx context.Context, params *UsersGetFullUserParams) (*UserFull, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating UsersGetFullUserParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning UsersGetFullUser")
 	}
 
 	resp, ok := data.(*UserFull)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -2986,9 +3056,6 @@ func (e *UsersSetSecureValueErrorsParams) CRC() uint32 {
 }
 
 func (e *UsersSetSecureValueErrorsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Id.Encode())
@@ -2996,15 +3063,19 @@ func (e *UsersSetSecureValueErrorsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) UsersSetSecureValueErrors(params *UsersSetSecureValueErrorsParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) UsersSetSecureValueErrors(ctx context.Context, params *UsersSetSecureValueErrorsParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating UsersSetSecureValueErrorsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning UsersSetSecureValueErrors")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3019,24 +3090,25 @@ func (e *ContactsGetContactIDsParams) CRC() uint32 {
 }
 
 func (e *ContactsGetContactIDsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Hash)
 	return buf.Result()
 }
 
-func (c *Client) ContactsGetContactIDs(params *ContactsGetContactIDsParams) (*serialize.Int, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ContactsGetContactIDs(ctx context.Context, params *ContactsGetContactIDsParams) (*serialize.Int, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ContactsGetContactIDsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ContactsGetContactIDs")
 	}
 
 	resp, ok := data.(*serialize.Int)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3054,15 +3126,15 @@ func (e *ContactsGetStatusesParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ContactsGetStatuses() (*ContactStatus, error) {
-	data, err := c.MakeRequest(&ContactsGetStatusesParams{})
+func (c *Client) ContactsGetStatuses(ctx context.Context) (*ContactStatus, error) {
+	data, err := c.MakeRequestContext(ctx, &ContactsGetStatusesParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ContactsGetStatuses")
 	}
 
 	resp, ok := data.(*ContactStatus)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3077,24 +3149,25 @@ func (e *ContactsGetContactsParams) CRC() uint32 {
 }
 
 func (e *ContactsGetContactsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Hash)
 	return buf.Result()
 }
 
-func (c *Client) ContactsGetContacts(params *ContactsGetContactsParams) (ContactsContacts, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ContactsGetContacts(ctx context.Context, params *ContactsGetContactsParams) (ContactsContacts, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ContactsGetContactsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ContactsGetContacts")
 	}
 
 	resp, ok := data.(ContactsContacts)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3109,24 +3182,25 @@ func (e *ContactsImportContactsParams) CRC() uint32 {
 }
 
 func (e *ContactsImportContactsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Contacts)
 	return buf.Result()
 }
 
-func (c *Client) ContactsImportContacts(params *ContactsImportContactsParams) (*ContactsImportedContacts, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ContactsImportContacts(ctx context.Context, params *ContactsImportContactsParams) (*ContactsImportedContacts, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ContactsImportContactsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ContactsImportContacts")
 	}
 
 	resp, ok := data.(*ContactsImportedContacts)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3141,24 +3215,25 @@ func (e *ContactsDeleteContactsParams) CRC() uint32 {
 }
 
 func (e *ContactsDeleteContactsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Id)
 	return buf.Result()
 }
 
-func (c *Client) ContactsDeleteContacts(params *ContactsDeleteContactsParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ContactsDeleteContacts(ctx context.Context, params *ContactsDeleteContactsParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ContactsDeleteContactsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ContactsDeleteContacts")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3173,24 +3248,25 @@ func (e *ContactsDeleteByPhonesParams) CRC() uint32 {
 }
 
 func (e *ContactsDeleteByPhonesParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Phones)
 	return buf.Result()
 }
 
-func (c *Client) ContactsDeleteByPhones(params *ContactsDeleteByPhonesParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ContactsDeleteByPhones(ctx context.Context, params *ContactsDeleteByPhonesParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ContactsDeleteByPhonesParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ContactsDeleteByPhones")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3205,24 +3281,25 @@ func (e *ContactsBlockParams) CRC() uint32 {
 }
 
 func (e *ContactsBlockParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Id.Encode())
 	return buf.Result()
 }
 
-func (c *Client) ContactsBlock(params *ContactsBlockParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ContactsBlock(ctx context.Context, params *ContactsBlockParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ContactsBlockParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ContactsBlock")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3237,24 +3314,25 @@ func (e *ContactsUnblockParams) CRC() uint32 {
 }
 
 func (e *ContactsUnblockParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Id.Encode())
 	return buf.Result()
 }
 
-func (c *Client) ContactsUnblock(params *ContactsUnblockParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ContactsUnblock(ctx context.Context, params *ContactsUnblockParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ContactsUnblockParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ContactsUnblock")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3270,9 +3348,6 @@ func (e *ContactsGetBlockedParams) CRC() uint32 {
 }
 
 func (e *ContactsGetBlockedParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Offset)
@@ -3280,15 +3355,19 @@ func (e *ContactsGetBlockedParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ContactsGetBlocked(params *ContactsGetBlockedParams) (ContactsBlocked, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ContactsGetBlocked(ctx context.Context, params *ContactsGetBlockedParams) (ContactsBlocked, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ContactsGetBlockedParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ContactsGetBlocked")
 	}
 
 	resp, ok := data.(ContactsBlocked)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3304,9 +3383,6 @@ func (e *ContactsSearchParams) CRC() uint32 {
 }
 
 func (e *ContactsSearchParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Q)
@@ -3314,15 +3390,19 @@ func (e *ContactsSearchParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ContactsSearch(params *ContactsSearchParams) (*ContactsFound, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ContactsSearch(ctx context.Context, params *ContactsSearchParams) (*ContactsFound, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ContactsSearchParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ContactsSearch")
 	}
 
 	resp, ok := data.(*ContactsFound)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3337,24 +3417,25 @@ func (e *ContactsResolveUsernameParams) CRC() uint32 {
 }
 
 func (e *ContactsResolveUsernameParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Username)
 	return buf.Result()
 }
 
-func (c *Client) ContactsResolveUsername(params *ContactsResolveUsernameParams) (*ContactsResolvedPeer, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ContactsResolveUsername(ctx context.Context, params *ContactsResolveUsernameParams) (*ContactsResolvedPeer, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ContactsResolveUsernameParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ContactsResolveUsername")
 	}
 
 	resp, ok := data.(*ContactsResolvedPeer)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3380,9 +3461,6 @@ func (e *ContactsGetTopPeersParams) CRC() uint32 {
 }
 
 func (e *ContactsGetTopPeersParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Correspondents) {
 		flag |= 1 << 0
@@ -3417,15 +3495,19 @@ func (e *ContactsGetTopPeersParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ContactsGetTopPeers(params *ContactsGetTopPeersParams) (ContactsTopPeers, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ContactsGetTopPeers(ctx context.Context, params *ContactsGetTopPeersParams) (ContactsTopPeers, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ContactsGetTopPeersParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ContactsGetTopPeers")
 	}
 
 	resp, ok := data.(ContactsTopPeers)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3441,9 +3523,6 @@ func (e *ContactsResetTopPeerRatingParams) CRC() uint32 {
 }
 
 func (e *ContactsResetTopPeerRatingParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Category.Encode())
@@ -3451,15 +3530,19 @@ func (e *ContactsResetTopPeerRatingParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ContactsResetTopPeerRating(params *ContactsResetTopPeerRatingParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ContactsResetTopPeerRating(ctx context.Context, params *ContactsResetTopPeerRatingParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ContactsResetTopPeerRatingParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ContactsResetTopPeerRating")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3477,15 +3560,15 @@ func (e *ContactsResetSavedParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ContactsResetSaved() (*serialize.Bool, error) {
-	data, err := c.MakeRequest(&ContactsResetSavedParams{})
+func (c *Client) ContactsResetSaved(ctx context.Context) (*serialize.Bool, error) {
+	data, err := c.MakeRequestContext(ctx, &ContactsResetSavedParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ContactsResetSaved")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3503,15 +3586,15 @@ func (e *ContactsGetSavedParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ContactsGetSaved() (*SavedContact, error) {
-	data, err := c.MakeRequest(&ContactsGetSavedParams{})
+func (c *Client) ContactsGetSaved(ctx context.Context) (*SavedContact, error) {
+	data, err := c.MakeRequestContext(ctx, &ContactsGetSavedParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ContactsGetSaved")
 	}
 
 	resp, ok := data.(*SavedContact)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3526,24 +3609,25 @@ func (e *ContactsToggleTopPeersParams) CRC() uint32 {
 }
 
 func (e *ContactsToggleTopPeersParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutBool(e.Enabled)
 	return buf.Result()
 }
 
-func (c *Client) ContactsToggleTopPeers(params *ContactsToggleTopPeersParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ContactsToggleTopPeers(ctx context.Context, params *ContactsToggleTopPeersParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ContactsToggleTopPeersParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ContactsToggleTopPeers")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3563,9 +3647,6 @@ func (e *ContactsAddContactParams) CRC() uint32 {
 }
 
 func (e *ContactsAddContactParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.AddPhonePrivacyException) {
 		flag |= 1 << 0
@@ -3580,15 +3661,19 @@ func (e *ContactsAddContactParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ContactsAddContact(params *ContactsAddContactParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ContactsAddContact(ctx context.Context, params *ContactsAddContactParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ContactsAddContactParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ContactsAddContact")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3603,24 +3688,25 @@ func (e *ContactsAcceptContactParams) CRC() uint32 {
 }
 
 func (e *ContactsAcceptContactParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Id.Encode())
 	return buf.Result()
 }
 
-func (c *Client) ContactsAcceptContact(params *ContactsAcceptContactParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ContactsAcceptContact(ctx context.Context, params *ContactsAcceptContactParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ContactsAcceptContactParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ContactsAcceptContact")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3638,9 +3724,6 @@ func (e *ContactsGetLocatedParams) CRC() uint32 {
 }
 
 func (e *ContactsGetLocatedParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.SelfExpires) {
 		flag |= 1 << 0
@@ -3658,15 +3741,19 @@ func (e *ContactsGetLocatedParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ContactsGetLocated(params *ContactsGetLocatedParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ContactsGetLocated(ctx context.Context, params *ContactsGetLocatedParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ContactsGetLocatedParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ContactsGetLocated")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3681,24 +3768,25 @@ func (e *MessagesGetMessagesParams) CRC() uint32 {
 }
 
 func (e *MessagesGetMessagesParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Id)
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetMessages(params *MessagesGetMessagesParams) (MessagesMessages, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetMessages(ctx context.Context, params *MessagesGetMessagesParams) (MessagesMessages, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetMessagesParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetMessages")
 	}
 
 	resp, ok := data.(MessagesMessages)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3720,9 +3808,6 @@ func (e *MessagesGetDialogsParams) CRC() uint32 {
 }
 
 func (e *MessagesGetDialogsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ExcludePinned) {
 		flag |= 1 << 0
@@ -3744,15 +3829,19 @@ func (e *MessagesGetDialogsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetDialogs(params *MessagesGetDialogsParams) (MessagesDialogs, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetDialogs(ctx context.Context, params *MessagesGetDialogsParams) (MessagesDialogs, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetDialogsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetDialogs")
 	}
 
 	resp, ok := data.(MessagesDialogs)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3774,9 +3863,6 @@ func (e *MessagesGetHistoryParams) CRC() uint32 {
 }
 
 func (e *MessagesGetHistoryParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -3790,15 +3876,19 @@ func (e *MessagesGetHistoryParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetHistory(params *MessagesGetHistoryParams) (MessagesMessages, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetHistory(ctx context.Context, params *MessagesGetHistoryParams) (MessagesMessages, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetHistoryParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetHistory")
 	}
 
 	resp, ok := data.(MessagesMessages)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3825,9 +3915,6 @@ func (e *MessagesSearchParams) CRC() uint32 {
 }
 
 func (e *MessagesSearchParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.FromId) {
 		flag |= 1 << 0
@@ -3852,15 +3939,19 @@ func (e *MessagesSearchParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesSearch(params *MessagesSearchParams) (MessagesMessages, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesSearch(ctx context.Context, params *MessagesSearchParams) (MessagesMessages, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesSearchParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesSearch")
 	}
 
 	resp, ok := data.(MessagesMessages)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3876,9 +3967,6 @@ func (e *MessagesReadHistoryParams) CRC() uint32 {
 }
 
 func (e *MessagesReadHistoryParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -3886,15 +3974,19 @@ func (e *MessagesReadHistoryParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesReadHistory(params *MessagesReadHistoryParams) (*MessagesAffectedMessages, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesReadHistory(ctx context.Context, params *MessagesReadHistoryParams) (*MessagesAffectedMessages, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesReadHistoryParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesReadHistory")
 	}
 
 	resp, ok := data.(*MessagesAffectedMessages)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3913,9 +4005,6 @@ func (e *MessagesDeleteHistoryParams) CRC() uint32 {
 }
 
 func (e *MessagesDeleteHistoryParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.JustClear) {
 		flag |= 1 << 0
@@ -3931,15 +4020,19 @@ func (e *MessagesDeleteHistoryParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesDeleteHistory(params *MessagesDeleteHistoryParams) (*MessagesAffectedHistory, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesDeleteHistory(ctx context.Context, params *MessagesDeleteHistoryParams) (*MessagesAffectedHistory, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesDeleteHistoryParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesDeleteHistory")
 	}
 
 	resp, ok := data.(*MessagesAffectedHistory)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3956,9 +4049,6 @@ func (e *MessagesDeleteMessagesParams) CRC() uint32 {
 }
 
 func (e *MessagesDeleteMessagesParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Revoke) {
 		flag |= 1 << 0
@@ -3970,15 +4060,19 @@ func (e *MessagesDeleteMessagesParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesDeleteMessages(params *MessagesDeleteMessagesParams) (*MessagesAffectedMessages, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesDeleteMessages(ctx context.Context, params *MessagesDeleteMessagesParams) (*MessagesAffectedMessages, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesDeleteMessagesParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesDeleteMessages")
 	}
 
 	resp, ok := data.(*MessagesAffectedMessages)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3993,24 +4087,25 @@ func (e *MessagesReceivedMessagesParams) CRC() uint32 {
 }
 
 func (e *MessagesReceivedMessagesParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.MaxId)
 	return buf.Result()
 }
 
-func (c *Client) MessagesReceivedMessages(params *MessagesReceivedMessagesParams) (*ReceivedNotifyMessage, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesReceivedMessages(ctx context.Context, params *MessagesReceivedMessagesParams) (*ReceivedNotifyMessage, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesReceivedMessagesParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesReceivedMessages")
 	}
 
 	resp, ok := data.(*ReceivedNotifyMessage)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -4026,9 +4121,6 @@ func (e *MessagesSetTypingParams) CRC() uint32 {
 }
 
 func (e *MessagesSetTypingParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -4036,15 +4128,19 @@ func (e *MessagesSetTypingParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesSetTyping(params *MessagesSetTypingParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesSetTyping(ctx context.Context, params *MessagesSetTypingParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesSetTypingParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesSetTyping")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -4070,9 +4166,6 @@ func (e *MessagesSendMessageParams) CRC() uint32 {
 }
 
 func (e *MessagesSendMessageParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ReplyToMsgId) {
 		flag |= 1 << 0
@@ -4119,15 +4212,19 @@ func (e *MessagesSendMessageParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesSendMessage(params *MessagesSendMessageParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesSendMessage(ctx context.Context, params *MessagesSendMessageParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesSendMessageParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesSendMessage")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -4153,9 +4250,6 @@ func (e *MessagesSendMediaParams) CRC() uint32 {
 }
 
 func (e *MessagesSendMediaParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ReplyToMsgId) {
 		flag |= 1 << 0
@@ -4200,15 +4294,19 @@ func (e *MessagesSendMediaParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesSendMedia(params *MessagesSendMediaParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesSendMedia(ctx context.Context, params *MessagesSendMediaParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesSendMediaParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesSendMedia")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -4232,9 +4330,6 @@ func (e *MessagesForwardMessagesParams) CRC() uint32 {
 }
 
 func (e *MessagesForwardMessagesParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Silent) {
 		flag |= 1 << 5
@@ -4264,15 +4359,19 @@ func (e *MessagesForwardMessagesParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesForwardMessages(params *MessagesForwardMessagesParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesForwardMessages(ctx context.Context, params *MessagesForwardMessagesParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesForwardMessagesParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesForwardMessages")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -4287,24 +4386,25 @@ func (e *MessagesReportSpamParams) CRC() uint32 {
 }
 
 func (e *MessagesReportSpamParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
 	return buf.Result()
 }
 
-func (c *Client) MessagesReportSpam(params *MessagesReportSpamParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesReportSpam(ctx context.Context, params *MessagesReportSpamParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesReportSpamParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesReportSpam")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -4319,24 +4419,25 @@ func (e *MessagesGetPeerSettingsParams) CRC() uint32 {
 }
 
 func (e *MessagesGetPeerSettingsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetPeerSettings(params *MessagesGetPeerSettingsParams) (*PeerSettings, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetPeerSettings(ctx context.Context, params *MessagesGetPeerSettingsParams) (*PeerSettings, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetPeerSettingsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetPeerSettings")
 	}
 
 	resp, ok := data.(*PeerSettings)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -4353,9 +4454,6 @@ func (e *MessagesReportParams) CRC() uint32 {
 }
 
 func (e *MessagesReportParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -4364,15 +4462,19 @@ func (e *MessagesReportParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesReport(params *MessagesReportParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesReport(ctx context.Context, params *MessagesReportParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesReportParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesReport")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -4387,24 +4489,25 @@ func (e *MessagesGetChatsParams) CRC() uint32 {
 }
 
 func (e *MessagesGetChatsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Id)
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetChats(params *MessagesGetChatsParams) (MessagesChats, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetChats(ctx context.Context, params *MessagesGetChatsParams) (MessagesChats, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetChatsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetChats")
 	}
 
 	resp, ok := data.(MessagesChats)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -4419,24 +4522,25 @@ func (e *MessagesGetFullChatParams) CRC() uint32 {
 }
 
 func (e *MessagesGetFullChatParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChatId)
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetFullChat(params *MessagesGetFullChatParams) (*MessagesChatFull, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetFullChat(ctx context.Context, params *MessagesGetFullChatParams) (*MessagesChatFull, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetFullChatParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetFullChat")
 	}
 
 	resp, ok := data.(*MessagesChatFull)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -4452,9 +4556,6 @@ func (e *MessagesEditChatTitleParams) CRC() uint32 {
 }
 
 func (e *MessagesEditChatTitleParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChatId)
@@ -4462,15 +4563,19 @@ func (e *MessagesEditChatTitleParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesEditChatTitle(params *MessagesEditChatTitleParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesEditChatTitle(ctx context.Context, params *MessagesEditChatTitleParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesEditChatTitleParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesEditChatTitle")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -4486,9 +4591,6 @@ func (e *MessagesEditChatPhotoParams) CRC() uint32 {
 }
 
 func (e *MessagesEditChatPhotoParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChatId)
@@ -4496,15 +4598,19 @@ func (e *MessagesEditChatPhotoParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesEditChatPhoto(params *MessagesEditChatPhotoParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesEditChatPhoto(ctx context.Context, params *MessagesEditChatPhotoParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesEditChatPhotoParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesEditChatPhoto")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -4521,9 +4627,6 @@ func (e *MessagesAddChatUserParams) CRC() uint32 {
 }
 
 func (e *MessagesAddChatUserParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChatId)
@@ -4532,15 +4635,19 @@ func (e *MessagesAddChatUserParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesAddChatUser(params *MessagesAddChatUserParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesAddChatUser(ctx context.Context, params *MessagesAddChatUserParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesAddChatUserParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesAddChatUser")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -4556,9 +4663,6 @@ func (e *MessagesDeleteChatUserParams) CRC() uint32 {
 }
 
 func (e *MessagesDeleteChatUserParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChatId)
@@ -4566,15 +4670,19 @@ func (e *MessagesDeleteChatUserParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesDeleteChatUser(params *MessagesDeleteChatUserParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesDeleteChatUser(ctx context.Context, params *MessagesDeleteChatUserParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesDeleteChatUserParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesDeleteChatUser")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -4590,9 +4698,6 @@ func (e *MessagesCreateChatParams) CRC() uint32 {
 }
 
 func (e *MessagesCreateChatParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Users)
@@ -4600,15 +4705,19 @@ func (e *MessagesCreateChatParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesCreateChat(params *MessagesCreateChatParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesCreateChat(ctx context.Context, params *MessagesCreateChatParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesCreateChatParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesCreateChat")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -4624,9 +4733,6 @@ func (e *MessagesGetDhConfigParams) CRC() uint32 {
 }
 
 func (e *MessagesGetDhConfigParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Version)
@@ -4634,15 +4740,19 @@ func (e *MessagesGetDhConfigParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetDhConfig(params *MessagesGetDhConfigParams) (MessagesDhConfig, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetDhConfig(ctx context.Context, params *MessagesGetDhConfigParams) (MessagesDhConfig, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetDhConfigParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetDhConfig")
 	}
 
 	resp, ok := data.(MessagesDhConfig)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -4659,9 +4769,6 @@ func (e *MessagesRequestEncryptionParams) CRC() uint32 {
 }
 
 func (e *MessagesRequestEncryptionParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.UserId.Encode())
@@ -4670,15 +4777,19 @@ func (e *MessagesRequestEncryptionParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesRequestEncryption(params *MessagesRequestEncryptionParams) (EncryptedChat, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesRequestEncryption(ctx context.Context, params *MessagesRequestEncryptionParams) (EncryptedChat, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesRequestEncryptionParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesRequestEncryption")
 	}
 
 	resp, ok := data.(EncryptedChat)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -4695,9 +4806,6 @@ func (e *MessagesAcceptEncryptionParams) CRC() uint32 {
 }
 
 func (e *MessagesAcceptEncryptionParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -4706,15 +4814,19 @@ func (e *MessagesAcceptEncryptionParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesAcceptEncryption(params *MessagesAcceptEncryptionParams) (EncryptedChat, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesAcceptEncryption(ctx context.Context, params *MessagesAcceptEncryptionParams) (EncryptedChat, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesAcceptEncryptionParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesAcceptEncryption")
 	}
 
 	resp, ok := data.(EncryptedChat)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -4729,24 +4841,25 @@ func (e *MessagesDiscardEncryptionParams) CRC() uint32 {
 }
 
 func (e *MessagesDiscardEncryptionParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChatId)
 	return buf.Result()
 }
 
-func (c *Client) MessagesDiscardEncryption(params *MessagesDiscardEncryptionParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesDiscardEncryption(ctx context.Context, params *MessagesDiscardEncryptionParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesDiscardEncryptionParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesDiscardEncryption")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -4762,9 +4875,6 @@ func (e *MessagesSetEncryptedTypingParams) CRC() uint32 {
 }
 
 func (e *MessagesSetEncryptedTypingParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -4772,15 +4882,19 @@ func (e *MessagesSetEncryptedTypingParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesSetEncryptedTyping(params *MessagesSetEncryptedTypingParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesSetEncryptedTyping(ctx context.Context, params *MessagesSetEncryptedTypingParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesSetEncryptedTypingParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesSetEncryptedTyping")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -4796,9 +4910,6 @@ func (e *MessagesReadEncryptedHistoryParams) CRC() uint32 {
 }
 
 func (e *MessagesReadEncryptedHistoryParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -4806,15 +4917,19 @@ func (e *MessagesReadEncryptedHistoryParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesReadEncryptedHistory(params *MessagesReadEncryptedHistoryParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesReadEncryptedHistory(ctx context.Context, params *MessagesReadEncryptedHistoryParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesReadEncryptedHistoryParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesReadEncryptedHistory")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -4831,9 +4946,6 @@ func (e *MessagesSendEncryptedParams) CRC() uint32 {
 }
 
 func (e *MessagesSendEncryptedParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -4842,15 +4954,19 @@ func (e *MessagesSendEncryptedParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesSendEncrypted(params *MessagesSendEncryptedParams) (MessagesSentEncryptedMessage, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesSendEncrypted(ctx context.Context, params *MessagesSendEncryptedParams) (MessagesSentEncryptedMessage, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesSendEncryptedParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesSendEncrypted")
 	}
 
 	resp, ok := data.(MessagesSentEncryptedMessage)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -4868,9 +4984,6 @@ func (e *MessagesSendEncryptedFileParams) CRC() uint32 {
 }
 
 func (e *MessagesSendEncryptedFileParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -4880,15 +4993,19 @@ func (e *MessagesSendEncryptedFileParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesSendEncryptedFile(params *MessagesSendEncryptedFileParams) (MessagesSentEncryptedMessage, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesSendEncryptedFile(ctx context.Context, params *MessagesSendEncryptedFileParams) (MessagesSentEncryptedMessage, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesSendEncryptedFileParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesSendEncryptedFile")
 	}
 
 	resp, ok := data.(MessagesSentEncryptedMessage)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -4905,9 +5022,6 @@ func (e *MessagesSendEncryptedServiceParams) CRC() uint32 {
 }
 
 func (e *MessagesSendEncryptedServiceParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -4916,15 +5030,19 @@ func (e *MessagesSendEncryptedServiceParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesSendEncryptedService(params *MessagesSendEncryptedServiceParams) (MessagesSentEncryptedMessage, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesSendEncryptedService(ctx context.Context, params *MessagesSendEncryptedServiceParams) (MessagesSentEncryptedMessage, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesSendEncryptedServiceParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesSendEncryptedService")
 	}
 
 	resp, ok := data.(MessagesSentEncryptedMessage)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -4939,24 +5057,25 @@ func (e *MessagesReceivedQueueParams) CRC() uint32 {
 }
 
 func (e *MessagesReceivedQueueParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.MaxQts)
 	return buf.Result()
 }
 
-func (c *Client) MessagesReceivedQueue(params *MessagesReceivedQueueParams) (*serialize.Long, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesReceivedQueue(ctx context.Context, params *MessagesReceivedQueueParams) (*serialize.Long, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesReceivedQueueParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesReceivedQueue")
 	}
 
 	resp, ok := data.(*serialize.Long)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -4971,24 +5090,25 @@ func (e *MessagesReportEncryptedSpamParams) CRC() uint32 {
 }
 
 func (e *MessagesReportEncryptedSpamParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
 	return buf.Result()
 }
 
-func (c *Client) MessagesReportEncryptedSpam(params *MessagesReportEncryptedSpamParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesReportEncryptedSpam(ctx context.Context, params *MessagesReportEncryptedSpamParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesReportEncryptedSpamParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesReportEncryptedSpam")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -5003,24 +5123,25 @@ func (e *MessagesReadMessageContentsParams) CRC() uint32 {
 }
 
 func (e *MessagesReadMessageContentsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Id)
 	return buf.Result()
 }
 
-func (c *Client) MessagesReadMessageContents(params *MessagesReadMessageContentsParams) (*MessagesAffectedMessages, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesReadMessageContents(ctx context.Context, params *MessagesReadMessageContentsParams) (*MessagesAffectedMessages, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesReadMessageContentsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesReadMessageContents")
 	}
 
 	resp, ok := data.(*MessagesAffectedMessages)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -5036,9 +5157,6 @@ func (e *MessagesGetStickersParams) CRC() uint32 {
 }
 
 func (e *MessagesGetStickersParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Emoticon)
@@ -5046,15 +5164,19 @@ func (e *MessagesGetStickersParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetStickers(params *MessagesGetStickersParams) (MessagesStickers, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetStickers(ctx context.Context, params *MessagesGetStickersParams) (MessagesStickers, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetStickersParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetStickers")
 	}
 
 	resp, ok := data.(MessagesStickers)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -5069,24 +5191,25 @@ func (e *MessagesGetAllStickersParams) CRC() uint32 {
 }
 
 func (e *MessagesGetAllStickersParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Hash)
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetAllStickers(params *MessagesGetAllStickersParams) (MessagesAllStickers, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetAllStickers(ctx context.Context, params *MessagesGetAllStickersParams) (MessagesAllStickers, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetAllStickersParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetAllStickers")
 	}
 
 	resp, ok := data.(MessagesAllStickers)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -5103,9 +5226,6 @@ func (e *MessagesGetWebPagePreviewParams) CRC() uint32 {
 }
 
 func (e *MessagesGetWebPagePreviewParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Entities) {
 		flag |= 1 << 3
@@ -5120,15 +5240,19 @@ func (e *MessagesGetWebPagePreviewParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetWebPagePreview(params *MessagesGetWebPagePreviewParams) (MessageMedia, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetWebPagePreview(ctx context.Context, params *MessagesGetWebPagePreviewParams) (MessageMedia, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetWebPagePreviewParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetWebPagePreview")
 	}
 
 	resp, ok := data.(MessageMedia)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -5143,24 +5267,25 @@ func (e *MessagesExportChatInviteParams) CRC() uint32 {
 }
 
 func (e *MessagesExportChatInviteParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
 	return buf.Result()
 }
 
-func (c *Client) MessagesExportChatInvite(params *MessagesExportChatInviteParams) (ExportedChatInvite, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesExportChatInvite(ctx context.Context, params *MessagesExportChatInviteParams) (ExportedChatInvite, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesExportChatInviteParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesExportChatInvite")
 	}
 
 	resp, ok := data.(ExportedChatInvite)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -5175,24 +5300,25 @@ func (e *MessagesCheckChatInviteParams) CRC() uint32 {
 }
 
 func (e *MessagesCheckChatInviteParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Hash)
 	return buf.Result()
 }
 
-func (c *Client) MessagesCheckChatInvite(params *MessagesCheckChatInviteParams) (ChatInvite, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesCheckChatInvite(ctx context.Context, params *MessagesCheckChatInviteParams) (ChatInvite, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesCheckChatInviteParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesCheckChatInvite")
 	}
 
 	resp, ok := data.(ChatInvite)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -5207,24 +5333,25 @@ func (e *MessagesImportChatInviteParams) CRC() uint32 {
 }
 
 func (e *MessagesImportChatInviteParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Hash)
 	return buf.Result()
 }
 
-func (c *Client) MessagesImportChatInvite(params *MessagesImportChatInviteParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesImportChatInvite(ctx context.Context, params *MessagesImportChatInviteParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesImportChatInviteParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesImportChatInvite")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -5239,24 +5366,25 @@ func (e *MessagesGetStickerSetParams) CRC() uint32 {
 }
 
 func (e *MessagesGetStickerSetParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Stickerset.Encode())
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetStickerSet(params *MessagesGetStickerSetParams) (*MessagesStickerSet, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetStickerSet(ctx context.Context, params *MessagesGetStickerSetParams) (*MessagesStickerSet, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetStickerSetParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetStickerSet")
 	}
 
 	resp, ok := data.(*MessagesStickerSet)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -5272,9 +5400,6 @@ func (e *MessagesInstallStickerSetParams) CRC() uint32 {
 }
 
 func (e *MessagesInstallStickerSetParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Stickerset.Encode())
@@ -5282,15 +5407,19 @@ func (e *MessagesInstallStickerSetParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesInstallStickerSet(params *MessagesInstallStickerSetParams) (MessagesStickerSetInstallResult, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesInstallStickerSet(ctx context.Context, params *MessagesInstallStickerSetParams) (MessagesStickerSetInstallResult, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesInstallStickerSetParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesInstallStickerSet")
 	}
 
 	resp, ok := data.(MessagesStickerSetInstallResult)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -5305,24 +5434,25 @@ func (e *MessagesUninstallStickerSetParams) CRC() uint32 {
 }
 
 func (e *MessagesUninstallStickerSetParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Stickerset.Encode())
 	return buf.Result()
 }
 
-func (c *Client) MessagesUninstallStickerSet(params *MessagesUninstallStickerSetParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesUninstallStickerSet(ctx context.Context, params *MessagesUninstallStickerSetParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesUninstallStickerSetParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesUninstallStickerSet")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -5340,9 +5470,6 @@ func (e *MessagesStartBotParams) CRC() uint32 {
 }
 
 func (e *MessagesStartBotParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Bot.Encode())
@@ -5352,15 +5479,19 @@ func (e *MessagesStartBotParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesStartBot(params *MessagesStartBotParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesStartBot(ctx context.Context, params *MessagesStartBotParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesStartBotParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesStartBot")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -5377,9 +5508,6 @@ func (e *MessagesGetMessagesViewsParams) CRC() uint32 {
 }
 
 func (e *MessagesGetMessagesViewsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -5388,15 +5516,19 @@ func (e *MessagesGetMessagesViewsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetMessagesViews(params *MessagesGetMessagesViewsParams) (*serialize.Int, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetMessagesViews(ctx context.Context, params *MessagesGetMessagesViewsParams) (*serialize.Int, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetMessagesViewsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetMessagesViews")
 	}
 
 	resp, ok := data.(*serialize.Int)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -5413,9 +5545,6 @@ func (e *MessagesEditChatAdminParams) CRC() uint32 {
 }
 
 func (e *MessagesEditChatAdminParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChatId)
@@ -5424,15 +5553,19 @@ func (e *MessagesEditChatAdminParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesEditChatAdmin(params *MessagesEditChatAdminParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesEditChatAdmin(ctx context.Context, params *MessagesEditChatAdminParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesEditChatAdminParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesEditChatAdmin")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -5447,24 +5580,25 @@ func (e *MessagesMigrateChatParams) CRC() uint32 {
 }
 
 func (e *MessagesMigrateChatParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChatId)
 	return buf.Result()
 }
 
-func (c *Client) MessagesMigrateChat(params *MessagesMigrateChatParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesMigrateChat(ctx context.Context, params *MessagesMigrateChatParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesMigrateChatParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesMigrateChat")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -5485,9 +5619,6 @@ func (e *MessagesSearchGlobalParams) CRC() uint32 {
 }
 
 func (e *MessagesSearchGlobalParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.FolderId) {
 		flag |= 1 << 0
@@ -5506,15 +5637,19 @@ func (e *MessagesSearchGlobalParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesSearchGlobal(params *MessagesSearchGlobalParams) (MessagesMessages, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesSearchGlobal(ctx context.Context, params *MessagesSearchGlobalParams) (MessagesMessages, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesSearchGlobalParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesSearchGlobal")
 	}
 
 	resp, ok := data.(MessagesMessages)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -5531,9 +5666,6 @@ func (e *MessagesReorderStickerSetsParams) CRC() uint32 {
 }
 
 func (e *MessagesReorderStickerSetsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Masks) {
 		flag |= 1 << 0
@@ -5545,15 +5677,19 @@ func (e *MessagesReorderStickerSetsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesReorderStickerSets(params *MessagesReorderStickerSetsParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesReorderStickerSets(ctx context.Context, params *MessagesReorderStickerSetsParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesReorderStickerSetsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesReorderStickerSets")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -5570,9 +5706,6 @@ func (e *MessagesGetDocumentByHashParams) CRC() uint32 {
 }
 
 func (e *MessagesGetDocumentByHashParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutMessage(e.Sha256)
@@ -5581,15 +5714,19 @@ func (e *MessagesGetDocumentByHashParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetDocumentByHash(params *MessagesGetDocumentByHashParams) (Document, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetDocumentByHash(ctx context.Context, params *MessagesGetDocumentByHashParams) (Document, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetDocumentByHashParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetDocumentByHash")
 	}
 
 	resp, ok := data.(Document)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -5604,24 +5741,25 @@ func (e *MessagesGetSavedGifsParams) CRC() uint32 {
 }
 
 func (e *MessagesGetSavedGifsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Hash)
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetSavedGifs(params *MessagesGetSavedGifsParams) (MessagesSavedGifs, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetSavedGifs(ctx context.Context, params *MessagesGetSavedGifsParams) (MessagesSavedGifs, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetSavedGifsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetSavedGifs")
 	}
 
 	resp, ok := data.(MessagesSavedGifs)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -5637,9 +5775,6 @@ func (e *MessagesSaveGifParams) CRC() uint32 {
 }
 
 func (e *MessagesSaveGifParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Id.Encode())
@@ -5647,15 +5782,19 @@ func (e *MessagesSaveGifParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesSaveGif(params *MessagesSaveGifParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesSaveGif(ctx context.Context, params *MessagesSaveGifParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesSaveGifParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesSaveGif")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -5675,9 +5814,6 @@ func (e *MessagesGetInlineBotResultsParams) CRC() uint32 {
 }
 
 func (e *MessagesGetInlineBotResultsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.GeoPoint) {
 		flag |= 1 << 0
@@ -5695,15 +5831,19 @@ func (e *MessagesGetInlineBotResultsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetInlineBotResults(params *MessagesGetInlineBotResultsParams) (*MessagesBotResults, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetInlineBotResults(ctx context.Context, params *MessagesGetInlineBotResultsParams) (*MessagesBotResults, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetInlineBotResultsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetInlineBotResults")
 	}
 
 	resp, ok := data.(*MessagesBotResults)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -5725,9 +5865,6 @@ func (e *MessagesSetInlineBotResultsParams) CRC() uint32 {
 }
 
 func (e *MessagesSetInlineBotResultsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Gallery) {
 		flag |= 1 << 0
@@ -5756,15 +5893,19 @@ func (e *MessagesSetInlineBotResultsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesSetInlineBotResults(params *MessagesSetInlineBotResultsParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesSetInlineBotResults(ctx context.Context, params *MessagesSetInlineBotResultsParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesSetInlineBotResultsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesSetInlineBotResults")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -5789,9 +5930,6 @@ func (e *MessagesSendInlineBotResultParams) CRC() uint32 {
 }
 
 func (e *MessagesSendInlineBotResultParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ReplyToMsgId) {
 		flag |= 1 << 0
@@ -5827,15 +5965,19 @@ func (e *MessagesSendInlineBotResultParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesSendInlineBotResult(params *MessagesSendInlineBotResultParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesSendInlineBotResult(ctx context.Context, params *MessagesSendInlineBotResultParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesSendInlineBotResultParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesSendInlineBotResult")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -5851,9 +5993,6 @@ func (e *MessagesGetMessageEditDataParams) CRC() uint32 {
 }
 
 func (e *MessagesGetMessageEditDataParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -5861,15 +6000,19 @@ func (e *MessagesGetMessageEditDataParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetMessageEditData(params *MessagesGetMessageEditDataParams) (*MessagesMessageEditData, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetMessageEditData(ctx context.Context, params *MessagesGetMessageEditDataParams) (*MessagesMessageEditData, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetMessageEditDataParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetMessageEditData")
 	}
 
 	resp, ok := data.(*MessagesMessageEditData)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -5892,9 +6035,6 @@ func (e *MessagesEditMessageParams) CRC() uint32 {
 }
 
 func (e *MessagesEditMessageParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.NoWebpage) {
 		flag |= 1 << 1
@@ -5937,15 +6077,19 @@ func (e *MessagesEditMessageParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesEditMessage(params *MessagesEditMessageParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesEditMessage(ctx context.Context, params *MessagesEditMessageParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesEditMessageParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesEditMessage")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -5966,9 +6110,6 @@ func (e *MessagesEditInlineBotMessageParams) CRC() uint32 {
 }
 
 func (e *MessagesEditInlineBotMessageParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.NoWebpage) {
 		flag |= 1 << 1
@@ -6004,15 +6145,19 @@ func (e *MessagesEditInlineBotMessageParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesEditInlineBotMessage(params *MessagesEditInlineBotMessageParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesEditInlineBotMessage(ctx context.Context, params *MessagesEditInlineBotMessageParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesEditInlineBotMessageParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesEditInlineBotMessage")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -6031,9 +6176,6 @@ func (e *MessagesGetBotCallbackAnswerParams) CRC() uint32 {
 }
 
 func (e *MessagesGetBotCallbackAnswerParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Data) {
 		flag |= 1 << 0
@@ -6052,15 +6194,19 @@ func (e *MessagesGetBotCallbackAnswerParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetBotCallbackAnswer(params *MessagesGetBotCallbackAnswerParams) (*MessagesBotCallbackAnswer, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetBotCallbackAnswer(ctx context.Context, params *MessagesGetBotCallbackAnswerParams) (*MessagesBotCallbackAnswer, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetBotCallbackAnswerParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetBotCallbackAnswer")
 	}
 
 	resp, ok := data.(*MessagesBotCallbackAnswer)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -6080,9 +6226,6 @@ func (e *MessagesSetBotCallbackAnswerParams) CRC() uint32 {
 }
 
 func (e *MessagesSetBotCallbackAnswerParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Message) {
 		flag |= 1 << 0
@@ -6107,15 +6250,19 @@ func (e *MessagesSetBotCallbackAnswerParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesSetBotCallbackAnswer(params *MessagesSetBotCallbackAnswerParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesSetBotCallbackAnswer(ctx context.Context, params *MessagesSetBotCallbackAnswerParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesSetBotCallbackAnswerParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesSetBotCallbackAnswer")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -6130,24 +6277,25 @@ func (e *MessagesGetPeerDialogsParams) CRC() uint32 {
 }
 
 func (e *MessagesGetPeerDialogsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Peers)
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetPeerDialogs(params *MessagesGetPeerDialogsParams) (*MessagesPeerDialogs, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetPeerDialogs(ctx context.Context, params *MessagesGetPeerDialogsParams) (*MessagesPeerDialogs, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetPeerDialogsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetPeerDialogs")
 	}
 
 	resp, ok := data.(*MessagesPeerDialogs)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -6167,9 +6315,6 @@ func (e *MessagesSaveDraftParams) CRC() uint32 {
 }
 
 func (e *MessagesSaveDraftParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ReplyToMsgId) {
 		flag |= 1 << 0
@@ -6194,15 +6339,19 @@ func (e *MessagesSaveDraftParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesSaveDraft(params *MessagesSaveDraftParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesSaveDraft(ctx context.Context, params *MessagesSaveDraftParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesSaveDraftParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesSaveDraft")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -6220,15 +6369,15 @@ func (e *MessagesGetAllDraftsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetAllDrafts() (Updates, error) {
-	data, err := c.MakeRequest(&MessagesGetAllDraftsParams{})
+func (c *Client) MessagesGetAllDrafts(ctx context.Context) (Updates, error) {
+	data, err := c.MakeRequestContext(ctx, &MessagesGetAllDraftsParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetAllDrafts")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -6243,24 +6392,25 @@ func (e *MessagesGetFeaturedStickersParams) CRC() uint32 {
 }
 
 func (e *MessagesGetFeaturedStickersParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Hash)
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetFeaturedStickers(params *MessagesGetFeaturedStickersParams) (MessagesFeaturedStickers, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetFeaturedStickers(ctx context.Context, params *MessagesGetFeaturedStickersParams) (MessagesFeaturedStickers, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetFeaturedStickersParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetFeaturedStickers")
 	}
 
 	resp, ok := data.(MessagesFeaturedStickers)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -6275,24 +6425,25 @@ func (e *MessagesReadFeaturedStickersParams) CRC() uint32 {
 }
 
 func (e *MessagesReadFeaturedStickersParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Id)
 	return buf.Result()
 }
 
-func (c *Client) MessagesReadFeaturedStickers(params *MessagesReadFeaturedStickersParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesReadFeaturedStickers(ctx context.Context, params *MessagesReadFeaturedStickersParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesReadFeaturedStickersParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesReadFeaturedStickers")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -6309,9 +6460,6 @@ func (e *MessagesGetRecentStickersParams) CRC() uint32 {
 }
 
 func (e *MessagesGetRecentStickersParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Attached) {
 		flag |= 1 << 0
@@ -6323,15 +6471,19 @@ func (e *MessagesGetRecentStickersParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetRecentStickers(params *MessagesGetRecentStickersParams) (MessagesRecentStickers, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetRecentStickers(ctx context.Context, params *MessagesGetRecentStickersParams) (MessagesRecentStickers, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetRecentStickersParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetRecentStickers")
 	}
 
 	resp, ok := data.(MessagesRecentStickers)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -6349,9 +6501,6 @@ func (e *MessagesSaveRecentStickerParams) CRC() uint32 {
 }
 
 func (e *MessagesSaveRecentStickerParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Attached) {
 		flag |= 1 << 0
@@ -6364,15 +6513,19 @@ func (e *MessagesSaveRecentStickerParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesSaveRecentSticker(params *MessagesSaveRecentStickerParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesSaveRecentSticker(ctx context.Context, params *MessagesSaveRecentStickerParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesSaveRecentStickerParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesSaveRecentSticker")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -6388,9 +6541,6 @@ func (e *MessagesClearRecentStickersParams) CRC() uint32 {
 }
 
 func (e *MessagesClearRecentStickersParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Attached) {
 		flag |= 1 << 0
@@ -6401,15 +6551,19 @@ func (e *MessagesClearRecentStickersParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesClearRecentStickers(params *MessagesClearRecentStickersParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesClearRecentStickers(ctx context.Context, params *MessagesClearRecentStickersParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesClearRecentStickersParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesClearRecentStickers")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -6427,9 +6581,6 @@ func (e *MessagesGetArchivedStickersParams) CRC() uint32 {
 }
 
 func (e *MessagesGetArchivedStickersParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Masks) {
 		flag |= 1 << 0
@@ -6442,15 +6593,19 @@ func (e *MessagesGetArchivedStickersParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetArchivedStickers(params *MessagesGetArchivedStickersParams) (*MessagesArchivedStickers, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetArchivedStickers(ctx context.Context, params *MessagesGetArchivedStickersParams) (*MessagesArchivedStickers, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetArchivedStickersParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetArchivedStickers")
 	}
 
 	resp, ok := data.(*MessagesArchivedStickers)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -6465,24 +6620,25 @@ func (e *MessagesGetMaskStickersParams) CRC() uint32 {
 }
 
 func (e *MessagesGetMaskStickersParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Hash)
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetMaskStickers(params *MessagesGetMaskStickersParams) (MessagesAllStickers, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetMaskStickers(ctx context.Context, params *MessagesGetMaskStickersParams) (MessagesAllStickers, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetMaskStickersParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetMaskStickers")
 	}
 
 	resp, ok := data.(MessagesAllStickers)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -6497,24 +6653,25 @@ func (e *MessagesGetAttachedStickersParams) CRC() uint32 {
 }
 
 func (e *MessagesGetAttachedStickersParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Media.Encode())
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetAttachedStickers(params *MessagesGetAttachedStickersParams) (StickerSetCovered, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetAttachedStickers(ctx context.Context, params *MessagesGetAttachedStickersParams) (StickerSetCovered, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetAttachedStickersParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetAttachedStickers")
 	}
 
 	resp, ok := data.(StickerSetCovered)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -6535,9 +6692,6 @@ func (e *MessagesSetGameScoreParams) CRC() uint32 {
 }
 
 func (e *MessagesSetGameScoreParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.EditMessage) {
 		flag |= 1 << 0
@@ -6555,15 +6709,19 @@ func (e *MessagesSetGameScoreParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesSetGameScore(params *MessagesSetGameScoreParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesSetGameScore(ctx context.Context, params *MessagesSetGameScoreParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesSetGameScoreParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesSetGameScore")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -6583,9 +6741,6 @@ func (e *MessagesSetInlineGameScoreParams) CRC() uint32 {
 }
 
 func (e *MessagesSetInlineGameScoreParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.EditMessage) {
 		flag |= 1 << 0
@@ -6602,15 +6757,19 @@ func (e *MessagesSetInlineGameScoreParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesSetInlineGameScore(params *MessagesSetInlineGameScoreParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesSetInlineGameScore(ctx context.Context, params *MessagesSetInlineGameScoreParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesSetInlineGameScoreParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesSetInlineGameScore")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -6627,9 +6786,6 @@ func (e *MessagesGetGameHighScoresParams) CRC() uint32 {
 }
 
 func (e *MessagesGetGameHighScoresParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -6638,15 +6794,19 @@ func (e *MessagesGetGameHighScoresParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetGameHighScores(params *MessagesGetGameHighScoresParams) (*MessagesHighScores, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetGameHighScores(ctx context.Context, params *MessagesGetGameHighScoresParams) (*MessagesHighScores, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetGameHighScoresParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetGameHighScores")
 	}
 
 	resp, ok := data.(*MessagesHighScores)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -6662,9 +6822,6 @@ func (e *MessagesGetInlineGameHighScoresParams) CRC() uint32 {
 }
 
 func (e *MessagesGetInlineGameHighScoresParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Id.Encode())
@@ -6672,15 +6829,19 @@ func (e *MessagesGetInlineGameHighScoresParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetInlineGameHighScores(params *MessagesGetInlineGameHighScoresParams) (*MessagesHighScores, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetInlineGameHighScores(ctx context.Context, params *MessagesGetInlineGameHighScoresParams) (*MessagesHighScores, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetInlineGameHighScoresParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetInlineGameHighScores")
 	}
 
 	resp, ok := data.(*MessagesHighScores)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -6697,9 +6858,6 @@ func (e *MessagesGetCommonChatsParams) CRC() uint32 {
 }
 
 func (e *MessagesGetCommonChatsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.UserId.Encode())
@@ -6708,15 +6866,19 @@ func (e *MessagesGetCommonChatsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetCommonChats(params *MessagesGetCommonChatsParams) (MessagesChats, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetCommonChats(ctx context.Context, params *MessagesGetCommonChatsParams) (MessagesChats, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetCommonChatsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetCommonChats")
 	}
 
 	resp, ok := data.(MessagesChats)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -6731,24 +6893,25 @@ func (e *MessagesGetAllChatsParams) CRC() uint32 {
 }
 
 func (e *MessagesGetAllChatsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.ExceptIds)
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetAllChats(params *MessagesGetAllChatsParams) (MessagesChats, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetAllChats(ctx context.Context, params *MessagesGetAllChatsParams) (MessagesChats, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetAllChatsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetAllChats")
 	}
 
 	resp, ok := data.(MessagesChats)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -6764,9 +6927,6 @@ func (e *MessagesGetWebPageParams) CRC() uint32 {
 }
 
 func (e *MessagesGetWebPageParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Url)
@@ -6774,15 +6934,19 @@ func (e *MessagesGetWebPageParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetWebPage(params *MessagesGetWebPageParams) (WebPage, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetWebPage(ctx context.Context, params *MessagesGetWebPageParams) (WebPage, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetWebPageParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetWebPage")
 	}
 
 	resp, ok := data.(WebPage)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -6799,9 +6963,6 @@ func (e *MessagesToggleDialogPinParams) CRC() uint32 {
 }
 
 func (e *MessagesToggleDialogPinParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Pinned) {
 		flag |= 1 << 0
@@ -6813,15 +6974,19 @@ func (e *MessagesToggleDialogPinParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesToggleDialogPin(params *MessagesToggleDialogPinParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesToggleDialogPin(ctx context.Context, params *MessagesToggleDialogPinParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesToggleDialogPinParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesToggleDialogPin")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -6839,9 +7004,6 @@ func (e *MessagesReorderPinnedDialogsParams) CRC() uint32 {
 }
 
 func (e *MessagesReorderPinnedDialogsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Force) {
 		flag |= 1 << 0
@@ -6854,15 +7016,19 @@ func (e *MessagesReorderPinnedDialogsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesReorderPinnedDialogs(params *MessagesReorderPinnedDialogsParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesReorderPinnedDialogs(ctx context.Context, params *MessagesReorderPinnedDialogsParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesReorderPinnedDialogsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesReorderPinnedDialogs")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -6877,24 +7043,25 @@ func (e *MessagesGetPinnedDialogsParams) CRC() uint32 {
 }
 
 func (e *MessagesGetPinnedDialogsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.FolderId)
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetPinnedDialogs(params *MessagesGetPinnedDialogsParams) (*MessagesPeerDialogs, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetPinnedDialogs(ctx context.Context, params *MessagesGetPinnedDialogsParams) (*MessagesPeerDialogs, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetPinnedDialogsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetPinnedDialogs")
 	}
 
 	resp, ok := data.(*MessagesPeerDialogs)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -6912,9 +7079,6 @@ func (e *MessagesSetBotShippingResultsParams) CRC() uint32 {
 }
 
 func (e *MessagesSetBotShippingResultsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Error) {
 		flag |= 1 << 0
@@ -6935,15 +7099,19 @@ func (e *MessagesSetBotShippingResultsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesSetBotShippingResults(params *MessagesSetBotShippingResultsParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesSetBotShippingResults(ctx context.Context, params *MessagesSetBotShippingResultsParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesSetBotShippingResultsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesSetBotShippingResults")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -6961,9 +7129,6 @@ func (e *MessagesSetBotPrecheckoutResultsParams) CRC() uint32 {
 }
 
 func (e *MessagesSetBotPrecheckoutResultsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Error) {
 		flag |= 1 << 0
@@ -6981,15 +7146,19 @@ func (e *MessagesSetBotPrecheckoutResultsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesSetBotPrecheckoutResults(params *MessagesSetBotPrecheckoutResultsParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesSetBotPrecheckoutResults(ctx context.Context, params *MessagesSetBotPrecheckoutResultsParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesSetBotPrecheckoutResultsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesSetBotPrecheckoutResults")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7005,9 +7174,6 @@ func (e *MessagesUploadMediaParams) CRC() uint32 {
 }
 
 func (e *MessagesUploadMediaParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -7015,15 +7181,19 @@ func (e *MessagesUploadMediaParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesUploadMedia(params *MessagesUploadMediaParams) (MessageMedia, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesUploadMedia(ctx context.Context, params *MessagesUploadMediaParams) (MessageMedia, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesUploadMediaParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesUploadMedia")
 	}
 
 	resp, ok := data.(MessageMedia)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7040,9 +7210,6 @@ func (e *MessagesSendScreenshotNotificationParams) CRC() uint32 {
 }
 
 func (e *MessagesSendScreenshotNotificationParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -7051,15 +7218,19 @@ func (e *MessagesSendScreenshotNotificationParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesSendScreenshotNotification(params *MessagesSendScreenshotNotificationParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
-	if err != nil {
+func (c *Client) MessagesSendScreenshotNotification(ctx context.Context, params *MessagesSendScreenshotNotificationParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesSendScreenshotNotificationParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
+	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesSendScreenshotNotification")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7074,24 +7245,25 @@ func (e *MessagesGetFavedStickersParams) CRC() uint32 {
 }
 
 func (e *MessagesGetFavedStickersParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Hash)
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetFavedStickers(params *MessagesGetFavedStickersParams) (MessagesFavedStickers, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetFavedStickers(ctx context.Context, params *MessagesGetFavedStickersParams) (MessagesFavedStickers, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetFavedStickersParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetFavedStickers")
 	}
 
 	resp, ok := data.(MessagesFavedStickers)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7107,9 +7279,6 @@ func (e *MessagesFaveStickerParams) CRC() uint32 {
 }
 
 func (e *MessagesFaveStickerParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Id.Encode())
@@ -7117,15 +7286,19 @@ func (e *MessagesFaveStickerParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesFaveSticker(params *MessagesFaveStickerParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesFaveSticker(ctx context.Context, params *MessagesFaveStickerParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesFaveStickerParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesFaveSticker")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7145,9 +7318,6 @@ func (e *MessagesGetUnreadMentionsParams) CRC() uint32 {
 }
 
 func (e *MessagesGetUnreadMentionsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -7159,15 +7329,19 @@ func (e *MessagesGetUnreadMentionsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetUnreadMentions(params *MessagesGetUnreadMentionsParams) (MessagesMessages, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetUnreadMentions(ctx context.Context, params *MessagesGetUnreadMentionsParams) (MessagesMessages, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetUnreadMentionsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetUnreadMentions")
 	}
 
 	resp, ok := data.(MessagesMessages)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7182,24 +7356,25 @@ func (e *MessagesReadMentionsParams) CRC() uint32 {
 }
 
 func (e *MessagesReadMentionsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
 	return buf.Result()
 }
 
-func (c *Client) MessagesReadMentions(params *MessagesReadMentionsParams) (*MessagesAffectedHistory, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesReadMentions(ctx context.Context, params *MessagesReadMentionsParams) (*MessagesAffectedHistory, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesReadMentionsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesReadMentions")
 	}
 
 	resp, ok := data.(*MessagesAffectedHistory)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7216,9 +7391,6 @@ func (e *MessagesGetRecentLocationsParams) CRC() uint32 {
 }
 
 func (e *MessagesGetRecentLocationsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -7227,15 +7399,19 @@ func (e *MessagesGetRecentLocationsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetRecentLocations(params *MessagesGetRecentLocationsParams) (MessagesMessages, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetRecentLocations(ctx context.Context, params *MessagesGetRecentLocationsParams) (MessagesMessages, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetRecentLocationsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetRecentLocations")
 	}
 
 	resp, ok := data.(MessagesMessages)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7257,9 +7433,6 @@ func (e *MessagesSendMultiMediaParams) CRC() uint32 {
 }
 
 func (e *MessagesSendMultiMediaParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ReplyToMsgId) {
 		flag |= 1 << 0
@@ -7290,15 +7463,19 @@ func (e *MessagesSendMultiMediaParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesSendMultiMedia(params *MessagesSendMultiMediaParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesSendMultiMedia(ctx context.Context, params *MessagesSendMultiMediaParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesSendMultiMediaParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesSendMultiMedia")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7314,9 +7491,6 @@ func (e *MessagesUploadEncryptedFileParams) CRC() uint32 {
 }
 
 func (e *MessagesUploadEncryptedFileParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -7324,15 +7498,19 @@ func (e *MessagesUploadEncryptedFileParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesUploadEncryptedFile(params *MessagesUploadEncryptedFileParams) (EncryptedFile, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesUploadEncryptedFile(ctx context.Context, params *MessagesUploadEncryptedFileParams) (EncryptedFile, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesUploadEncryptedFileParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesUploadEncryptedFile")
 	}
 
 	resp, ok := data.(EncryptedFile)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7350,9 +7528,6 @@ func (e *MessagesSearchStickerSetsParams) CRC() uint32 {
 }
 
 func (e *MessagesSearchStickerSetsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ExcludeFeatured) {
 		flag |= 1 << 0
@@ -7365,15 +7540,19 @@ func (e *MessagesSearchStickerSetsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesSearchStickerSets(params *MessagesSearchStickerSetsParams) (MessagesFoundStickerSets, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesSearchStickerSets(ctx context.Context, params *MessagesSearchStickerSetsParams) (MessagesFoundStickerSets, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesSearchStickerSetsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesSearchStickerSets")
 	}
 
 	resp, ok := data.(MessagesFoundStickerSets)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7391,15 +7570,15 @@ func (e *MessagesGetSplitRangesParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetSplitRanges() (*MessageRange, error) {
-	data, err := c.MakeRequest(&MessagesGetSplitRangesParams{})
+func (c *Client) MessagesGetSplitRanges(ctx context.Context) (*MessageRange, error) {
+	data, err := c.MakeRequestContext(ctx, &MessagesGetSplitRangesParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetSplitRanges")
 	}
 
 	resp, ok := data.(*MessageRange)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7416,9 +7595,6 @@ func (e *MessagesMarkDialogUnreadParams) CRC() uint32 {
 }
 
 func (e *MessagesMarkDialogUnreadParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Unread) {
 		flag |= 1 << 0
@@ -7430,15 +7606,19 @@ func (e *MessagesMarkDialogUnreadParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesMarkDialogUnread(params *MessagesMarkDialogUnreadParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesMarkDialogUnread(ctx context.Context, params *MessagesMarkDialogUnreadParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesMarkDialogUnreadParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesMarkDialogUnread")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7456,15 +7636,15 @@ func (e *MessagesGetDialogUnreadMarksParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetDialogUnreadMarks() (DialogPeer, error) {
-	data, err := c.MakeRequest(&MessagesGetDialogUnreadMarksParams{})
+func (c *Client) MessagesGetDialogUnreadMarks(ctx context.Context) (DialogPeer, error) {
+	data, err := c.MakeRequestContext(ctx, &MessagesGetDialogUnreadMarksParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetDialogUnreadMarks")
 	}
 
 	resp, ok := data.(DialogPeer)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7482,15 +7662,15 @@ func (e *MessagesClearAllDraftsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesClearAllDrafts() (*serialize.Bool, error) {
-	data, err := c.MakeRequest(&MessagesClearAllDraftsParams{})
+func (c *Client) MessagesClearAllDrafts(ctx context.Context) (*serialize.Bool, error) {
+	data, err := c.MakeRequestContext(ctx, &MessagesClearAllDraftsParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesClearAllDrafts")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7508,9 +7688,6 @@ func (e *MessagesUpdatePinnedMessageParams) CRC() uint32 {
 }
 
 func (e *MessagesUpdatePinnedMessageParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Silent) {
 		flag |= 1 << 0
@@ -7523,15 +7700,19 @@ func (e *MessagesUpdatePinnedMessageParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesUpdatePinnedMessage(params *MessagesUpdatePinnedMessageParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesUpdatePinnedMessage(ctx context.Context, params *MessagesUpdatePinnedMessageParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesUpdatePinnedMessageParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesUpdatePinnedMessage")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7548,9 +7729,6 @@ func (e *MessagesSendVoteParams) CRC() uint32 {
 }
 
 func (e *MessagesSendVoteParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -7559,15 +7737,19 @@ func (e *MessagesSendVoteParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesSendVote(params *MessagesSendVoteParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesSendVote(ctx context.Context, params *MessagesSendVoteParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesSendVoteParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesSendVote")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7583,9 +7765,6 @@ func (e *MessagesGetPollResultsParams) CRC() uint32 {
 }
 
 func (e *MessagesGetPollResultsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -7593,15 +7772,19 @@ func (e *MessagesGetPollResultsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetPollResults(params *MessagesGetPollResultsParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetPollResults(ctx context.Context, params *MessagesGetPollResultsParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetPollResultsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetPollResults")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7616,24 +7799,25 @@ func (e *MessagesGetOnlinesParams) CRC() uint32 {
 }
 
 func (e *MessagesGetOnlinesParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetOnlines(params *MessagesGetOnlinesParams) (*ChatOnlines, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetOnlines(ctx context.Context, params *MessagesGetOnlinesParams) (*ChatOnlines, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetOnlinesParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetOnlines")
 	}
 
 	resp, ok := data.(*ChatOnlines)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7651,9 +7835,6 @@ func (e *MessagesGetStatsURLParams) CRC() uint32 {
 }
 
 func (e *MessagesGetStatsURLParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Dark) {
 		flag |= 1 << 0
@@ -7666,15 +7847,19 @@ func (e *MessagesGetStatsURLParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetStatsURL(params *MessagesGetStatsURLParams) (*StatsURL, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetStatsURL(ctx context.Context, params *MessagesGetStatsURLParams) (*StatsURL, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetStatsURLParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetStatsURL")
 	}
 
 	resp, ok := data.(*StatsURL)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7690,9 +7875,6 @@ func (e *MessagesEditChatAboutParams) CRC() uint32 {
 }
 
 func (e *MessagesEditChatAboutParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -7700,15 +7882,19 @@ func (e *MessagesEditChatAboutParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesEditChatAbout(params *MessagesEditChatAboutParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesEditChatAbout(ctx context.Context, params *MessagesEditChatAboutParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesEditChatAboutParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesEditChatAbout")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7724,9 +7910,6 @@ func (e *MessagesEditChatDefaultBannedRightsParams) CRC() uint32 {
 }
 
 func (e *MessagesEditChatDefaultBannedRightsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -7734,15 +7917,19 @@ func (e *MessagesEditChatDefaultBannedRightsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesEditChatDefaultBannedRights(params *MessagesEditChatDefaultBannedRightsParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesEditChatDefaultBannedRights(ctx context.Context, params *MessagesEditChatDefaultBannedRightsParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesEditChatDefaultBannedRightsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesEditChatDefaultBannedRights")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7757,24 +7944,25 @@ func (e *MessagesGetEmojiKeywordsParams) CRC() uint32 {
 }
 
 func (e *MessagesGetEmojiKeywordsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.LangCode)
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetEmojiKeywords(params *MessagesGetEmojiKeywordsParams) (*EmojiKeywordsDifference, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetEmojiKeywords(ctx context.Context, params *MessagesGetEmojiKeywordsParams) (*EmojiKeywordsDifference, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetEmojiKeywordsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetEmojiKeywords")
 	}
 
 	resp, ok := data.(*EmojiKeywordsDifference)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7790,9 +7978,6 @@ func (e *MessagesGetEmojiKeywordsDifferenceParams) CRC() uint32 {
 }
 
 func (e *MessagesGetEmojiKeywordsDifferenceParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.LangCode)
@@ -7800,15 +7985,19 @@ func (e *MessagesGetEmojiKeywordsDifferenceParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetEmojiKeywordsDifference(params *MessagesGetEmojiKeywordsDifferenceParams) (*EmojiKeywordsDifference, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetEmojiKeywordsDifference(ctx context.Context, params *MessagesGetEmojiKeywordsDifferenceParams) (*EmojiKeywordsDifference, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetEmojiKeywordsDifferenceParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetEmojiKeywordsDifference")
 	}
 
 	resp, ok := data.(*EmojiKeywordsDifference)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7823,24 +8012,25 @@ func (e *MessagesGetEmojiKeywordsLanguagesParams) CRC() uint32 {
 }
 
 func (e *MessagesGetEmojiKeywordsLanguagesParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.LangCodes)
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetEmojiKeywordsLanguages(params *MessagesGetEmojiKeywordsLanguagesParams) (*EmojiLanguage, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetEmojiKeywordsLanguages(ctx context.Context, params *MessagesGetEmojiKeywordsLanguagesParams) (*EmojiLanguage, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetEmojiKeywordsLanguagesParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetEmojiKeywordsLanguages")
 	}
 
 	resp, ok := data.(*EmojiLanguage)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7855,24 +8045,25 @@ func (e *MessagesGetEmojiURLParams) CRC() uint32 {
 }
 
 func (e *MessagesGetEmojiURLParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.LangCode)
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetEmojiURL(params *MessagesGetEmojiURLParams) (*EmojiURL, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetEmojiURL(ctx context.Context, params *MessagesGetEmojiURLParams) (*EmojiURL, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetEmojiURLParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetEmojiURL")
 	}
 
 	resp, ok := data.(*EmojiURL)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7888,9 +8079,6 @@ func (e *MessagesGetSearchCountersParams) CRC() uint32 {
 }
 
 func (e *MessagesGetSearchCountersParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -7898,15 +8086,19 @@ func (e *MessagesGetSearchCountersParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetSearchCounters(params *MessagesGetSearchCountersParams) (*MessagesSearchCounter, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetSearchCounters(ctx context.Context, params *MessagesGetSearchCountersParams) (*MessagesSearchCounter, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetSearchCountersParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetSearchCounters")
 	}
 
 	resp, ok := data.(*MessagesSearchCounter)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7923,9 +8115,6 @@ func (e *MessagesRequestUrlAuthParams) CRC() uint32 {
 }
 
 func (e *MessagesRequestUrlAuthParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -7934,15 +8123,19 @@ func (e *MessagesRequestUrlAuthParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesRequestUrlAuth(params *MessagesRequestUrlAuthParams) (UrlAuthResult, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesRequestUrlAuth(ctx context.Context, params *MessagesRequestUrlAuthParams) (UrlAuthResult, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesRequestUrlAuthParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesRequestUrlAuth")
 	}
 
 	resp, ok := data.(UrlAuthResult)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -7961,9 +8154,6 @@ func (e *MessagesAcceptUrlAuthParams) CRC() uint32 {
 }
 
 func (e *MessagesAcceptUrlAuthParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.WriteAllowed) {
 		flag |= 1 << 0
@@ -7977,15 +8167,19 @@ func (e *MessagesAcceptUrlAuthParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesAcceptUrlAuth(params *MessagesAcceptUrlAuthParams) (UrlAuthResult, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesAcceptUrlAuth(ctx context.Context, params *MessagesAcceptUrlAuthParams) (UrlAuthResult, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesAcceptUrlAuthParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesAcceptUrlAuth")
 	}
 
 	resp, ok := data.(UrlAuthResult)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8000,24 +8194,25 @@ func (e *MessagesHidePeerSettingsBarParams) CRC() uint32 {
 }
 
 func (e *MessagesHidePeerSettingsBarParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
 	return buf.Result()
 }
 
-func (c *Client) MessagesHidePeerSettingsBar(params *MessagesHidePeerSettingsBarParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesHidePeerSettingsBar(ctx context.Context, params *MessagesHidePeerSettingsBarParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesHidePeerSettingsBarParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesHidePeerSettingsBar")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8033,9 +8228,6 @@ func (e *MessagesGetScheduledHistoryParams) CRC() uint32 {
 }
 
 func (e *MessagesGetScheduledHistoryParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -8043,15 +8235,19 @@ func (e *MessagesGetScheduledHistoryParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetScheduledHistory(params *MessagesGetScheduledHistoryParams) (MessagesMessages, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetScheduledHistory(ctx context.Context, params *MessagesGetScheduledHistoryParams) (MessagesMessages, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetScheduledHistoryParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetScheduledHistory")
 	}
 
 	resp, ok := data.(MessagesMessages)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8067,9 +8263,6 @@ func (e *MessagesGetScheduledMessagesParams) CRC() uint32 {
 }
 
 func (e *MessagesGetScheduledMessagesParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -8077,15 +8270,19 @@ func (e *MessagesGetScheduledMessagesParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetScheduledMessages(params *MessagesGetScheduledMessagesParams) (MessagesMessages, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetScheduledMessages(ctx context.Context, params *MessagesGetScheduledMessagesParams) (MessagesMessages, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetScheduledMessagesParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetScheduledMessages")
 	}
 
 	resp, ok := data.(MessagesMessages)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8101,9 +8298,6 @@ func (e *MessagesSendScheduledMessagesParams) CRC() uint32 {
 }
 
 func (e *MessagesSendScheduledMessagesParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -8111,15 +8305,19 @@ func (e *MessagesSendScheduledMessagesParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesSendScheduledMessages(params *MessagesSendScheduledMessagesParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesSendScheduledMessages(ctx context.Context, params *MessagesSendScheduledMessagesParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesSendScheduledMessagesParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesSendScheduledMessages")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8135,9 +8333,6 @@ func (e *MessagesDeleteScheduledMessagesParams) CRC() uint32 {
 }
 
 func (e *MessagesDeleteScheduledMessagesParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -8145,15 +8340,19 @@ func (e *MessagesDeleteScheduledMessagesParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesDeleteScheduledMessages(params *MessagesDeleteScheduledMessagesParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesDeleteScheduledMessages(ctx context.Context, params *MessagesDeleteScheduledMessagesParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesDeleteScheduledMessagesParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesDeleteScheduledMessages")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8173,9 +8372,6 @@ func (e *MessagesGetPollVotesParams) CRC() uint32 {
 }
 
 func (e *MessagesGetPollVotesParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Option) {
 		flag |= 1 << 0
@@ -8198,15 +8394,19 @@ func (e *MessagesGetPollVotesParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetPollVotes(params *MessagesGetPollVotesParams) (*MessagesVotesList, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetPollVotes(ctx context.Context, params *MessagesGetPollVotesParams) (*MessagesVotesList, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetPollVotesParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetPollVotes")
 	}
 
 	resp, ok := data.(*MessagesVotesList)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8225,9 +8425,6 @@ func (e *MessagesToggleStickerSetsParams) CRC() uint32 {
 }
 
 func (e *MessagesToggleStickerSetsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Uninstall) {
 		flag |= 1 << 0
@@ -8245,15 +8442,19 @@ func (e *MessagesToggleStickerSetsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesToggleStickerSets(params *MessagesToggleStickerSetsParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesToggleStickerSets(ctx context.Context, params *MessagesToggleStickerSetsParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesToggleStickerSetsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesToggleStickerSets")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8271,15 +8472,15 @@ func (e *MessagesGetDialogFiltersParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetDialogFilters() (*DialogFilter, error) {
-	data, err := c.MakeRequest(&MessagesGetDialogFiltersParams{})
+func (c *Client) MessagesGetDialogFilters(ctx context.Context) (*DialogFilter, error) {
+	data, err := c.MakeRequestContext(ctx, &MessagesGetDialogFiltersParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetDialogFilters")
 	}
 
 	resp, ok := data.(*DialogFilter)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8297,15 +8498,15 @@ func (e *MessagesGetSuggestedDialogFiltersParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetSuggestedDialogFilters() (*DialogFilterSuggested, error) {
-	data, err := c.MakeRequest(&MessagesGetSuggestedDialogFiltersParams{})
+func (c *Client) MessagesGetSuggestedDialogFilters(ctx context.Context) (*DialogFilterSuggested, error) {
+	data, err := c.MakeRequestContext(ctx, &MessagesGetSuggestedDialogFiltersParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetSuggestedDialogFilters")
 	}
 
 	resp, ok := data.(*DialogFilterSuggested)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8322,9 +8523,6 @@ func (e *MessagesUpdateDialogFilterParams) CRC() uint32 {
 }
 
 func (e *MessagesUpdateDialogFilterParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Filter) {
 		flag |= 1 << 0
@@ -8339,15 +8537,19 @@ func (e *MessagesUpdateDialogFilterParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesUpdateDialogFilter(params *MessagesUpdateDialogFilterParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesUpdateDialogFilter(ctx context.Context, params *MessagesUpdateDialogFilterParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesUpdateDialogFilterParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesUpdateDialogFilter")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8362,24 +8564,25 @@ func (e *MessagesUpdateDialogFiltersOrderParams) CRC() uint32 {
 }
 
 func (e *MessagesUpdateDialogFiltersOrderParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Order)
 	return buf.Result()
 }
 
-func (c *Client) MessagesUpdateDialogFiltersOrder(params *MessagesUpdateDialogFiltersOrderParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesUpdateDialogFiltersOrder(ctx context.Context, params *MessagesUpdateDialogFiltersOrderParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesUpdateDialogFiltersOrderParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesUpdateDialogFiltersOrder")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8396,9 +8599,6 @@ func (e *MessagesGetOldFeaturedStickersParams) CRC() uint32 {
 }
 
 func (e *MessagesGetOldFeaturedStickersParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Offset)
@@ -8407,15 +8607,19 @@ func (e *MessagesGetOldFeaturedStickersParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) MessagesGetOldFeaturedStickers(params *MessagesGetOldFeaturedStickersParams) (MessagesFeaturedStickers, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) MessagesGetOldFeaturedStickers(ctx context.Context, params *MessagesGetOldFeaturedStickersParams) (MessagesFeaturedStickers, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating MessagesGetOldFeaturedStickersParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning MessagesGetOldFeaturedStickers")
 	}
 
 	resp, ok := data.(MessagesFeaturedStickers)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8433,15 +8637,15 @@ func (e *UpdatesGetStateParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) UpdatesGetState() (*UpdatesState, error) {
-	data, err := c.MakeRequest(&UpdatesGetStateParams{})
+func (c *Client) UpdatesGetState(ctx context.Context) (*UpdatesState, error) {
+	data, err := c.MakeRequestContext(ctx, &UpdatesGetStateParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning UpdatesGetState")
 	}
 
 	resp, ok := data.(*UpdatesState)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8460,9 +8664,6 @@ func (e *UpdatesGetDifferenceParams) CRC() uint32 {
 }
 
 func (e *UpdatesGetDifferenceParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.PtsTotalLimit) {
 		flag |= 1 << 0
@@ -8479,15 +8680,19 @@ func (e *UpdatesGetDifferenceParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) UpdatesGetDifference(params *UpdatesGetDifferenceParams) (UpdatesDifference, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) UpdatesGetDifference(ctx context.Context, params *UpdatesGetDifferenceParams) (UpdatesDifference, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating UpdatesGetDifferenceParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning UpdatesGetDifference")
 	}
 
 	resp, ok := data.(UpdatesDifference)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8507,9 +8712,6 @@ func (e *UpdatesGetChannelDifferenceParams) CRC() uint32 {
 }
 
 func (e *UpdatesGetChannelDifferenceParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Force) {
 		flag |= 1 << 0
@@ -8524,15 +8726,19 @@ func (e *UpdatesGetChannelDifferenceParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) UpdatesGetChannelDifference(params *UpdatesGetChannelDifferenceParams) (UpdatesChannelDifference, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) UpdatesGetChannelDifference(ctx context.Context, params *UpdatesGetChannelDifferenceParams) (UpdatesChannelDifference, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating UpdatesGetChannelDifferenceParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning UpdatesGetChannelDifference")
 	}
 
 	resp, ok := data.(UpdatesChannelDifference)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8547,24 +8753,25 @@ func (e *PhotosUpdateProfilePhotoParams) CRC() uint32 {
 }
 
 func (e *PhotosUpdateProfilePhotoParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Id.Encode())
 	return buf.Result()
 }
 
-func (c *Client) PhotosUpdateProfilePhoto(params *PhotosUpdateProfilePhotoParams) (*PhotosPhoto, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) PhotosUpdateProfilePhoto(ctx context.Context, params *PhotosUpdateProfilePhotoParams) (*PhotosPhoto, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating PhotosUpdateProfilePhotoParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning PhotosUpdateProfilePhoto")
 	}
 
 	resp, ok := data.(*PhotosPhoto)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8582,9 +8789,6 @@ func (e *PhotosUploadProfilePhotoParams) CRC() uint32 {
 }
 
 func (e *PhotosUploadProfilePhotoParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.File) {
 		flag |= 1 << 0
@@ -8610,15 +8814,19 @@ func (e *PhotosUploadProfilePhotoParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) PhotosUploadProfilePhoto(params *PhotosUploadProfilePhotoParams) (*PhotosPhoto, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) PhotosUploadProfilePhoto(ctx context.Context, params *PhotosUploadProfilePhotoParams) (*PhotosPhoto, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating PhotosUploadProfilePhotoParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning PhotosUploadProfilePhoto")
 	}
 
 	resp, ok := data.(*PhotosPhoto)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8633,24 +8841,25 @@ func (e *PhotosDeletePhotosParams) CRC() uint32 {
 }
 
 func (e *PhotosDeletePhotosParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Id)
 	return buf.Result()
 }
 
-func (c *Client) PhotosDeletePhotos(params *PhotosDeletePhotosParams) (*serialize.Long, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) PhotosDeletePhotos(ctx context.Context, params *PhotosDeletePhotosParams) (*serialize.Long, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating PhotosDeletePhotosParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning PhotosDeletePhotos")
 	}
 
 	resp, ok := data.(*serialize.Long)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8668,9 +8877,6 @@ func (e *PhotosGetUserPhotosParams) CRC() uint32 {
 }
 
 func (e *PhotosGetUserPhotosParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.UserId.Encode())
@@ -8680,15 +8886,19 @@ func (e *PhotosGetUserPhotosParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) PhotosGetUserPhotos(params *PhotosGetUserPhotosParams) (PhotosPhotos, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) PhotosGetUserPhotos(ctx context.Context, params *PhotosGetUserPhotosParams) (PhotosPhotos, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating PhotosGetUserPhotosParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning PhotosGetUserPhotos")
 	}
 
 	resp, ok := data.(PhotosPhotos)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8705,9 +8915,6 @@ func (e *UploadSaveFilePartParams) CRC() uint32 {
 }
 
 func (e *UploadSaveFilePartParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.FileId)
@@ -8716,15 +8923,19 @@ func (e *UploadSaveFilePartParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) UploadSaveFilePart(params *UploadSaveFilePartParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) UploadSaveFilePart(ctx context.Context, params *UploadSaveFilePartParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating UploadSaveFilePartParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning UploadSaveFilePart")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8744,9 +8955,6 @@ func (e *UploadGetFileParams) CRC() uint32 {
 }
 
 func (e *UploadGetFileParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Precise) {
 		flag |= 1 << 0
@@ -8763,15 +8971,19 @@ func (e *UploadGetFileParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) UploadGetFile(params *UploadGetFileParams) (UploadFile, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) UploadGetFile(ctx context.Context, params *UploadGetFileParams) (UploadFile, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating UploadGetFileParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning UploadGetFile")
 	}
 
 	resp, ok := data.(UploadFile)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8789,9 +9001,6 @@ func (e *UploadSaveBigFilePartParams) CRC() uint32 {
 }
 
 func (e *UploadSaveBigFilePartParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.FileId)
@@ -8801,15 +9010,19 @@ func (e *UploadSaveBigFilePartParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) UploadSaveBigFilePart(params *UploadSaveBigFilePartParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) UploadSaveBigFilePart(ctx context.Context, params *UploadSaveBigFilePartParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating UploadSaveBigFilePartParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning UploadSaveBigFilePart")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8826,9 +9039,6 @@ func (e *UploadGetWebFileParams) CRC() uint32 {
 }
 
 func (e *UploadGetWebFileParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Location.Encode())
@@ -8837,15 +9047,19 @@ func (e *UploadGetWebFileParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) UploadGetWebFile(params *UploadGetWebFileParams) (*UploadWebFile, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) UploadGetWebFile(ctx context.Context, params *UploadGetWebFileParams) (*UploadWebFile, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating UploadGetWebFileParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning UploadGetWebFile")
 	}
 
 	resp, ok := data.(*UploadWebFile)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8862,9 +9076,6 @@ func (e *UploadGetCdnFileParams) CRC() uint32 {
 }
 
 func (e *UploadGetCdnFileParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutMessage(e.FileToken)
@@ -8873,15 +9084,19 @@ func (e *UploadGetCdnFileParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) UploadGetCdnFile(params *UploadGetCdnFileParams) (UploadCdnFile, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) UploadGetCdnFile(ctx context.Context, params *UploadGetCdnFileParams) (UploadCdnFile, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating UploadGetCdnFileParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning UploadGetCdnFile")
 	}
 
 	resp, ok := data.(UploadCdnFile)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8897,9 +9112,6 @@ func (e *UploadReuploadCdnFileParams) CRC() uint32 {
 }
 
 func (e *UploadReuploadCdnFileParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutMessage(e.FileToken)
@@ -8907,15 +9119,19 @@ func (e *UploadReuploadCdnFileParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) UploadReuploadCdnFile(params *UploadReuploadCdnFileParams) (*FileHash, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) UploadReuploadCdnFile(ctx context.Context, params *UploadReuploadCdnFileParams) (*FileHash, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating UploadReuploadCdnFileParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning UploadReuploadCdnFile")
 	}
 
 	resp, ok := data.(*FileHash)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8931,9 +9147,6 @@ func (e *UploadGetCdnFileHashesParams) CRC() uint32 {
 }
 
 func (e *UploadGetCdnFileHashesParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutMessage(e.FileToken)
@@ -8941,15 +9154,19 @@ func (e *UploadGetCdnFileHashesParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) UploadGetCdnFileHashes(params *UploadGetCdnFileHashesParams) (*FileHash, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) UploadGetCdnFileHashes(ctx context.Context, params *UploadGetCdnFileHashesParams) (*FileHash, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating UploadGetCdnFileHashesParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning UploadGetCdnFileHashes")
 	}
 
 	resp, ok := data.(*FileHash)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -8965,9 +9182,6 @@ func (e *UploadGetFileHashesParams) CRC() uint32 {
 }
 
 func (e *UploadGetFileHashesParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Location.Encode())
@@ -8975,15 +9189,19 @@ func (e *UploadGetFileHashesParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) UploadGetFileHashes(params *UploadGetFileHashesParams) (*FileHash, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) UploadGetFileHashes(ctx context.Context, params *UploadGetFileHashesParams) (*FileHash, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating UploadGetFileHashesParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning UploadGetFileHashes")
 	}
 
 	resp, ok := data.(*FileHash)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9001,15 +9219,15 @@ func (e *HelpGetConfigParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) HelpGetConfig() (*Config, error) {
-	data, err := c.MakeRequest(&HelpGetConfigParams{})
+func (c *Client) HelpGetConfig(ctx context.Context) (*Config, error) {
+	data, err := c.MakeRequestContext(ctx, &HelpGetConfigParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning HelpGetConfig")
 	}
 
 	resp, ok := data.(*Config)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9027,15 +9245,15 @@ func (e *HelpGetNearestDcParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) HelpGetNearestDc() (*NearestDc, error) {
-	data, err := c.MakeRequest(&HelpGetNearestDcParams{})
+func (c *Client) HelpGetNearestDc(ctx context.Context) (*NearestDc, error) {
+	data, err := c.MakeRequestContext(ctx, &HelpGetNearestDcParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning HelpGetNearestDc")
 	}
 
 	resp, ok := data.(*NearestDc)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9050,24 +9268,25 @@ func (e *HelpGetAppUpdateParams) CRC() uint32 {
 }
 
 func (e *HelpGetAppUpdateParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Source)
 	return buf.Result()
 }
 
-func (c *Client) HelpGetAppUpdate(params *HelpGetAppUpdateParams) (HelpAppUpdate, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) HelpGetAppUpdate(ctx context.Context, params *HelpGetAppUpdateParams) (HelpAppUpdate, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating HelpGetAppUpdateParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning HelpGetAppUpdate")
 	}
 
 	resp, ok := data.(HelpAppUpdate)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9085,15 +9304,15 @@ func (e *HelpGetInviteTextParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) HelpGetInviteText() (*HelpInviteText, error) {
-	data, err := c.MakeRequest(&HelpGetInviteTextParams{})
+func (c *Client) HelpGetInviteText(ctx context.Context) (*HelpInviteText, error) {
+	data, err := c.MakeRequestContext(ctx, &HelpGetInviteTextParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning HelpGetInviteText")
 	}
 
 	resp, ok := data.(*HelpInviteText)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9111,15 +9330,15 @@ func (e *HelpGetSupportParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) HelpGetSupport() (*HelpSupport, error) {
-	data, err := c.MakeRequest(&HelpGetSupportParams{})
+func (c *Client) HelpGetSupport(ctx context.Context) (*HelpSupport, error) {
+	data, err := c.MakeRequestContext(ctx, &HelpGetSupportParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning HelpGetSupport")
 	}
 
 	resp, ok := data.(*HelpSupport)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9134,24 +9353,25 @@ func (e *HelpGetAppChangelogParams) CRC() uint32 {
 }
 
 func (e *HelpGetAppChangelogParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.PrevAppVersion)
 	return buf.Result()
 }
 
-func (c *Client) HelpGetAppChangelog(params *HelpGetAppChangelogParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) HelpGetAppChangelog(ctx context.Context, params *HelpGetAppChangelogParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating HelpGetAppChangelogParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning HelpGetAppChangelog")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9167,9 +9387,6 @@ func (e *HelpSetBotUpdatesStatusParams) CRC() uint32 {
 }
 
 func (e *HelpSetBotUpdatesStatusParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.PendingUpdatesCount)
@@ -9177,15 +9394,19 @@ func (e *HelpSetBotUpdatesStatusParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) HelpSetBotUpdatesStatus(params *HelpSetBotUpdatesStatusParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) HelpSetBotUpdatesStatus(ctx context.Context, params *HelpSetBotUpdatesStatusParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating HelpSetBotUpdatesStatusParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning HelpSetBotUpdatesStatus")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9203,15 +9424,15 @@ func (e *HelpGetCdnConfigParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) HelpGetCdnConfig() (*CdnConfig, error) {
-	data, err := c.MakeRequest(&HelpGetCdnConfigParams{})
+func (c *Client) HelpGetCdnConfig(ctx context.Context) (*CdnConfig, error) {
+	data, err := c.MakeRequestContext(ctx, &HelpGetCdnConfigParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning HelpGetCdnConfig")
 	}
 
 	resp, ok := data.(*CdnConfig)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9226,24 +9447,25 @@ func (e *HelpGetRecentMeUrlsParams) CRC() uint32 {
 }
 
 func (e *HelpGetRecentMeUrlsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Referer)
 	return buf.Result()
 }
 
-func (c *Client) HelpGetRecentMeUrls(params *HelpGetRecentMeUrlsParams) (*HelpRecentMeUrls, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) HelpGetRecentMeUrls(ctx context.Context, params *HelpGetRecentMeUrlsParams) (*HelpRecentMeUrls, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating HelpGetRecentMeUrlsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning HelpGetRecentMeUrls")
 	}
 
 	resp, ok := data.(*HelpRecentMeUrls)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9261,15 +9483,15 @@ func (e *HelpGetTermsOfServiceUpdateParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) HelpGetTermsOfServiceUpdate() (HelpTermsOfServiceUpdate, error) {
-	data, err := c.MakeRequest(&HelpGetTermsOfServiceUpdateParams{})
+func (c *Client) HelpGetTermsOfServiceUpdate(ctx context.Context) (HelpTermsOfServiceUpdate, error) {
+	data, err := c.MakeRequestContext(ctx, &HelpGetTermsOfServiceUpdateParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning HelpGetTermsOfServiceUpdate")
 	}
 
 	resp, ok := data.(HelpTermsOfServiceUpdate)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9284,24 +9506,25 @@ func (e *HelpAcceptTermsOfServiceParams) CRC() uint32 {
 }
 
 func (e *HelpAcceptTermsOfServiceParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Id.Encode())
 	return buf.Result()
 }
 
-func (c *Client) HelpAcceptTermsOfService(params *HelpAcceptTermsOfServiceParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) HelpAcceptTermsOfService(ctx context.Context, params *HelpAcceptTermsOfServiceParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating HelpAcceptTermsOfServiceParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning HelpAcceptTermsOfService")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9316,24 +9539,25 @@ func (e *HelpGetDeepLinkInfoParams) CRC() uint32 {
 }
 
 func (e *HelpGetDeepLinkInfoParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Path)
 	return buf.Result()
 }
 
-func (c *Client) HelpGetDeepLinkInfo(params *HelpGetDeepLinkInfoParams) (HelpDeepLinkInfo, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) HelpGetDeepLinkInfo(ctx context.Context, params *HelpGetDeepLinkInfoParams) (HelpDeepLinkInfo, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating HelpGetDeepLinkInfoParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning HelpGetDeepLinkInfo")
 	}
 
 	resp, ok := data.(HelpDeepLinkInfo)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9351,15 +9575,15 @@ func (e *HelpGetAppConfigParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) HelpGetAppConfig() (JSONValue, error) {
-	data, err := c.MakeRequest(&HelpGetAppConfigParams{})
+func (c *Client) HelpGetAppConfig(ctx context.Context) (JSONValue, error) {
+	data, err := c.MakeRequestContext(ctx, &HelpGetAppConfigParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning HelpGetAppConfig")
 	}
 
 	resp, ok := data.(JSONValue)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9374,24 +9598,25 @@ func (e *HelpSaveAppLogParams) CRC() uint32 {
 }
 
 func (e *HelpSaveAppLogParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Events)
 	return buf.Result()
 }
 
-func (c *Client) HelpSaveAppLog(params *HelpSaveAppLogParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) HelpSaveAppLog(ctx context.Context, params *HelpSaveAppLogParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating HelpSaveAppLogParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning HelpSaveAppLog")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9406,24 +9631,25 @@ func (e *HelpGetPassportConfigParams) CRC() uint32 {
 }
 
 func (e *HelpGetPassportConfigParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Hash)
 	return buf.Result()
 }
 
-func (c *Client) HelpGetPassportConfig(params *HelpGetPassportConfigParams) (HelpPassportConfig, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) HelpGetPassportConfig(ctx context.Context, params *HelpGetPassportConfigParams) (HelpPassportConfig, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating HelpGetPassportConfigParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning HelpGetPassportConfig")
 	}
 
 	resp, ok := data.(HelpPassportConfig)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9441,15 +9667,15 @@ func (e *HelpGetSupportNameParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) HelpGetSupportName() (*HelpSupportName, error) {
-	data, err := c.MakeRequest(&HelpGetSupportNameParams{})
+func (c *Client) HelpGetSupportName(ctx context.Context) (*HelpSupportName, error) {
+	data, err := c.MakeRequestContext(ctx, &HelpGetSupportNameParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning HelpGetSupportName")
 	}
 
 	resp, ok := data.(*HelpSupportName)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9464,24 +9690,25 @@ func (e *HelpGetUserInfoParams) CRC() uint32 {
 }
 
 func (e *HelpGetUserInfoParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.UserId.Encode())
 	return buf.Result()
 }
 
-func (c *Client) HelpGetUserInfo(params *HelpGetUserInfoParams) (HelpUserInfo, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) HelpGetUserInfo(ctx context.Context, params *HelpGetUserInfoParams) (HelpUserInfo, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating HelpGetUserInfoParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning HelpGetUserInfo")
 	}
 
 	resp, ok := data.(HelpUserInfo)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9498,9 +9725,6 @@ func (e *HelpEditUserInfoParams) CRC() uint32 {
 }
 
 func (e *HelpEditUserInfoParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.UserId.Encode())
@@ -9509,15 +9733,19 @@ func (e *HelpEditUserInfoParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) HelpEditUserInfo(params *HelpEditUserInfoParams) (HelpUserInfo, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) HelpEditUserInfo(ctx context.Context, params *HelpEditUserInfoParams) (HelpUserInfo, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating HelpEditUserInfoParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning HelpEditUserInfo")
 	}
 
 	resp, ok := data.(HelpUserInfo)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9535,15 +9763,15 @@ func (e *HelpGetPromoDataParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) HelpGetPromoData() (HelpPromoData, error) {
-	data, err := c.MakeRequest(&HelpGetPromoDataParams{})
+func (c *Client) HelpGetPromoData(ctx context.Context) (HelpPromoData, error) {
+	data, err := c.MakeRequestContext(ctx, &HelpGetPromoDataParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning HelpGetPromoData")
 	}
 
 	resp, ok := data.(HelpPromoData)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9558,24 +9786,25 @@ func (e *HelpHidePromoDataParams) CRC() uint32 {
 }
 
 func (e *HelpHidePromoDataParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
 	return buf.Result()
 }
 
-func (c *Client) HelpHidePromoData(params *HelpHidePromoDataParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) HelpHidePromoData(ctx context.Context, params *HelpHidePromoDataParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating HelpHidePromoDataParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning HelpHidePromoData")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9590,24 +9819,25 @@ func (e *HelpDismissSuggestionParams) CRC() uint32 {
 }
 
 func (e *HelpDismissSuggestionParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Suggestion)
 	return buf.Result()
 }
 
-func (c *Client) HelpDismissSuggestion(params *HelpDismissSuggestionParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) HelpDismissSuggestion(ctx context.Context, params *HelpDismissSuggestionParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating HelpDismissSuggestionParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning HelpDismissSuggestion")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9623,9 +9853,6 @@ func (e *ChannelsReadHistoryParams) CRC() uint32 {
 }
 
 func (e *ChannelsReadHistoryParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
@@ -9633,15 +9860,19 @@ func (e *ChannelsReadHistoryParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsReadHistory(params *ChannelsReadHistoryParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsReadHistory(ctx context.Context, params *ChannelsReadHistoryParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsReadHistoryParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsReadHistory")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9657,9 +9888,6 @@ func (e *ChannelsDeleteMessagesParams) CRC() uint32 {
 }
 
 func (e *ChannelsDeleteMessagesParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
@@ -9667,15 +9895,19 @@ func (e *ChannelsDeleteMessagesParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsDeleteMessages(params *ChannelsDeleteMessagesParams) (*MessagesAffectedMessages, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsDeleteMessages(ctx context.Context, params *ChannelsDeleteMessagesParams) (*MessagesAffectedMessages, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsDeleteMessagesParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsDeleteMessages")
 	}
 
 	resp, ok := data.(*MessagesAffectedMessages)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9691,9 +9923,6 @@ func (e *ChannelsDeleteUserHistoryParams) CRC() uint32 {
 }
 
 func (e *ChannelsDeleteUserHistoryParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
@@ -9701,15 +9930,19 @@ func (e *ChannelsDeleteUserHistoryParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsDeleteUserHistory(params *ChannelsDeleteUserHistoryParams) (*MessagesAffectedHistory, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsDeleteUserHistory(ctx context.Context, params *ChannelsDeleteUserHistoryParams) (*MessagesAffectedHistory, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsDeleteUserHistoryParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsDeleteUserHistory")
 	}
 
 	resp, ok := data.(*MessagesAffectedHistory)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9726,9 +9959,6 @@ func (e *ChannelsReportSpamParams) CRC() uint32 {
 }
 
 func (e *ChannelsReportSpamParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
@@ -9737,15 +9967,19 @@ func (e *ChannelsReportSpamParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsReportSpam(params *ChannelsReportSpamParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsReportSpam(ctx context.Context, params *ChannelsReportSpamParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsReportSpamParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsReportSpam")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9761,9 +9995,6 @@ func (e *ChannelsGetMessagesParams) CRC() uint32 {
 }
 
 func (e *ChannelsGetMessagesParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
@@ -9771,15 +10002,19 @@ func (e *ChannelsGetMessagesParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsGetMessages(params *ChannelsGetMessagesParams) (MessagesMessages, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsGetMessages(ctx context.Context, params *ChannelsGetMessagesParams) (MessagesMessages, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsGetMessagesParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsGetMessages")
 	}
 
 	resp, ok := data.(MessagesMessages)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9798,9 +10033,6 @@ func (e *ChannelsGetParticipantsParams) CRC() uint32 {
 }
 
 func (e *ChannelsGetParticipantsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
@@ -9811,15 +10043,19 @@ func (e *ChannelsGetParticipantsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsGetParticipants(params *ChannelsGetParticipantsParams) (ChannelsChannelParticipants, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsGetParticipants(ctx context.Context, params *ChannelsGetParticipantsParams) (ChannelsChannelParticipants, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsGetParticipantsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsGetParticipants")
 	}
 
 	resp, ok := data.(ChannelsChannelParticipants)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9835,9 +10071,6 @@ func (e *ChannelsGetParticipantParams) CRC() uint32 {
 }
 
 func (e *ChannelsGetParticipantParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
@@ -9845,15 +10078,19 @@ func (e *ChannelsGetParticipantParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsGetParticipant(params *ChannelsGetParticipantParams) (*ChannelsChannelParticipant, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsGetParticipant(ctx context.Context, params *ChannelsGetParticipantParams) (*ChannelsChannelParticipant, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsGetParticipantParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsGetParticipant")
 	}
 
 	resp, ok := data.(*ChannelsChannelParticipant)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9868,24 +10105,25 @@ func (e *ChannelsGetChannelsParams) CRC() uint32 {
 }
 
 func (e *ChannelsGetChannelsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Id)
 	return buf.Result()
 }
 
-func (c *Client) ChannelsGetChannels(params *ChannelsGetChannelsParams) (MessagesChats, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsGetChannels(ctx context.Context, params *ChannelsGetChannelsParams) (MessagesChats, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsGetChannelsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsGetChannels")
 	}
 
 	resp, ok := data.(MessagesChats)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9900,24 +10138,25 @@ func (e *ChannelsGetFullChannelParams) CRC() uint32 {
 }
 
 func (e *ChannelsGetFullChannelParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
 	return buf.Result()
 }
 
-func (c *Client) ChannelsGetFullChannel(params *ChannelsGetFullChannelParams) (*MessagesChatFull, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsGetFullChannel(ctx context.Context, params *ChannelsGetFullChannelParams) (*MessagesChatFull, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsGetFullChannelParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsGetFullChannel")
 	}
 
 	resp, ok := data.(*MessagesChatFull)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9938,9 +10177,6 @@ func (e *ChannelsCreateChannelParams) CRC() uint32 {
 }
 
 func (e *ChannelsCreateChannelParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Broadcast) {
 		flag |= 1 << 0
@@ -9965,15 +10201,19 @@ func (e *ChannelsCreateChannelParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsCreateChannel(params *ChannelsCreateChannelParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsCreateChannel(ctx context.Context, params *ChannelsCreateChannelParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsCreateChannelParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsCreateChannel")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -9991,9 +10231,6 @@ func (e *ChannelsEditAdminParams) CRC() uint32 {
 }
 
 func (e *ChannelsEditAdminParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
@@ -10003,15 +10240,19 @@ func (e *ChannelsEditAdminParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsEditAdmin(params *ChannelsEditAdminParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsEditAdmin(ctx context.Context, params *ChannelsEditAdminParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsEditAdminParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsEditAdmin")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10027,9 +10268,6 @@ func (e *ChannelsEditTitleParams) CRC() uint32 {
 }
 
 func (e *ChannelsEditTitleParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
@@ -10037,15 +10275,19 @@ func (e *ChannelsEditTitleParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsEditTitle(params *ChannelsEditTitleParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsEditTitle(ctx context.Context, params *ChannelsEditTitleParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsEditTitleParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsEditTitle")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10061,9 +10303,6 @@ func (e *ChannelsEditPhotoParams) CRC() uint32 {
 }
 
 func (e *ChannelsEditPhotoParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
@@ -10071,15 +10310,19 @@ func (e *ChannelsEditPhotoParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsEditPhoto(params *ChannelsEditPhotoParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsEditPhoto(ctx context.Context, params *ChannelsEditPhotoParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsEditPhotoParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsEditPhoto")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10095,9 +10338,6 @@ func (e *ChannelsCheckUsernameParams) CRC() uint32 {
 }
 
 func (e *ChannelsCheckUsernameParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
@@ -10105,15 +10345,19 @@ func (e *ChannelsCheckUsernameParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsCheckUsername(params *ChannelsCheckUsernameParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsCheckUsername(ctx context.Context, params *ChannelsCheckUsernameParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsCheckUsernameParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsCheckUsername")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10129,9 +10373,6 @@ func (e *ChannelsUpdateUsernameParams) CRC() uint32 {
 }
 
 func (e *ChannelsUpdateUsernameParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
@@ -10139,15 +10380,19 @@ func (e *ChannelsUpdateUsernameParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsUpdateUsername(params *ChannelsUpdateUsernameParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsUpdateUsername(ctx context.Context, params *ChannelsUpdateUsernameParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsUpdateUsernameParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsUpdateUsername")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10162,24 +10407,25 @@ func (e *ChannelsJoinChannelParams) CRC() uint32 {
 }
 
 func (e *ChannelsJoinChannelParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
 	return buf.Result()
 }
 
-func (c *Client) ChannelsJoinChannel(params *ChannelsJoinChannelParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsJoinChannel(ctx context.Context, params *ChannelsJoinChannelParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsJoinChannelParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsJoinChannel")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10194,24 +10440,25 @@ func (e *ChannelsLeaveChannelParams) CRC() uint32 {
 }
 
 func (e *ChannelsLeaveChannelParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
 	return buf.Result()
 }
 
-func (c *Client) ChannelsLeaveChannel(params *ChannelsLeaveChannelParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsLeaveChannel(ctx context.Context, params *ChannelsLeaveChannelParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsLeaveChannelParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsLeaveChannel")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10227,9 +10474,6 @@ func (e *ChannelsInviteToChannelParams) CRC() uint32 {
 }
 
 func (e *ChannelsInviteToChannelParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
@@ -10237,15 +10481,19 @@ func (e *ChannelsInviteToChannelParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsInviteToChannel(params *ChannelsInviteToChannelParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsInviteToChannel(ctx context.Context, params *ChannelsInviteToChannelParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsInviteToChannelParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsInviteToChannel")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10260,24 +10508,25 @@ func (e *ChannelsDeleteChannelParams) CRC() uint32 {
 }
 
 func (e *ChannelsDeleteChannelParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
 	return buf.Result()
 }
 
-func (c *Client) ChannelsDeleteChannel(params *ChannelsDeleteChannelParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsDeleteChannel(ctx context.Context, params *ChannelsDeleteChannelParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsDeleteChannelParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsDeleteChannel")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10294,9 +10543,6 @@ func (e *ChannelsExportMessageLinkParams) CRC() uint32 {
 }
 
 func (e *ChannelsExportMessageLinkParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
@@ -10305,15 +10551,19 @@ func (e *ChannelsExportMessageLinkParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsExportMessageLink(params *ChannelsExportMessageLinkParams) (*ExportedMessageLink, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsExportMessageLink(ctx context.Context, params *ChannelsExportMessageLinkParams) (*ExportedMessageLink, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsExportMessageLinkParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsExportMessageLink")
 	}
 
 	resp, ok := data.(*ExportedMessageLink)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10329,9 +10579,6 @@ func (e *ChannelsToggleSignaturesParams) CRC() uint32 {
 }
 
 func (e *ChannelsToggleSignaturesParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
@@ -10339,15 +10586,19 @@ func (e *ChannelsToggleSignaturesParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsToggleSignatures(params *ChannelsToggleSignaturesParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsToggleSignatures(ctx context.Context, params *ChannelsToggleSignaturesParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsToggleSignaturesParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsToggleSignatures")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10364,9 +10615,6 @@ func (e *ChannelsGetAdminedPublicChannelsParams) CRC() uint32 {
 }
 
 func (e *ChannelsGetAdminedPublicChannelsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ByLocation) {
 		flag |= 1 << 0
@@ -10380,15 +10628,19 @@ func (e *ChannelsGetAdminedPublicChannelsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsGetAdminedPublicChannels(params *ChannelsGetAdminedPublicChannelsParams) (MessagesChats, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsGetAdminedPublicChannels(ctx context.Context, params *ChannelsGetAdminedPublicChannelsParams) (MessagesChats, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsGetAdminedPublicChannelsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsGetAdminedPublicChannels")
 	}
 
 	resp, ok := data.(MessagesChats)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10405,9 +10657,6 @@ func (e *ChannelsEditBannedParams) CRC() uint32 {
 }
 
 func (e *ChannelsEditBannedParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
@@ -10416,15 +10665,19 @@ func (e *ChannelsEditBannedParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsEditBanned(params *ChannelsEditBannedParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsEditBanned(ctx context.Context, params *ChannelsEditBannedParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsEditBannedParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsEditBanned")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10446,9 +10699,6 @@ func (e *ChannelsGetAdminLogParams) CRC() uint32 {
 }
 
 func (e *ChannelsGetAdminLogParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.EventsFilter) {
 		flag |= 1 << 0
@@ -10473,15 +10723,19 @@ func (e *ChannelsGetAdminLogParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsGetAdminLog(params *ChannelsGetAdminLogParams) (*ChannelsAdminLogResults, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsGetAdminLog(ctx context.Context, params *ChannelsGetAdminLogParams) (*ChannelsAdminLogResults, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsGetAdminLogParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsGetAdminLog")
 	}
 
 	resp, ok := data.(*ChannelsAdminLogResults)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10497,9 +10751,6 @@ func (e *ChannelsSetStickersParams) CRC() uint32 {
 }
 
 func (e *ChannelsSetStickersParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
@@ -10507,15 +10758,19 @@ func (e *ChannelsSetStickersParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsSetStickers(params *ChannelsSetStickersParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsSetStickers(ctx context.Context, params *ChannelsSetStickersParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsSetStickersParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsSetStickers")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10531,9 +10786,6 @@ func (e *ChannelsReadMessageContentsParams) CRC() uint32 {
 }
 
 func (e *ChannelsReadMessageContentsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
@@ -10541,15 +10793,19 @@ func (e *ChannelsReadMessageContentsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsReadMessageContents(params *ChannelsReadMessageContentsParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsReadMessageContents(ctx context.Context, params *ChannelsReadMessageContentsParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsReadMessageContentsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsReadMessageContents")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10565,9 +10821,6 @@ func (e *ChannelsDeleteHistoryParams) CRC() uint32 {
 }
 
 func (e *ChannelsDeleteHistoryParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
@@ -10575,15 +10828,19 @@ func (e *ChannelsDeleteHistoryParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsDeleteHistory(params *ChannelsDeleteHistoryParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsDeleteHistory(ctx context.Context, params *ChannelsDeleteHistoryParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsDeleteHistoryParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsDeleteHistory")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10599,9 +10856,6 @@ func (e *ChannelsTogglePreHistoryHiddenParams) CRC() uint32 {
 }
 
 func (e *ChannelsTogglePreHistoryHiddenParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
@@ -10609,15 +10863,19 @@ func (e *ChannelsTogglePreHistoryHiddenParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsTogglePreHistoryHidden(params *ChannelsTogglePreHistoryHiddenParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsTogglePreHistoryHidden(ctx context.Context, params *ChannelsTogglePreHistoryHiddenParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsTogglePreHistoryHiddenParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsTogglePreHistoryHidden")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10632,24 +10890,25 @@ func (e *ChannelsGetLeftChannelsParams) CRC() uint32 {
 }
 
 func (e *ChannelsGetLeftChannelsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Offset)
 	return buf.Result()
 }
 
-func (c *Client) ChannelsGetLeftChannels(params *ChannelsGetLeftChannelsParams) (MessagesChats, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsGetLeftChannels(ctx context.Context, params *ChannelsGetLeftChannelsParams) (MessagesChats, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsGetLeftChannelsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsGetLeftChannels")
 	}
 
 	resp, ok := data.(MessagesChats)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10667,15 +10926,15 @@ func (e *ChannelsGetGroupsForDiscussionParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsGetGroupsForDiscussion() (MessagesChats, error) {
-	data, err := c.MakeRequest(&ChannelsGetGroupsForDiscussionParams{})
+func (c *Client) ChannelsGetGroupsForDiscussion(ctx context.Context) (MessagesChats, error) {
+	data, err := c.MakeRequestContext(ctx, &ChannelsGetGroupsForDiscussionParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsGetGroupsForDiscussion")
 	}
 
 	resp, ok := data.(MessagesChats)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10691,9 +10950,6 @@ func (e *ChannelsSetDiscussionGroupParams) CRC() uint32 {
 }
 
 func (e *ChannelsSetDiscussionGroupParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Broadcast.Encode())
@@ -10701,15 +10957,19 @@ func (e *ChannelsSetDiscussionGroupParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsSetDiscussionGroup(params *ChannelsSetDiscussionGroupParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsSetDiscussionGroup(ctx context.Context, params *ChannelsSetDiscussionGroupParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsSetDiscussionGroupParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsSetDiscussionGroup")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10726,9 +10986,6 @@ func (e *ChannelsEditCreatorParams) CRC() uint32 {
 }
 
 func (e *ChannelsEditCreatorParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
@@ -10737,15 +10994,19 @@ func (e *ChannelsEditCreatorParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsEditCreator(params *ChannelsEditCreatorParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsEditCreator(ctx context.Context, params *ChannelsEditCreatorParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsEditCreatorParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsEditCreator")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10762,9 +11023,6 @@ func (e *ChannelsEditLocationParams) CRC() uint32 {
 }
 
 func (e *ChannelsEditLocationParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
@@ -10773,15 +11031,19 @@ func (e *ChannelsEditLocationParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsEditLocation(params *ChannelsEditLocationParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsEditLocation(ctx context.Context, params *ChannelsEditLocationParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsEditLocationParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsEditLocation")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10797,9 +11059,6 @@ func (e *ChannelsToggleSlowModeParams) CRC() uint32 {
 }
 
 func (e *ChannelsToggleSlowModeParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Channel.Encode())
@@ -10807,15 +11066,19 @@ func (e *ChannelsToggleSlowModeParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsToggleSlowMode(params *ChannelsToggleSlowModeParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) ChannelsToggleSlowMode(ctx context.Context, params *ChannelsToggleSlowModeParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating ChannelsToggleSlowModeParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsToggleSlowMode")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10833,15 +11096,15 @@ func (e *ChannelsGetInactiveChannelsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) ChannelsGetInactiveChannels() (*MessagesInactiveChats, error) {
-	data, err := c.MakeRequest(&ChannelsGetInactiveChannelsParams{})
+func (c *Client) ChannelsGetInactiveChannels(ctx context.Context) (*MessagesInactiveChats, error) {
+	data, err := c.MakeRequestContext(ctx, &ChannelsGetInactiveChannelsParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning ChannelsGetInactiveChannels")
 	}
 
 	resp, ok := data.(*MessagesInactiveChats)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10857,9 +11120,6 @@ func (e *BotsSendCustomRequestParams) CRC() uint32 {
 }
 
 func (e *BotsSendCustomRequestParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.CustomMethod)
@@ -10867,15 +11127,19 @@ func (e *BotsSendCustomRequestParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) BotsSendCustomRequest(params *BotsSendCustomRequestParams) (*DataJSON, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) BotsSendCustomRequest(ctx context.Context, params *BotsSendCustomRequestParams) (*DataJSON, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating BotsSendCustomRequestParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning BotsSendCustomRequest")
 	}
 
 	resp, ok := data.(*DataJSON)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10891,9 +11155,6 @@ func (e *BotsAnswerWebhookJSONQueryParams) CRC() uint32 {
 }
 
 func (e *BotsAnswerWebhookJSONQueryParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.QueryId)
@@ -10901,15 +11162,19 @@ func (e *BotsAnswerWebhookJSONQueryParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) BotsAnswerWebhookJSONQuery(params *BotsAnswerWebhookJSONQueryParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) BotsAnswerWebhookJSONQuery(ctx context.Context, params *BotsAnswerWebhookJSONQueryParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating BotsAnswerWebhookJSONQueryParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning BotsAnswerWebhookJSONQuery")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10924,24 +11189,25 @@ func (e *BotsSetBotCommandsParams) CRC() uint32 {
 }
 
 func (e *BotsSetBotCommandsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Commands)
 	return buf.Result()
 }
 
-func (c *Client) BotsSetBotCommands(params *BotsSetBotCommandsParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) BotsSetBotCommands(ctx context.Context, params *BotsSetBotCommandsParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating BotsSetBotCommandsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning BotsSetBotCommands")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10956,24 +11222,25 @@ func (e *PaymentsGetPaymentFormParams) CRC() uint32 {
 }
 
 func (e *PaymentsGetPaymentFormParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.MsgId)
 	return buf.Result()
 }
 
-func (c *Client) PaymentsGetPaymentForm(params *PaymentsGetPaymentFormParams) (*PaymentsPaymentForm, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) PaymentsGetPaymentForm(ctx context.Context, params *PaymentsGetPaymentFormParams) (*PaymentsPaymentForm, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating PaymentsGetPaymentFormParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning PaymentsGetPaymentForm")
 	}
 
 	resp, ok := data.(*PaymentsPaymentForm)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -10988,24 +11255,25 @@ func (e *PaymentsGetPaymentReceiptParams) CRC() uint32 {
 }
 
 func (e *PaymentsGetPaymentReceiptParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.MsgId)
 	return buf.Result()
 }
 
-func (c *Client) PaymentsGetPaymentReceipt(params *PaymentsGetPaymentReceiptParams) (*PaymentsPaymentReceipt, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) PaymentsGetPaymentReceipt(ctx context.Context, params *PaymentsGetPaymentReceiptParams) (*PaymentsPaymentReceipt, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating PaymentsGetPaymentReceiptParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning PaymentsGetPaymentReceipt")
 	}
 
 	resp, ok := data.(*PaymentsPaymentReceipt)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11023,9 +11291,6 @@ func (e *PaymentsValidateRequestedInfoParams) CRC() uint32 {
 }
 
 func (e *PaymentsValidateRequestedInfoParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Save) {
 		flag |= 1 << 0
@@ -11038,15 +11303,19 @@ func (e *PaymentsValidateRequestedInfoParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) PaymentsValidateRequestedInfo(params *PaymentsValidateRequestedInfoParams) (*PaymentsValidatedRequestedInfo, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) PaymentsValidateRequestedInfo(ctx context.Context, params *PaymentsValidateRequestedInfoParams) (*PaymentsValidatedRequestedInfo, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating PaymentsValidateRequestedInfoParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning PaymentsValidateRequestedInfo")
 	}
 
 	resp, ok := data.(*PaymentsValidatedRequestedInfo)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11065,9 +11334,6 @@ func (e *PaymentsSendPaymentFormParams) CRC() uint32 {
 }
 
 func (e *PaymentsSendPaymentFormParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.RequestedInfoId) {
 		flag |= 1 << 0
@@ -11089,15 +11355,19 @@ func (e *PaymentsSendPaymentFormParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) PaymentsSendPaymentForm(params *PaymentsSendPaymentFormParams) (PaymentsPaymentResult, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) PaymentsSendPaymentForm(ctx context.Context, params *PaymentsSendPaymentFormParams) (PaymentsPaymentResult, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating PaymentsSendPaymentFormParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning PaymentsSendPaymentForm")
 	}
 
 	resp, ok := data.(PaymentsPaymentResult)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11115,15 +11385,15 @@ func (e *PaymentsGetSavedInfoParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) PaymentsGetSavedInfo() (*PaymentsSavedInfo, error) {
-	data, err := c.MakeRequest(&PaymentsGetSavedInfoParams{})
+func (c *Client) PaymentsGetSavedInfo(ctx context.Context) (*PaymentsSavedInfo, error) {
+	data, err := c.MakeRequestContext(ctx, &PaymentsGetSavedInfoParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning PaymentsGetSavedInfo")
 	}
 
 	resp, ok := data.(*PaymentsSavedInfo)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11140,9 +11410,6 @@ func (e *PaymentsClearSavedInfoParams) CRC() uint32 {
 }
 
 func (e *PaymentsClearSavedInfoParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Credentials) {
 		flag |= 1 << 0
@@ -11156,15 +11423,19 @@ func (e *PaymentsClearSavedInfoParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) PaymentsClearSavedInfo(params *PaymentsClearSavedInfoParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) PaymentsClearSavedInfo(ctx context.Context, params *PaymentsClearSavedInfoParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating PaymentsClearSavedInfoParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning PaymentsClearSavedInfo")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11179,24 +11450,25 @@ func (e *PaymentsGetBankCardDataParams) CRC() uint32 {
 }
 
 func (e *PaymentsGetBankCardDataParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Number)
 	return buf.Result()
 }
 
-func (c *Client) PaymentsGetBankCardData(params *PaymentsGetBankCardDataParams) (*PaymentsBankCardData, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) PaymentsGetBankCardData(ctx context.Context, params *PaymentsGetBankCardDataParams) (*PaymentsBankCardData, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating PaymentsGetBankCardDataParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning PaymentsGetBankCardData")
 	}
 
 	resp, ok := data.(*PaymentsBankCardData)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11218,9 +11490,6 @@ func (e *StickersCreateStickerSetParams) CRC() uint32 {
 }
 
 func (e *StickersCreateStickerSetParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Masks) {
 		flag |= 1 << 0
@@ -11244,15 +11513,19 @@ func (e *StickersCreateStickerSetParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) StickersCreateStickerSet(params *StickersCreateStickerSetParams) (*MessagesStickerSet, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) StickersCreateStickerSet(ctx context.Context, params *StickersCreateStickerSetParams) (*MessagesStickerSet, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating StickersCreateStickerSetParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning StickersCreateStickerSet")
 	}
 
 	resp, ok := data.(*MessagesStickerSet)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11267,24 +11540,25 @@ func (e *StickersRemoveStickerFromSetParams) CRC() uint32 {
 }
 
 func (e *StickersRemoveStickerFromSetParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Sticker.Encode())
 	return buf.Result()
 }
 
-func (c *Client) StickersRemoveStickerFromSet(params *StickersRemoveStickerFromSetParams) (*MessagesStickerSet, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) StickersRemoveStickerFromSet(ctx context.Context, params *StickersRemoveStickerFromSetParams) (*MessagesStickerSet, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating StickersRemoveStickerFromSetParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning StickersRemoveStickerFromSet")
 	}
 
 	resp, ok := data.(*MessagesStickerSet)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11300,9 +11574,6 @@ func (e *StickersChangeStickerPositionParams) CRC() uint32 {
 }
 
 func (e *StickersChangeStickerPositionParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Sticker.Encode())
@@ -11310,15 +11581,19 @@ func (e *StickersChangeStickerPositionParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) StickersChangeStickerPosition(params *StickersChangeStickerPositionParams) (*MessagesStickerSet, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) StickersChangeStickerPosition(ctx context.Context, params *StickersChangeStickerPositionParams) (*MessagesStickerSet, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating StickersChangeStickerPositionParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning StickersChangeStickerPosition")
 	}
 
 	resp, ok := data.(*MessagesStickerSet)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11334,9 +11609,6 @@ func (e *StickersAddStickerToSetParams) CRC() uint32 {
 }
 
 func (e *StickersAddStickerToSetParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Stickerset.Encode())
@@ -11344,15 +11616,19 @@ func (e *StickersAddStickerToSetParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) StickersAddStickerToSet(params *StickersAddStickerToSetParams) (*MessagesStickerSet, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) StickersAddStickerToSet(ctx context.Context, params *StickersAddStickerToSetParams) (*MessagesStickerSet, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating StickersAddStickerToSetParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning StickersAddStickerToSet")
 	}
 
 	resp, ok := data.(*MessagesStickerSet)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11368,9 +11644,6 @@ func (e *StickersSetStickerSetThumbParams) CRC() uint32 {
 }
 
 func (e *StickersSetStickerSetThumbParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Stickerset.Encode())
@@ -11378,15 +11651,19 @@ func (e *StickersSetStickerSetThumbParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) StickersSetStickerSetThumb(params *StickersSetStickerSetThumbParams) (*MessagesStickerSet, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) StickersSetStickerSetThumb(ctx context.Context, params *StickersSetStickerSetThumbParams) (*MessagesStickerSet, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating StickersSetStickerSetThumbParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning StickersSetStickerSetThumb")
 	}
 
 	resp, ok := data.(*MessagesStickerSet)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11404,15 +11681,15 @@ func (e *PhoneGetCallConfigParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) PhoneGetCallConfig() (*DataJSON, error) {
-	data, err := c.MakeRequest(&PhoneGetCallConfigParams{})
+func (c *Client) PhoneGetCallConfig(ctx context.Context) (*DataJSON, error) {
+	data, err := c.MakeRequestContext(ctx, &PhoneGetCallConfigParams{})
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning PhoneGetCallConfig")
 	}
 
 	resp, ok := data.(*DataJSON)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11432,9 +11709,6 @@ func (e *PhoneRequestCallParams) CRC() uint32 {
 }
 
 func (e *PhoneRequestCallParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Video) {
 		flag |= 1 << 0
@@ -11449,15 +11723,19 @@ func (e *PhoneRequestCallParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) PhoneRequestCall(params *PhoneRequestCallParams) (*PhonePhoneCall, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) PhoneRequestCall(ctx context.Context, params *PhoneRequestCallParams) (*PhonePhoneCall, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating PhoneRequestCallParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning PhoneRequestCall")
 	}
 
 	resp, ok := data.(*PhonePhoneCall)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11474,9 +11752,6 @@ func (e *PhoneAcceptCallParams) CRC() uint32 {
 }
 
 func (e *PhoneAcceptCallParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -11485,15 +11760,19 @@ func (e *PhoneAcceptCallParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) PhoneAcceptCall(params *PhoneAcceptCallParams) (*PhonePhoneCall, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) PhoneAcceptCall(ctx context.Context, params *PhoneAcceptCallParams) (*PhonePhoneCall, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating PhoneAcceptCallParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning PhoneAcceptCall")
 	}
 
 	resp, ok := data.(*PhonePhoneCall)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11511,9 +11790,6 @@ func (e *PhoneConfirmCallParams) CRC() uint32 {
 }
 
 func (e *PhoneConfirmCallParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -11523,15 +11799,19 @@ func (e *PhoneConfirmCallParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) PhoneConfirmCall(params *PhoneConfirmCallParams) (*PhonePhoneCall, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) PhoneConfirmCall(ctx context.Context, params *PhoneConfirmCallParams) (*PhonePhoneCall, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating PhoneConfirmCallParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning PhoneConfirmCall")
 	}
 
 	resp, ok := data.(*PhonePhoneCall)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11546,24 +11826,25 @@ func (e *PhoneReceivedCallParams) CRC() uint32 {
 }
 
 func (e *PhoneReceivedCallParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
 	return buf.Result()
 }
 
-func (c *Client) PhoneReceivedCall(params *PhoneReceivedCallParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) PhoneReceivedCall(ctx context.Context, params *PhoneReceivedCallParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating PhoneReceivedCallParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning PhoneReceivedCall")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11583,9 +11864,6 @@ func (e *PhoneDiscardCallParams) CRC() uint32 {
 }
 
 func (e *PhoneDiscardCallParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Video) {
 		flag |= 1 << 0
@@ -11600,15 +11878,19 @@ func (e *PhoneDiscardCallParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) PhoneDiscardCall(params *PhoneDiscardCallParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) PhoneDiscardCall(ctx context.Context, params *PhoneDiscardCallParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating PhoneDiscardCallParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning PhoneDiscardCall")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11627,9 +11909,6 @@ func (e *PhoneSetCallRatingParams) CRC() uint32 {
 }
 
 func (e *PhoneSetCallRatingParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.UserInitiative) {
 		flag |= 1 << 0
@@ -11643,15 +11922,19 @@ func (e *PhoneSetCallRatingParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) PhoneSetCallRating(params *PhoneSetCallRatingParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) PhoneSetCallRating(ctx context.Context, params *PhoneSetCallRatingParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating PhoneSetCallRatingParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning PhoneSetCallRating")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11667,9 +11950,6 @@ func (e *PhoneSaveCallDebugParams) CRC() uint32 {
 }
 
 func (e *PhoneSaveCallDebugParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -11677,15 +11957,19 @@ func (e *PhoneSaveCallDebugParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) PhoneSaveCallDebug(params *PhoneSaveCallDebugParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) PhoneSaveCallDebug(ctx context.Context, params *PhoneSaveCallDebugParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating PhoneSaveCallDebugParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning PhoneSaveCallDebug")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11701,9 +11985,6 @@ func (e *PhoneSendSignalingDataParams) CRC() uint32 {
 }
 
 func (e *PhoneSendSignalingDataParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -11711,15 +11992,19 @@ func (e *PhoneSendSignalingDataParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) PhoneSendSignalingData(params *PhoneSendSignalingDataParams) (*serialize.Bool, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) PhoneSendSignalingData(ctx context.Context, params *PhoneSendSignalingDataParams) (*serialize.Bool, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating PhoneSendSignalingDataParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning PhoneSendSignalingData")
 	}
 
 	resp, ok := data.(*serialize.Bool)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11735,9 +12020,6 @@ func (e *LangpackGetLangPackParams) CRC() uint32 {
 }
 
 func (e *LangpackGetLangPackParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.LangPack)
@@ -11745,15 +12027,19 @@ func (e *LangpackGetLangPackParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) LangpackGetLangPack(params *LangpackGetLangPackParams) (*LangPackDifference, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) LangpackGetLangPack(ctx context.Context, params *LangpackGetLangPackParams) (*LangPackDifference, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating LangpackGetLangPackParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning LangpackGetLangPack")
 	}
 
 	resp, ok := data.(*LangPackDifference)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11770,9 +12056,6 @@ func (e *LangpackGetStringsParams) CRC() uint32 {
 }
 
 func (e *LangpackGetStringsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.LangPack)
@@ -11781,15 +12064,19 @@ func (e *LangpackGetStringsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) LangpackGetStrings(params *LangpackGetStringsParams) (LangPackString, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) LangpackGetStrings(ctx context.Context, params *LangpackGetStringsParams) (LangPackString, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating LangpackGetStringsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning LangpackGetStrings")
 	}
 
 	resp, ok := data.(LangPackString)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11806,9 +12093,6 @@ func (e *LangpackGetDifferenceParams) CRC() uint32 {
 }
 
 func (e *LangpackGetDifferenceParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.LangPack)
@@ -11817,15 +12101,19 @@ func (e *LangpackGetDifferenceParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) LangpackGetDifference(params *LangpackGetDifferenceParams) (*LangPackDifference, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) LangpackGetDifference(ctx context.Context, params *LangpackGetDifferenceParams) (*LangPackDifference, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating LangpackGetDifferenceParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning LangpackGetDifference")
 	}
 
 	resp, ok := data.(*LangPackDifference)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11840,24 +12128,25 @@ func (e *LangpackGetLanguagesParams) CRC() uint32 {
 }
 
 func (e *LangpackGetLanguagesParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.LangPack)
 	return buf.Result()
 }
 
-func (c *Client) LangpackGetLanguages(params *LangpackGetLanguagesParams) (*LangPackLanguage, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) LangpackGetLanguages(ctx context.Context, params *LangpackGetLanguagesParams) (*LangPackLanguage, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating LangpackGetLanguagesParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning LangpackGetLanguages")
 	}
 
 	resp, ok := data.(*LangPackLanguage)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11873,9 +12162,6 @@ func (e *LangpackGetLanguageParams) CRC() uint32 {
 }
 
 func (e *LangpackGetLanguageParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.LangPack)
@@ -11883,15 +12169,19 @@ func (e *LangpackGetLanguageParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) LangpackGetLanguage(params *LangpackGetLanguageParams) (*LangPackLanguage, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) LangpackGetLanguage(ctx context.Context, params *LangpackGetLanguageParams) (*LangPackLanguage, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating LangpackGetLanguageParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning LangpackGetLanguage")
 	}
 
 	resp, ok := data.(*LangPackLanguage)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11906,24 +12196,25 @@ func (e *FoldersEditPeerFoldersParams) CRC() uint32 {
 }
 
 func (e *FoldersEditPeerFoldersParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.FolderPeers)
 	return buf.Result()
 }
 
-func (c *Client) FoldersEditPeerFolders(params *FoldersEditPeerFoldersParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) FoldersEditPeerFolders(ctx context.Context, params *FoldersEditPeerFoldersParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating FoldersEditPeerFoldersParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning FoldersEditPeerFolders")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11938,24 +12229,25 @@ func (e *FoldersDeleteFolderParams) CRC() uint32 {
 }
 
 func (e *FoldersDeleteFolderParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.FolderId)
 	return buf.Result()
 }
 
-func (c *Client) FoldersDeleteFolder(params *FoldersDeleteFolderParams) (Updates, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) FoldersDeleteFolder(ctx context.Context, params *FoldersDeleteFolderParams) (Updates, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating FoldersDeleteFolderParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning FoldersDeleteFolder")
 	}
 
 	resp, ok := data.(Updates)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -11972,9 +12264,6 @@ func (e *StatsGetBroadcastStatsParams) CRC() uint32 {
 }
 
 func (e *StatsGetBroadcastStatsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Dark) {
 		flag |= 1 << 0
@@ -11986,15 +12275,19 @@ func (e *StatsGetBroadcastStatsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) StatsGetBroadcastStats(params *StatsGetBroadcastStatsParams) (*StatsBroadcastStats, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) StatsGetBroadcastStats(ctx context.Context, params *StatsGetBroadcastStatsParams) (*StatsBroadcastStats, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating StatsGetBroadcastStatsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning StatsGetBroadcastStats")
 	}
 
 	resp, ok := data.(*StatsBroadcastStats)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -12011,9 +12304,6 @@ func (e *StatsLoadAsyncGraphParams) CRC() uint32 {
 }
 
 func (e *StatsLoadAsyncGraphParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.X) {
 		flag |= 1 << 0
@@ -12028,15 +12318,19 @@ func (e *StatsLoadAsyncGraphParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) StatsLoadAsyncGraph(params *StatsLoadAsyncGraphParams) (StatsGraph, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) StatsLoadAsyncGraph(ctx context.Context, params *StatsLoadAsyncGraphParams) (StatsGraph, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating StatsLoadAsyncGraphParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning StatsLoadAsyncGraph")
 	}
 
 	resp, ok := data.(StatsGraph)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -12053,9 +12347,6 @@ func (e *StatsGetMegagroupStatsParams) CRC() uint32 {
 }
 
 func (e *StatsGetMegagroupStatsParams) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Dark) {
 		flag |= 1 << 0
@@ -12067,15 +12358,19 @@ func (e *StatsGetMegagroupStatsParams) Encode() []byte {
 	return buf.Result()
 }
 
-func (c *Client) StatsGetMegagroupStats(params *StatsGetMegagroupStatsParams) (*StatsMegagroupStats, error) {
-	data, err := c.MakeRequest(params)
+func (c *Client) StatsGetMegagroupStats(ctx context.Context, params *StatsGetMegagroupStatsParams) (*StatsMegagroupStats, error) {
+	if err := validator.New().Struct(params); err != nil {
+		return nil, errors.Wrap(err, "validating StatsGetMegagroupStatsParams")
+	}
+
+	data, err := c.MakeRequestContext(ctx, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "sedning StatsGetMegagroupStats")
 	}
 
 	resp, ok := data.(*StatsMegagroupStats)
 	if !ok {
-		panic("got invalid response type: " + reflect.TypeOf(data).String())
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
 	}
 
 	return resp, nil
@@ -3,9 +3,7 @@
 package telegram
 
 import (
-	validator "github.com/go-playground/validator"
 	zero "github.com/vikyd/zero"
-	dry "github.com/xelaj/go-dry"
 	serialize "github.com/xelaj/mtproto/serialize"
 )
 
@@ -19,9 +17,6 @@ func (e *PaymentsSavedInfo) CRC() uint32 {
 	return uint32(0xfb8fe43c)
 }
 func (e *PaymentsSavedInfo) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.SavedInfo) {
 		flag |= 1 << 0
@@ -46,9 +41,6 @@ func (e *StickerPack) CRC() uint32 {
 	return uint32(0x12b299d4)
 }
 func (e *StickerPack) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Emoticon)
@@ -68,9 +60,6 @@ func (e *PaymentRequestedInfo) CRC() uint32 {
 	return uint32(0x909c3f94)
 }
 func (e *PaymentRequestedInfo) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Name) {
 		flag |= 1 << 0
@@ -110,9 +99,6 @@ func (e *InlineBotSwitchPM) CRC() uint32 {
 	return uint32(0x3c20629f)
 }
 func (e *InlineBotSwitchPM) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Text)
@@ -129,9 +115,6 @@ func (e *Error) CRC() uint32 {
 	return uint32(0xc4b9f9bb)
 }
 func (e *Error) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Code)
@@ -151,9 +134,6 @@ func (e *UpdatesState) CRC() uint32 {
 	return uint32(0xa56c2a3e)
 }
 func (e *UpdatesState) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Pts)
@@ -185,9 +165,6 @@ func (e *ChatBannedRights) CRC() uint32 {
 	return uint32(0x9f120418)
 }
 func (e *ChatBannedRights) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ViewMessages) {
 		flag |= 1 << 0
@@ -240,9 +217,6 @@ func (e *StatsPercentValue) CRC() uint32 {
 	return uint32(0xcbce2fe0)
 }
 func (e *StatsPercentValue) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutDouble(e.Part)
@@ -272,9 +246,6 @@ func (e *StatsBroadcastStats) CRC() uint32 {
 	return uint32(0xbdf78394)
 }
 func (e *StatsBroadcastStats) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Period.Encode())
@@ -306,9 +277,6 @@ func (e *MessagesSearchCounter) CRC() uint32 {
 	return uint32(0xe844ebff)
 }
 func (e *MessagesSearchCounter) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Inexact) {
 		flag |= 1 << 1
@@ -331,9 +299,6 @@ func (e *ContactsImportedContacts) CRC() uint32 {
 	return uint32(0x77d01c3b)
 }
 func (e *ContactsImportedContacts) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Imported)
@@ -351,9 +316,6 @@ func (e *HelpInviteText) CRC() uint32 {
 	return uint32(0x18cb9f78)
 }
 func (e *HelpInviteText) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Message)
@@ -369,9 +331,6 @@ func (e *PaymentSavedCredentials) CRC() uint32 {
 	return uint32(0xcdc27a1f)
 }
 func (e *PaymentSavedCredentials) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Id)
@@ -393,9 +352,6 @@ func (e *WallPaperSettings) CRC() uint32 {
 	return uint32(0x5086cf8)
 }
 func (e *WallPaperSettings) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.BackgroundColor) {
 		flag |= 1 << 0
@@ -440,9 +396,6 @@ func (e *InputWebDocument) CRC() uint32 {
 	return uint32(0x9bed434d)
 }
 func (e *InputWebDocument) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Url)
@@ -462,9 +415,6 @@ func (e *NearestDc) CRC() uint32 {
 	return uint32(0x8e1a1775)
 }
 func (e *NearestDc) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Country)
@@ -492,9 +442,6 @@ func (e *AccountPassword) CRC() uint32 {
 	return uint32(0xad2641f8)
 }
 func (e *AccountPassword) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.HasRecovery) {
 		flag |= 1 << 0
@@ -548,9 +495,6 @@ func (e *PollResults) CRC() uint32 {
 	return uint32(0xbadcc1a3)
 }
 func (e *PollResults) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Min) {
 		flag |= 1 << 0
@@ -596,9 +540,6 @@ func (e *InputFolderPeer) CRC() uint32 {
 	return uint32(0xfbd2c296)
 }
 func (e *InputFolderPeer) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -619,9 +560,6 @@ func (e *AccountPasswordInputSettings) CRC() uint32 {
 	return uint32(0xc23727c9)
 }
 func (e *AccountPasswordInputSettings) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.NewAlgo) || !zero.IsZeroVal(e.NewPasswordHash) || !zero.IsZeroVal(e.Hint) {
 		flag |= 1 << 0
@@ -661,9 +599,6 @@ func (e *ExportedMessageLink) CRC() uint32 {
 	return uint32(0x5dab1af4)
 }
 func (e *ExportedMessageLink) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Link)
@@ -688,9 +623,6 @@ func (e *Poll) CRC() uint32 {
 	return uint32(0x86e18161)
 }
 func (e *Poll) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Closed) {
 		flag |= 1 << 0
@@ -732,9 +664,6 @@ func (e *EmojiURL) CRC() uint32 {
 	return uint32(0xa575739d)
 }
 func (e *EmojiURL) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Url)
@@ -750,9 +679,6 @@ func (e *ChannelsChannelParticipant) CRC() uint32 {
 	return uint32(0xd0d9b163)
 }
 func (e *ChannelsChannelParticipant) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Participant.Encode())
@@ -769,9 +695,6 @@ func (e *InputEncryptedChat) CRC() uint32 {
 	return uint32(0xf141b5e1)
 }
 func (e *InputEncryptedChat) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.ChatId)
@@ -788,9 +711,6 @@ func (e *AccountTmpPassword) CRC() uint32 {
 	return uint32(0xdb64fd34)
 }
 func (e *AccountTmpPassword) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutMessage(e.TmpPassword)
@@ -814,9 +734,6 @@ func (e *InputSecureValue) CRC() uint32 {
 	return uint32(0xdb21d0a7)
 }
 func (e *InputSecureValue) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Data) {
 		flag |= 1 << 0
@@ -882,9 +799,6 @@ func (e *ChatAdminRights) CRC() uint32 {
 	return uint32(0x5fb224d5)
 }
 func (e *ChatAdminRights) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ChangeInfo) {
 		flag |= 1 << 0
@@ -926,9 +840,6 @@ func (e *CodeSettings) CRC() uint32 {
 	return uint32(0xdebebe83)
 }
 func (e *CodeSettings) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.AllowFlashcall) {
 		flag |= 1 << 0
@@ -960,9 +871,6 @@ func (e *AutoDownloadSettings) CRC() uint32 {
 	return uint32(0xe04232f3)
 }
 func (e *AutoDownloadSettings) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Disabled) {
 		flag |= 1 << 0
@@ -994,9 +902,6 @@ func (e *AuthExportedAuthorization) CRC() uint32 {
 	return uint32(0xdf969c2d)
 }
 func (e *AuthExportedAuthorization) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Id)
@@ -1016,9 +921,6 @@ func (e *InputPeerNotifySettings) CRC() uint32 {
 	return uint32(0x9c3d198e)
 }
 func (e *InputPeerNotifySettings) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ShowPreviews) {
 		flag |= 1 << 0
@@ -1071,9 +973,6 @@ func (e *DialogFilter) CRC() uint32 {
 	return uint32(0x7438f7e8)
 }
 func (e *DialogFilter) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Contacts) {
 		flag |= 1 << 0
@@ -1134,9 +1033,6 @@ func (e *LangPackLanguage) CRC() uint32 {
 	return uint32(0xeeca5ce3)
 }
 func (e *LangPackLanguage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Official) {
 		flag |= 1 << 0
@@ -1178,9 +1074,6 @@ func (e *HelpTermsOfService) CRC() uint32 {
 	return uint32(0x780a0310)
 }
 func (e *HelpTermsOfService) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Popup) {
 		flag |= 1 << 0
@@ -1209,9 +1102,6 @@ func (e *SecureSecretSettings) CRC() uint32 {
 	return uint32(0x1527bcac)
 }
 func (e *SecureSecretSettings) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.SecureAlgo.Encode())
@@ -1230,9 +1120,6 @@ func (e *WebPageAttribute) CRC() uint32 {
 	return uint32(0x54b56617)
 }
 func (e *WebPageAttribute) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Documents) {
 		flag |= 1 << 0
@@ -1260,9 +1147,6 @@ func (e *StatsAbsValueAndPrev) CRC() uint32 {
 	return uint32(0xcb43acde)
 }
 func (e *StatsAbsValueAndPrev) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutDouble(e.Current)
@@ -1281,9 +1165,6 @@ func (e *InputAppEvent) CRC() uint32 {
 	return uint32(0x1d1b1245)
 }
 func (e *InputAppEvent) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutDouble(e.Time)
@@ -1309,9 +1190,6 @@ func (e *Page) CRC() uint32 {
 	return uint32(0x98657f0d)
 }
 func (e *Page) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Part) {
 		flag |= 1 << 0
@@ -1345,9 +1223,6 @@ func (e *StatsURL) CRC() uint32 {
 	return uint32(0x47a971e0)
 }
 func (e *StatsURL) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Url)
@@ -1364,9 +1239,6 @@ func (e *ContactsResolvedPeer) CRC() uint32 {
 	return uint32(0x7f077ad9)
 }
 func (e *ContactsResolvedPeer) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -1384,9 +1256,6 @@ func (e *AccountWebAuthorizations) CRC() uint32 {
 	return uint32(0xed56c9fc)
 }
 func (e *AccountWebAuthorizations) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Authorizations)
@@ -1403,9 +1272,6 @@ func (e *TopPeer) CRC() uint32 {
 	return uint32(0xedcdc05b)
 }
 func (e *TopPeer) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -1423,9 +1289,6 @@ func (e *AccountAutoDownloadSettings) CRC() uint32 {
 	return uint32(0x63cacf26)
 }
 func (e *AccountAutoDownloadSettings) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Low.Encode())
@@ -1449,9 +1312,6 @@ func (e *Game) CRC() uint32 {
 	return uint32(0xbdf9653b)
 }
 func (e *Game) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Document) {
 		flag |= 1 << 0
@@ -1479,9 +1339,6 @@ func (e *PollAnswer) CRC() uint32 {
 	return uint32(0x6ca9c2e9)
 }
 func (e *PollAnswer) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Text)
@@ -1499,9 +1356,6 @@ func (e *AccountPasswordSettings) CRC() uint32 {
 	return uint32(0x9a5c33e5)
 }
 func (e *AccountPasswordSettings) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Email) {
 		flag |= 1 << 0
@@ -1528,9 +1382,6 @@ func (e *CdnConfig) CRC() uint32 {
 	return uint32(0x5725e40a)
 }
 func (e *CdnConfig) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.PublicKeys)
@@ -1553,9 +1404,6 @@ func (e *PeerSettings) CRC() uint32 {
 	return uint32(0x733f2961)
 }
 func (e *PeerSettings) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ReportSpam) {
 		flag |= 1 << 0
@@ -1598,9 +1446,6 @@ func (e *BotCommand) CRC() uint32 {
 	return uint32(0xc27ac8c7)
 }
 func (e *BotCommand) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Command)
@@ -1617,9 +1462,6 @@ func (e *PaymentCharge) CRC() uint32 {
 	return uint32(0xea02c27e)
 }
 func (e *PaymentCharge) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Id)
@@ -1636,9 +1478,6 @@ func (e *PageCaption) CRC() uint32 {
 	return uint32(0x6f747657)
 }
 func (e *PageCaption) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Text.Encode())
@@ -1657,9 +1496,6 @@ func (e *StatsGroupTopAdmin) CRC() uint32 {
 	return uint32(0x6014f412)
 }
 func (e *StatsGroupTopAdmin) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -1687,9 +1523,6 @@ func (e *PaymentsPaymentReceipt) CRC() uint32 {
 	return uint32(0x500911e1)
 }
 func (e *PaymentsPaymentReceipt) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Info) {
 		flag |= 1 << 0
@@ -1724,9 +1557,6 @@ func (e *HelpSupportName) CRC() uint32 {
 	return uint32(0x8c05f1c9)
 }
 func (e *HelpSupportName) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Name)
@@ -1743,9 +1573,6 @@ func (e *SecureCredentialsEncrypted) CRC() uint32 {
 	return uint32(0x33f0ea47)
 }
 func (e *SecureCredentialsEncrypted) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutMessage(e.Data)
@@ -1763,9 +1590,6 @@ func (e *ContactBlocked) CRC() uint32 {
 	return uint32(0x561bc879)
 }
 func (e *ContactBlocked) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -1783,9 +1607,6 @@ func (e *MessagesAffectedHistory) CRC() uint32 {
 	return uint32(0xb45c69d1)
 }
 func (e *MessagesAffectedHistory) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Pts)
@@ -1805,9 +1626,6 @@ func (e *LangPackDifference) CRC() uint32 {
 	return uint32(0xf385c1f6)
 }
 func (e *LangPackDifference) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.LangCode)
@@ -1826,9 +1644,6 @@ func (e *InputClientProxy) CRC() uint32 {
 	return uint32(0x75588b3f)
 }
 func (e *InputClientProxy) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Address)
@@ -1849,9 +1664,6 @@ func (e *AccountAuthorizationForm) CRC() uint32 {
 	return uint32(0xad2e1cd8)
 }
 func (e *AccountAuthorizationForm) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.PrivacyPolicyUrl) {
 		flag |= 1 << 0
@@ -1879,9 +1691,6 @@ func (e *SavedContact) CRC() uint32 {
 	return uint32(0x1142bd56)
 }
 func (e *SavedContact) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Phone)
@@ -1901,9 +1710,6 @@ func (e *AccountContentSettings) CRC() uint32 {
 	return uint32(0x57e28221)
 }
 func (e *AccountContentSettings) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.SensitiveEnabled) {
 		flag |= 1 << 0
@@ -1925,9 +1731,6 @@ func (e *SecureValueHash) CRC() uint32 {
 	return uint32(0xed1ecdb0)
 }
 func (e *SecureValueHash) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Type.Encode())
@@ -1958,9 +1761,6 @@ func (e *UserFull) CRC() uint32 {
 	return uint32(0xedf17c12)
 }
 func (e *UserFull) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Blocked) {
 		flag |= 1 << 0
@@ -2029,9 +1829,6 @@ func (e *MessagesStickerSet) CRC() uint32 {
 	return uint32(0xb60a24a6)
 }
 func (e *MessagesStickerSet) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Set.Encode())
@@ -2051,9 +1848,6 @@ func (e *InputStickerSetItem) CRC() uint32 {
 	return uint32(0xffa0a496)
 }
 func (e *InputStickerSetItem) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.MaskCoords) {
 		flag |= 1 << 0
@@ -2077,9 +1871,6 @@ func (e *MessageRange) CRC() uint32 {
 	return uint32(0xae30253)
 }
 func (e *MessageRange) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.MinId)
@@ -2096,9 +1887,6 @@ func (e *ContactStatus) CRC() uint32 {
 	return uint32(0xd3680c61)
 }
 func (e *ContactStatus) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -2128,9 +1916,6 @@ func (e *ChannelAdminLogEventsFilter) CRC() uint32 {
 	return uint32(0xea107ae4)
 }
 func (e *ChannelAdminLogEventsFilter) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Join) {
 		flag |= 1 << 0
@@ -2193,9 +1978,6 @@ func (e *InputThemeSettings) CRC() uint32 {
 	return uint32(0xbd507cd1)
 }
 func (e *InputThemeSettings) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.MessageTopColor) || !zero.IsZeroVal(e.MessageBottomColor) {
 		flag |= 1 << 0
@@ -2235,9 +2017,6 @@ func (e *PostAddress) CRC() uint32 {
 	return uint32(0x1e8caaeb)
 }
 func (e *PostAddress) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.StreetLine1)
@@ -2259,9 +2038,6 @@ func (e *InputBotInlineMessageID) CRC() uint32 {
 	return uint32(0x890c3d89)
 }
 func (e *InputBotInlineMessageID) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.DcId)
@@ -2282,9 +2058,6 @@ func (e *MessagesPeerDialogs) CRC() uint32 {
 	return uint32(0x3371c354)
 }
 func (e *MessagesPeerDialogs) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Dialogs)
@@ -2306,9 +2079,6 @@ func (e *ContactsFound) CRC() uint32 {
 	return uint32(0xb3134d9d)
 }
 func (e *ContactsFound) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.MyResults)
@@ -2327,9 +2097,6 @@ func (e *ReceivedNotifyMessage) CRC() uint32 {
 	return uint32(0xa384b779)
 }
 func (e *ReceivedNotifyMessage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Id)
@@ -2347,9 +2114,6 @@ func (e *BotInfo) CRC() uint32 {
 	return uint32(0x98e81d3a)
 }
 func (e *BotInfo) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -2368,9 +2132,6 @@ func (e *MessagesChatFull) CRC() uint32 {
 	return uint32(0xe5d7d19c)
 }
 func (e *MessagesChatFull) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.FullChat.Encode())
@@ -2387,9 +2148,6 @@ func (e *KeyboardButtonRow) CRC() uint32 {
 	return uint32(0x77608b83)
 }
 func (e *KeyboardButtonRow) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Buttons)
@@ -2414,9 +2172,6 @@ func (e *Invoice) CRC() uint32 {
 	return uint32(0xc30aa358)
 }
 func (e *Invoice) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Test) {
 		flag |= 1 << 0
@@ -2465,9 +2220,6 @@ func (e *PageTableCell) CRC() uint32 {
 	return uint32(0x34566b6a)
 }
 func (e *PageTableCell) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Header) {
 		flag |= 1 << 0
@@ -2516,9 +2268,6 @@ func (e *GlobalPrivacySettings) CRC() uint32 {
 	return uint32(0xbea2f424)
 }
 func (e *GlobalPrivacySettings) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ArchiveAndMuteNewNoncontactPeers) {
 		flag |= 1 << 0
@@ -2543,9 +2292,6 @@ func (e *UploadWebFile) CRC() uint32 {
 	return uint32(0x21e753bc)
 }
 func (e *UploadWebFile) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Size)
@@ -2564,9 +2310,6 @@ func (e *AccountTakeout) CRC() uint32 {
 	return uint32(0x4dba4501)
 }
 func (e *AccountTakeout) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -2587,9 +2330,6 @@ func (e *Folder) CRC() uint32 {
 	return uint32(0xff544e65)
 }
 func (e *Folder) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.AutofillNewBroadcasts) {
 		flag |= 1 << 0
@@ -2624,9 +2364,6 @@ func (e *MaskCoords) CRC() uint32 {
 	return uint32(0xaed6dbb2)
 }
 func (e *MaskCoords) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.N)
@@ -2646,9 +2383,6 @@ func (e *StatsGroupTopPoster) CRC() uint32 {
 	return uint32(0x18f3d0f7)
 }
 func (e *StatsGroupTopPoster) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -2666,9 +2400,6 @@ func (e *MessagesAffectedMessages) CRC() uint32 {
 	return uint32(0x84d19185)
 }
 func (e *MessagesAffectedMessages) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Pts)
@@ -2686,9 +2417,6 @@ func (e *ShippingOption) CRC() uint32 {
 	return uint32(0xb6213cdf)
 }
 func (e *ShippingOption) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Id)
@@ -2707,9 +2435,6 @@ func (e *ChannelsAdminLogResults) CRC() uint32 {
 	return uint32(0xed8af74d)
 }
 func (e *ChannelsAdminLogResults) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Events)
@@ -2730,9 +2455,6 @@ func (e *MessagesVotesList) CRC() uint32 {
 	return uint32(0x823f649)
 }
 func (e *MessagesVotesList) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.NextOffset) {
 		flag |= 1 << 0
@@ -2769,9 +2491,6 @@ func (e *StickerSet) CRC() uint32 {
 	return uint32(0xeeb46f27)
 }
 func (e *StickerSet) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.InstalledDate) {
 		flag |= 1 << 0
@@ -2821,9 +2540,6 @@ func (e *PaymentsValidatedRequestedInfo) CRC() uint32 {
 	return uint32(0xd1451883)
 }
 func (e *PaymentsValidatedRequestedInfo) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Id) {
 		flag |= 1 << 0
@@ -2855,9 +2571,6 @@ func (e *ThemeSettings) CRC() uint32 {
 	return uint32(0x9c14984a)
 }
 func (e *ThemeSettings) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.MessageTopColor) || !zero.IsZeroVal(e.MessageBottomColor) {
 		flag |= 1 << 0
@@ -2895,9 +2608,6 @@ func (e *VideoSize) CRC() uint32 {
 	return uint32(0xe831c556)
 }
 func (e *VideoSize) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.VideoStartTs) {
 		flag |= 1 << 0
@@ -2924,9 +2634,6 @@ func (e *PhotosPhoto) CRC() uint32 {
 	return uint32(0x20212ca8)
 }
 func (e *PhotosPhoto) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Photo.Encode())
@@ -2943,9 +2650,6 @@ func (e *PopularContact) CRC() uint32 {
 	return uint32(0x5ce14175)
 }
 func (e *PopularContact) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.ClientId)
@@ -2965,9 +2669,6 @@ func (e *InputSingleMedia) CRC() uint32 {
 	return uint32(0x1cc6e91f)
 }
 func (e *InputSingleMedia) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Entities) {
 		flag |= 1 << 0
@@ -2993,9 +2694,6 @@ func (e *MessageInteractionCounters) CRC() uint32 {
 	return uint32(0xad4fc9bd)
 }
 func (e *MessageInteractionCounters) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.MsgId)
@@ -3021,9 +2719,6 @@ func (e *Theme) CRC() uint32 {
 	return uint32(0x28f1114)
 }
 func (e *Theme) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Creator) {
 		flag |= 1 << 0
@@ -3072,9 +2767,6 @@ func (e *PaymentsPaymentForm) CRC() uint32 {
 	return uint32(0x3f56aea3)
 }
 func (e *PaymentsPaymentForm) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.SavedInfo) {
 		flag |= 1 << 0
@@ -3121,9 +2813,6 @@ func (e *ChatOnlines) CRC() uint32 {
 	return uint32(0xf041e250)
 }
 func (e *ChatOnlines) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Onlines)
@@ -3141,9 +2830,6 @@ func (e *EmojiKeywordsDifference) CRC() uint32 {
 	return uint32(0x5cc761bd)
 }
 func (e *EmojiKeywordsDifference) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.LangCode)
@@ -3165,9 +2851,6 @@ func (e *PeerNotifySettings) CRC() uint32 {
 	return uint32(0xaf509d20)
 }
 func (e *PeerNotifySettings) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.ShowPreviews) {
 		flag |= 1 << 0
@@ -3207,9 +2890,6 @@ func (e *JSONObjectValue) CRC() uint32 {
 	return uint32(0xc0de1bd9)
 }
 func (e *JSONObjectValue) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Key)
@@ -3225,9 +2905,6 @@ func (e *EmojiLanguage) CRC() uint32 {
 	return uint32(0xb3fb5361)
 }
 func (e *EmojiLanguage) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.LangCode)
@@ -3243,9 +2920,6 @@ func (e *StatsGroupTopInviter) CRC() uint32 {
 	return uint32(0x31962a4c)
 }
 func (e *StatsGroupTopInviter) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -3268,9 +2942,6 @@ func (e *MessagesBotResults) CRC() uint32 {
 	return uint32(0x947ca848)
 }
 func (e *MessagesBotResults) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Gallery) {
 		flag |= 1 << 0
@@ -3319,9 +2990,6 @@ func (e *Authorization) CRC() uint32 {
 	return uint32(0xad01d61d)
 }
 func (e *Authorization) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Current) {
 		flag |= 1 << 0
@@ -3373,9 +3041,6 @@ func (e *StatsMegagroupStats) CRC() uint32 {
 	return uint32(0xef7ff916)
 }
 func (e *StatsMegagroupStats) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Period.Encode())
@@ -3412,9 +3077,6 @@ func (e *MessagesBotCallbackAnswer) CRC() uint32 {
 	return uint32(0x36585ea4)
 }
 func (e *MessagesBotCallbackAnswer) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Message) {
 		flag |= 1 << 0
@@ -3452,9 +3114,6 @@ func (e *FileLocation) CRC() uint32 {
 	return uint32(0xbc7fc6cd)
 }
 func (e *FileLocation) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.VolumeId)
@@ -3472,9 +3131,6 @@ func (e *RestrictionReason) CRC() uint32 {
 	return uint32(0xd072acb4)
 }
 func (e *RestrictionReason) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Platform)
@@ -3542,9 +3198,6 @@ func (e *Config) CRC() uint32 {
 	return uint32(0x330b4067)
 }
 func (e *Config) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.TmpSessions) {
 		flag |= 1 << 0
@@ -3664,9 +3317,6 @@ func (e *LabeledPrice) CRC() uint32 {
 	return uint32(0xcb296bf8)
 }
 func (e *LabeledPrice) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Label)
@@ -3684,9 +3334,6 @@ func (e *SecureData) CRC() uint32 {
 	return uint32(0x8aeabec3)
 }
 func (e *SecureData) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutMessage(e.Data)
@@ -3704,9 +3351,6 @@ func (e *ImportedContact) CRC() uint32 {
 	return uint32(0xd0028438)
 }
 func (e *ImportedContact) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -3723,9 +3367,6 @@ func (e *MessagesMessageEditData) CRC() uint32 {
 	return uint32(0x26b5dde6)
 }
 func (e *MessagesMessageEditData) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Caption) {
 		flag |= 1 << 0
@@ -3744,9 +3385,6 @@ func (e *PhonePhoneCall) CRC() uint32 {
 	return uint32(0xec82e140)
 }
 func (e *PhonePhoneCall) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.PhoneCall.Encode())
@@ -3763,9 +3401,6 @@ func (e *InputPhoneCall) CRC() uint32 {
 	return uint32(0x1e36fded)
 }
 func (e *InputPhoneCall) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -3784,9 +3419,6 @@ func (e *ChannelAdminLogEvent) CRC() uint32 {
 	return uint32(0x3b5a3e40)
 }
 func (e *ChannelAdminLogEvent) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Id)
@@ -3808,9 +3440,6 @@ func (e *AuthSentCode) CRC() uint32 {
 	return uint32(0x5e002502)
 }
 func (e *AuthSentCode) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.NextType) {
 		flag |= 1 << 1
@@ -3843,9 +3472,6 @@ func (e *PollAnswerVoters) CRC() uint32 {
 	return uint32(0x3b6ddad2)
 }
 func (e *PollAnswerVoters) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Chosen) {
 		flag |= 1 << 0
@@ -3869,9 +3495,6 @@ func (e *DialogFilterSuggested) CRC() uint32 {
 	return uint32(0x77744d4a)
 }
 func (e *DialogFilterSuggested) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Filter.Encode())
@@ -3888,9 +3511,6 @@ func (e *FolderPeer) CRC() uint32 {
 	return uint32(0xe9baa668)
 }
 func (e *FolderPeer) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Peer.Encode())
@@ -3908,9 +3528,6 @@ func (e *HighScore) CRC() uint32 {
 	return uint32(0x58fffcd0)
 }
 func (e *HighScore) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Pos)
@@ -3928,9 +3545,6 @@ func (e *CdnPublicKey) CRC() uint32 {
 	return uint32(0xc982eaba)
 }
 func (e *CdnPublicKey) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.DcId)
@@ -3946,9 +3560,6 @@ func (e *DataJSON) CRC() uint32 {
 	return uint32(0x7d748d04)
 }
 func (e *DataJSON) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Data)
@@ -3970,9 +3581,6 @@ func (e *PageRelatedArticle) CRC() uint32 {
 	return uint32(0xb390dc08)
 }
 func (e *PageRelatedArticle) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Title) {
 		flag |= 1 << 0
@@ -4019,9 +3627,6 @@ func (e *AuthPasswordRecovery) CRC() uint32 {
 	return uint32(0x137948a5)
 }
 func (e *AuthPasswordRecovery) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.EmailPattern)
@@ -4044,9 +3649,6 @@ func (e *WebAuthorization) CRC() uint32 {
 	return uint32(0xcac943f2)
 }
 func (e *WebAuthorization) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.Hash)
@@ -4070,9 +3672,6 @@ func (e *PaymentsBankCardData) CRC() uint32 {
 	return uint32(0x3e24e573)
 }
 func (e *PaymentsBankCardData) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Title)
@@ -4090,9 +3689,6 @@ func (e *FileHash) CRC() uint32 {
 	return uint32(0x6242c773)
 }
 func (e *FileHash) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Offset)
@@ -4111,9 +3707,6 @@ func (e *AccessPointRule) CRC() uint32 {
 	return uint32(0x4679b65f)
 }
 func (e *AccessPointRule) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.PhonePrefixRules)
@@ -4133,9 +3726,6 @@ func (e *InputContact) CRC() uint32 {
 	return uint32(0xf392b7f4)
 }
 func (e *InputContact) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutLong(e.ClientId)
@@ -4154,9 +3744,6 @@ func (e *MessagesHighScores) CRC() uint32 {
 	return uint32(0x9a3bfd99)
 }
 func (e *MessagesHighScores) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Scores)
@@ -4173,9 +3760,6 @@ func (e *StatsDateRangeDays) CRC() uint32 {
 	return uint32(0xb637edaf)
 }
 func (e *StatsDateRangeDays) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.MinDate)
@@ -4193,9 +3777,6 @@ func (e *HelpConfigSimple) CRC() uint32 {
 	return uint32(0x5a592a6c)
 }
 func (e *HelpConfigSimple) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Date)
@@ -4214,9 +3795,6 @@ func (e *AccountPrivacyRules) CRC() uint32 {
 	return uint32(0x50a04e45)
 }
 func (e *AccountPrivacyRules) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Rules)
@@ -4233,9 +3811,6 @@ func (e *AccountAuthorizations) CRC() uint32 {
 	return uint32(0x1250abde)
 }
 func (e *AccountAuthorizations) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Authorizations)
@@ -4259,9 +3834,6 @@ func (e *MessageFwdHeader) CRC() uint32 {
 	return uint32(0x353a686b)
 }
 func (e *MessageFwdHeader) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.FromId) {
 		flag |= 1 << 0
@@ -4323,9 +3895,6 @@ func (e *Contact) CRC() uint32 {
 	return uint32(0xf911c994)
 }
 func (e *Contact) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.UserId)
@@ -4342,9 +3911,6 @@ func (e *MessagesArchivedStickers) CRC() uint32 {
 	return uint32(0x4fcba9c8)
 }
 func (e *MessagesArchivedStickers) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Count)
@@ -4365,9 +3931,6 @@ func (e *PhoneCallProtocol) CRC() uint32 {
 	return uint32(0xfc878fc8)
 }
 func (e *PhoneCallProtocol) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.UdpP2P) {
 		flag |= 1 << 0
@@ -4391,9 +3954,6 @@ func (e *PageTableRow) CRC() uint32 {
 	return uint32(0xe0c0c5e5)
 }
 func (e *PageTableRow) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Cells)
@@ -4409,9 +3969,6 @@ func (e *AccountSentEmailCode) CRC() uint32 {
 	return uint32(0x811f854f)
 }
 func (e *AccountSentEmailCode) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.EmailPattern)
@@ -4429,9 +3986,6 @@ func (e *TopPeerCategoryPeers) CRC() uint32 {
 	return uint32(0xfb834291)
 }
 func (e *TopPeerCategoryPeers) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutRawBytes(e.Category.Encode())
@@ -4449,9 +4003,6 @@ func (e *BankCardOpenUrl) CRC() uint32 {
 	return uint32(0xf568028a)
 }
 func (e *BankCardOpenUrl) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.Url)
@@ -4476,9 +4027,6 @@ func (e *DcOption) CRC() uint32 {
 	return uint32(0x18b7a10d)
 }
 func (e *DcOption) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Ipv6) {
 		flag |= 1 << 0
@@ -4519,9 +4067,6 @@ func (e *HelpRecentMeUrls) CRC() uint32 {
 	return uint32(0xe0310d7)
 }
 func (e *HelpRecentMeUrls) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Urls)
@@ -4539,9 +4084,6 @@ func (e *HelpSupport) CRC() uint32 {
 	return uint32(0x17c6b5f6)
 }
 func (e *HelpSupport) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutString(e.PhoneNumber)
@@ -4557,9 +4099,6 @@ func (e *AccountDaysTTL) CRC() uint32 {
 	return uint32(0xb8d0afdf)
 }
 func (e *AccountDaysTTL) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutInt(e.Days)
@@ -4583,9 +4122,6 @@ func (e *SecureValue) CRC() uint32 {
 	return uint32(0x187fa0ca)
 }
 func (e *SecureValue) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	var flag uint32
 	if !zero.IsZeroVal(e.Data) {
 		flag |= 1 << 0
@@ -4646,9 +4182,6 @@ func (e *MessagesInactiveChats) CRC() uint32 {
 	return uint32(0xa927fec5)
 }
 func (e *MessagesInactiveChats) Encode() []byte {
-	err := validator.New().Struct(e)
-	dry.PanicIfErr(err)
-
 	buf := serialize.NewEncoder()
 	buf.PutUint(e.CRC())
 	buf.PutVector(e.Dates)
@@ -0,0 +1,256 @@
+package mtproto
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/xelaj/go-dry"
+
+	"github.com/xelaj/mtproto/serialize"
+	"github.com/xelaj/mtproto/utils"
+)
+
+// TransportMode определяет, как пакеты MTProto упаковываются в поток байт
+// https://core.telegram.org/mtproto/mtproto-transports
+type TransportMode int
+
+const (
+	// TransportIntermediate длина пакета 4 байтами, затем сам пакет. используется по умолчанию
+	TransportIntermediate TransportMode = iota
+	// TransportAbridged длина пакета в словах 1 или 4 байтами, затем сам пакет
+	TransportAbridged
+	// TransportPaddedIntermediate как intermediate, но к пакету добавляется 0-15 случайных байт
+	TransportPaddedIntermediate
+	// TransportFull длина пакета, порядковый номер и crc32 в конце
+	TransportFull
+	// TransportHTTP каждый пакет отправляется отдельным POST запросом, ответы
+	// сервер присылает через http_wait. работает без обфускации и прокси
+	TransportHTTP
+)
+
+// максимальный размер пакета, который мы готовы прочитать. нужен что бы
+// мусор в соединении не заставил выделить гигабайты памяти
+const maxPacketSize = 1 << 24
+
+// Transport читает и пишет целые пакеты MTProto в соединение.
+// каждый экземпляр привязан к одному соединению, т.к. некоторые протоколы
+// (например full) хранят состояние
+type Transport interface {
+	WritePacket(data []byte) error
+	ReadPacket() ([]byte, error)
+}
+
+// NewTransport создает кодек для указанного протокола поверх соединения. заголовок
+// протокола (см. header) кодек не отправляет, это делает тот, кто создает соединение.
+// http транспорт сам управляет соединениями, поэтому здесь не создается
+func NewTransport(mode TransportMode, conn io.ReadWriter) (Transport, error) {
+	switch mode {
+	case TransportIntermediate:
+		return &intermediateTransport{conn: conn}, nil
+	case TransportAbridged:
+		return &abridgedTransport{conn: conn}, nil
+	case TransportPaddedIntermediate:
+		return &intermediateTransport{conn: conn, padded: true}, nil
+	case TransportFull:
+		return &fullTransport{conn: conn}, nil
+	case TransportHTTP:
+		return nil, errors.New("http transport doesn't work over a single connection")
+	default:
+		return nil, errors.New("unknown transport mode: " + strconv.Itoa(int(mode)))
+	}
+}
+
+// header это байты, которые отправляются сразу после подключения, по ним сервер
+// понимает, какой протокол мы используем
+func (t TransportMode) header() []byte {
+	switch t {
+	case TransportIntermediate:
+		return []byte{0xee, 0xee, 0xee, 0xee}
+	case TransportAbridged:
+		return []byte{0xef}
+	case TransportPaddedIntermediate:
+		return []byte{0xdd, 0xdd, 0xdd, 0xdd}
+	default:
+		// у full и http протоколов заголовка нет
+		return nil
+	}
+}
+
+// protocolTag это 4 байта, которые кладутся в заголовок obfuscated2 вместо
+// обычного заголовка протокола
+func (t TransportMode) protocolTag() ([]byte, error) {
+	switch t {
+	case TransportIntermediate:
+		return []byte{0xee, 0xee, 0xee, 0xee}, nil
+	case TransportAbridged:
+		return []byte{0xef, 0xef, 0xef, 0xef}, nil
+	case TransportPaddedIntermediate:
+		return []byte{0xdd, 0xdd, 0xdd, 0xdd}, nil
+	default:
+		return nil, errors.New("transport mode " + strconv.Itoa(int(t)) + " can't be obfuscated")
+	}
+}
+
+//----------------------------------------------------------------------------
+
+// https://core.telegram.org/mtproto/mtproto-transports#abridged
+type abridgedTransport struct {
+	conn io.ReadWriter
+}
+
+func (t *abridgedTransport) WritePacket(data []byte) error {
+	if len(data)%serialize.WordLen != 0 {
+		return fmt.Errorf("packet length must be divisible by %v, got %v", serialize.WordLen, len(data))
+	}
+
+	_, err := t.conn.Write(append(utils.PacketLengthMTProtoCompatible(data), data...))
+	return err
+}
+
+func (t *abridgedTransport) ReadPacket() ([]byte, error) {
+	// маленькие пакеты (до 127 слов) кодируют длину в 1 байт, а побольше в 4.
+	// поэтому читаем сначала 1 байт, смотрим, это 0x7f или нет, если да, то
+	// читаем оставшиеся 3 байта и получаем длину
+	sizeBuf := make([]byte, 1, serialize.WordLen)
+	_, err := io.ReadFull(t.conn, sizeBuf)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading length")
+	}
+
+	size, err := utils.GetPacketLengthMTProtoCompatible(sizeBuf)
+	if err == utils.ErrPacketSizeIsBigger {
+		sizeBuf = sizeBuf[:serialize.WordLen]
+		_, err = io.ReadFull(t.conn, sizeBuf[1:])
+		if err != nil {
+			return nil, errors.Wrap(err, "reading length")
+		}
+
+		size, err = utils.GetPacketLengthMTProtoCompatible(sizeBuf)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding length")
+	}
+
+	return readPacketData(t.conn, size)
+}
+
+//----------------------------------------------------------------------------
+
+// https://core.telegram.org/mtproto/mtproto-transports#intermediate
+// https://core.telegram.org/mtproto/mtproto-transports#padded-intermediate
+type intermediateTransport struct {
+	conn   io.ReadWriter
+	padded bool
+}
+
+func (t *intermediateTransport) WritePacket(data []byte) error {
+	if t.padded {
+		data = append(data, dry.RandomBytes(int(dry.RandomBytes(1)[0]%16))...)
+	}
+
+	buf := make([]byte, serialize.WordLen, serialize.WordLen+len(data))
+	binary.LittleEndian.PutUint32(buf, uint32(len(data)))
+
+	_, err := t.conn.Write(append(buf, data...))
+	return err
+}
+
+func (t *intermediateTransport) ReadPacket() ([]byte, error) {
+	sizeBuf := make([]byte, serialize.WordLen)
+	_, err := io.ReadFull(t.conn, sizeBuf)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading length")
+	}
+
+	// в padded режиме паддинг остается в пакете, декодер сообщений его игнорирует
+	return readPacketData(t.conn, int(binary.LittleEndian.Uint32(sizeBuf)))
+}
+
+//----------------------------------------------------------------------------
+
+// https://core.telegram.org/mtproto/mtproto-transports#full
+type fullTransport struct {
+	conn io.ReadWriter
+
+	sendSeqNo int32
+	recvSeqNo int32
+}
+
+const (
+	// длина, порядковый номер и crc32
+	fullTransportOverhead = serialize.WordLen * 3
+)
+
+func (t *fullTransport) WritePacket(data []byte) error {
+	buf := make([]byte, serialize.WordLen*2, len(data)+fullTransportOverhead)
+	binary.LittleEndian.PutUint32(buf[0:], uint32(len(data)+fullTransportOverhead))
+	binary.LittleEndian.PutUint32(buf[4:], uint32(t.sendSeqNo))
+	buf = append(buf, data...)
+
+	crc := make([]byte, serialize.WordLen)
+	binary.LittleEndian.PutUint32(crc, crc32.ChecksumIEEE(buf))
+	buf = append(buf, crc...)
+
+	_, err := t.conn.Write(buf)
+	if err != nil {
+		return err
+	}
+
+	t.sendSeqNo++
+	return nil
+}
+
+func (t *fullTransport) ReadPacket() ([]byte, error) {
+	header := make([]byte, serialize.WordLen*2)
+	_, err := io.ReadFull(t.conn, header)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading header")
+	}
+
+	size := int(binary.LittleEndian.Uint32(header[0:]))
+	if size < fullTransportOverhead {
+		return nil, errors.New("invalid packet length: " + strconv.Itoa(size))
+	}
+
+	rest, err := readPacketData(t.conn, size-len(header))
+	if err != nil {
+		return nil, err
+	}
+
+	data, crc := rest[:len(rest)-serialize.WordLen], rest[len(rest)-serialize.WordLen:]
+
+	checksum := crc32.NewIEEE()
+	checksum.Write(header)
+	checksum.Write(data)
+	if checksum.Sum32() != binary.LittleEndian.Uint32(crc) {
+		return nil, errors.New("crc32 checksum mismatch")
+	}
+
+	seqNo := int32(binary.LittleEndian.Uint32(header[4:]))
+	if seqNo != t.recvSeqNo {
+		return nil, fmt.Errorf("wrong packet seqno: expected %v, got %v", t.recvSeqNo, seqNo)
+	}
+	t.recvSeqNo++
+
+	return data, nil
+}
+
+//----------------------------------------------------------------------------
+
+func readPacketData(r io.Reader, size int) ([]byte, error) {
+	if size <= 0 || size > maxPacketSize {
+		return nil, errors.New("invalid packet length: " + strconv.Itoa(size))
+	}
+
+	data := make([]byte, size)
+	_, err := io.ReadFull(r, data)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading packet data")
+	}
+
+	return data, nil
+}
@@ -0,0 +1,57 @@
+package mtproto
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xelaj/go-dry"
+)
+
+func TestTransportRoundTrip(t *testing.T) {
+	for _, mode := range []TransportMode{
+		TransportIntermediate,
+		TransportAbridged,
+		TransportPaddedIntermediate,
+		TransportFull,
+	} {
+		t.Run("mode "+strconv.Itoa(int(mode)), func(t *testing.T) {
+			conn := new(bytes.Buffer)
+			transport, err := NewTransport(mode, conn)
+			assert.NoError(t, err)
+
+			packets := [][]byte{
+				dry.RandomBytes(4),
+				dry.RandomBytes(124 * 4),
+				dry.RandomBytes(127 * 4), // abridged переключается на 4 байта длины
+				dry.RandomBytes(4096),
+			}
+			for _, packet := range packets {
+				assert.NoError(t, transport.WritePacket(packet))
+			}
+
+			for _, packet := range packets {
+				data, err := transport.ReadPacket()
+				assert.NoError(t, err)
+				if mode == TransportPaddedIntermediate {
+					assert.True(t, len(data)-len(packet) < 16)
+					data = data[:len(packet)]
+				}
+				assert.Equal(t, packet, data)
+			}
+		})
+	}
+}
+
+func TestFullTransportChecksum(t *testing.T) {
+	conn := new(bytes.Buffer)
+	transport, err := NewTransport(TransportFull, conn)
+	assert.NoError(t, err)
+
+	assert.NoError(t, transport.WritePacket([]byte{1, 2, 3, 4}))
+	conn.Bytes()[9] ^= 0xff // портим данные пакета
+
+	_, err = transport.ReadPacket()
+	assert.Error(t, err)
+}
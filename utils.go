@@ -6,7 +6,7 @@ import (
 
 func MessageRequireToAck(msg serialize.TL) bool {
 	switch msg.(type) {
-	case /**serialize.Ping,*/ *serialize.MsgsAck:
+	case /**serialize.Ping,*/ *serialize.MsgsAck, *HttpWaitParams:
 		return false
 	default:
 		return true
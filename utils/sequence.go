@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"sync"
+	"time"
+)
+
+// MessageSequence выдает msg_id и seqno для сообщений одной сессии.
+// https://core.telegram.org/mtproto/description#message-identifier-msg-id
+// https://core.telegram.org/mtproto/description#message-sequence-number-msg-seqno
+//
+// msg_id клиента всегда делится на 4 и строго возрастает в пределах сессии, даже
+// если сообщения создаются одновременно из разных горутин. seqno равен удвоенному
+// количеству уже отправленных content-related сообщений (тех, которые требуют
+// ack), и плюс один, если само сообщение тоже content-related
+type MessageSequence struct {
+	mutex           sync.Mutex
+	lastMsgID       int64
+	contentMessages int32
+	offset          time.Duration
+	timeSynced      bool
+}
+
+func NewMessageSequence() *MessageSequence {
+	return new(MessageSequence)
+}
+
+// SetTimeOffset задает, насколько часы сервера спешат относительно наших
+func (s *MessageSequence) SetTimeOffset(offset time.Duration) {
+	s.mutex.Lock()
+	s.offset = offset
+	s.timeSynced = true
+	s.mutex.Unlock()
+}
+
+// TimeSynced возвращает true, если время сервера уже известно (был вызван
+// SetTimeOffset)
+func (s *MessageSequence) TimeSynced() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.timeSynced
+}
+
+func (s *MessageSequence) TimeOffset() time.Duration {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.offset
+}
+
+// Next выдает msg_id и seqno для следующего сообщения
+func (s *MessageSequence) Next(contentRelated bool) (msgID int64, seqNo int32) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	msgID = s.nextMsgID()
+	seqNo = s.contentMessages * 2
+	if contentRelated {
+		seqNo++
+		s.contentMessages++
+	}
+
+	return msgID, seqNo
+}
+
+// NextMessageID выдает только msg_id, seqno не меняется. нужно для сообщений
+// вне сессии (незашифрованных)
+func (s *MessageSequence) NextMessageID() int64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.nextMsgID()
+}
+
+func (s *MessageSequence) nextMsgID() int64 {
+	id := GenerateMessageId(s.offset)
+	if id <= s.lastMsgID {
+		id = s.lastMsgID + 4
+	}
+	s.lastMsgID = id
+
+	return id
+}
+
+// LastSeqNo возвращает seqno, который получит следующее не content-related сообщение
+func (s *MessageSequence) LastSeqNo() int32 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.contentMessages * 2
+}
+
+// ResetSeqNo начинает считать seqno с нуля, это нужно при создании новой сессии.
+// msg_id продолжают возрастать
+func (s *MessageSequence) ResetSeqNo() {
+	s.mutex.Lock()
+	s.contentMessages = 0
+	s.mutex.Unlock()
+}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageSequenceIDs(t *testing.T) {
+	s := NewMessageSequence()
+
+	const goroutines, perGoroutine = 8, 1000
+	ids := make(chan int64, goroutines*perGoroutine)
+	wg := sync.WaitGroup{}
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			last := int64(0)
+			for j := 0; j < perGoroutine; j++ {
+				id := s.NextMessageID()
+				assert.True(t, id > last, "msg_id must increase")
+				last = id
+				ids <- id
+			}
+		}()
+	}
+	wg.Wait()
+	close(ids)
+
+	all := make([]int64, 0, goroutines*perGoroutine)
+	for id := range ids {
+		// у клиента msg_id всегда делится на 4
+		assert.Equal(t, int64(0), id%4)
+		all = append(all, id)
+	}
+
+	sort.Slice(all, func(i, j int) bool { return all[i] < all[j] })
+	for i := 1; i < len(all); i++ {
+		assert.NotEqual(t, all[i-1], all[i], "msg_id must be unique")
+	}
+}
+
+func TestMessageSequenceTimeOffset(t *testing.T) {
+	s := NewMessageSequence()
+	s.SetTimeOffset(-time.Hour)
+	assert.WithinDuration(t, time.Now().Add(-time.Hour), MessageIdTime(s.NextMessageID()), 2*time.Second)
+
+	// даже если часы ушли назад, msg_id не уменьшаются
+	last := s.NextMessageID()
+	s.SetTimeOffset(-2 * time.Hour)
+	assert.True(t, s.NextMessageID() > last)
+}
+
+func TestMessageSequenceSeqNo(t *testing.T) {
+	s := NewMessageSequence()
+
+	for _, tcase := range []struct {
+		contentRelated bool
+		expected       int32
+	}{
+		{false, 0},
+		{true, 1},
+		{true, 3},
+		{false, 4},
+		{false, 4},
+		{true, 5},
+	} {
+		_, seqNo := s.Next(tcase.contentRelated)
+		assert.Equal(t, tcase.expected, seqNo)
+	}
+	assert.Equal(t, int32(6), s.LastSeqNo())
+
+	s.ResetSeqNo()
+	_, seqNo := s.Next(true)
+	assert.Equal(t, int32(1), seqNo)
+}
@@ -23,16 +23,24 @@ const (
 	magicValueSizeMoreThanSingleByte = 0x7f
 )
 
-// GenerateMessageId отдает по сути unix timestamp но ужасно специфическим образом
+// GenerateMessageId отдает по сути unix timestamp но ужасно специфическим образом.
+// offset это разница между временем сервера и нашим, сервер не принимает msg_id,
+// которые слишком сильно отличаются от его времени
 // TODO: нахуя нужно битовое и на -4??
-func GenerateMessageId() int64 {
+func GenerateMessageId(offset time.Duration) int64 {
 	const billion = 1000 * 1000 * 1000
-	unixnano := time.Now().UnixNano()
+	unixnano := time.Now().Add(offset).UnixNano()
 	seconds := unixnano / billion
 	nanoseconds := unixnano % billion
 	return (seconds << 32) | (nanoseconds & -4)
 }
 
+// MessageIdTime достает из msg_id время, когда сообщение было создано (с
+// точностью до секунды)
+func MessageIdTime(msgID int64) time.Time {
+	return time.Unix(msgID>>32, 0)
+}
+
 func AuthKeyHash(key []byte) []byte {
 	return dry.Sha1Byte(key)[12:20]
 }
@@ -68,7 +76,7 @@ func GetPacketLengthMTProtoCompatible(bytesToGetInfo []byte) (int, error) {
 	}
 
 	// 3 последующих байта сейчас прочтем, последний для доведения до uint32, то есть в буффере значение будет 0x00ffffff, где f любой байт, который показывает число
-	buf := append(bytesToGetInfo, byte(0x00))
+	buf := append(append([]byte{}, bytesToGetInfo[1:]...), byte(0x00))
 
 	value := binary.LittleEndian.Uint32(buf)
 	return int(value) * wordLen, nil
@@ -0,0 +1,258 @@
+package mtproto
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/xelaj/go-dry"
+)
+
+// https://core.telegram.org/mtproto/transports#websocket
+// телеграм принимает MTProto внутри бинарных фреймов websocket. внутри фреймов
+// должен быть обфусцированный (obfuscated2) поток, поэтому websocket соединение
+// просто подменяет собой tcp, а все остальное работает как обычно.
+// https://tools.ietf.org/html/rfc6455
+
+const (
+	wsOpContinuation = 0x0
+	wsOpText         = 0x1
+	wsOpBinary       = 0x2
+	wsOpClose        = 0x8
+	wsOpPing         = 0x9
+	wsOpPong         = 0xa
+
+	wsFinalBit = 0x80
+	wsMaskBit  = 0x80
+
+	wsAcceptGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+)
+
+// wsConn пишет все данные бинарными фреймами, а при чтении склеивает данные
+// из всех фреймов в один поток
+type wsConn struct {
+	net.Conn
+	reader io.Reader
+
+	readBuf    bytes.Buffer
+	writeMutex sync.Mutex
+}
+
+// dialWebSocket подключается к rawurl (ws:// или wss://) через dial и делает
+// upgrade до websocket
+func dialWebSocket(ctx context.Context, dial DialFunc, rawurl string) (*wsConn, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing url")
+	}
+
+	host := u.Host
+	if u.Port() == "" {
+		switch u.Scheme {
+		case "ws":
+			host = net.JoinHostPort(u.Hostname(), "80")
+		case "wss":
+			host = net.JoinHostPort(u.Hostname(), "443")
+		}
+	}
+
+	if u.Scheme != "ws" && u.Scheme != "wss" {
+		return nil, errors.New("unsupported websocket scheme: " + u.Scheme)
+	}
+
+	conn, err := dial(ctx, "tcp", host)
+	if err != nil {
+		return nil, errors.Wrap(err, "dialing")
+	}
+
+	if u.Scheme == "wss" {
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: u.Hostname()})
+		stop := closeOnCancel(ctx, conn)
+		err = tlsConn.Handshake()
+		stop()
+		if err != nil {
+			conn.Close()
+			return nil, errors.Wrap(err, "tls handshake")
+		}
+		conn = tlsConn
+	}
+
+	ws, err := wsHandshake(conn, u)
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "websocket handshake")
+	}
+
+	return ws, nil
+}
+
+func wsHandshake(conn net.Conn, u *url.URL) (*wsConn, error) {
+	key := base64.StdEncoding.EncodeToString(dry.RandomBytes(16))
+
+	req := &http.Request{
+		Method:     http.MethodGet,
+		URL:        u,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Host:       u.Host,
+		Header: http.Header{
+			"Upgrade":                {"websocket"},
+			"Connection":             {"Upgrade"},
+			"Sec-WebSocket-Key":      {key},
+			"Sec-WebSocket-Version":  {"13"},
+			"Sec-WebSocket-Protocol": {"binary"},
+		},
+	}
+	err := req.Write(conn)
+	if err != nil {
+		return nil, errors.Wrap(err, "writing request")
+	}
+
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading response")
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		return nil, errors.New("server responded with " + resp.Status)
+	}
+	if resp.Header.Get("Sec-WebSocket-Accept") != wsAcceptKey(key) {
+		return nil, errors.New("invalid Sec-WebSocket-Accept")
+	}
+
+	return &wsConn{Conn: conn, reader: reader}, nil
+}
+
+func wsAcceptKey(key string) string {
+	h := sha1.Sum([]byte(key + wsAcceptGUID))
+	return base64.StdEncoding.EncodeToString(h[:])
+}
+
+func (c *wsConn) Read(b []byte) (int, error) {
+	for c.readBuf.Len() == 0 {
+		opcode, payload, err := c.readFrame()
+		if err != nil {
+			return 0, err
+		}
+
+		switch opcode {
+		case wsOpBinary, wsOpText, wsOpContinuation:
+			c.readBuf.Write(payload)
+		case wsOpPing:
+			err = c.writeFrame(wsOpPong, payload)
+			if err != nil {
+				return 0, errors.Wrap(err, "writing pong")
+			}
+		case wsOpPong:
+			// ничего не делаем
+		case wsOpClose:
+			c.writeFrame(wsOpClose, nil)
+			return 0, io.EOF
+		default:
+			return 0, errors.New("unknown websocket opcode: " + strconv.Itoa(int(opcode)))
+		}
+	}
+
+	return c.readBuf.Read(b)
+}
+
+func (c *wsConn) Write(b []byte) (int, error) {
+	err := c.writeFrame(wsOpBinary, b)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(b), nil
+}
+
+func (c *wsConn) readFrame() (opcode byte, payload []byte, err error) {
+	header := make([]byte, 2)
+	_, err = io.ReadFull(c.reader, header)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "reading frame header")
+	}
+
+	opcode = header[0] & 0x0f
+	masked := header[1]&wsMaskBit != 0
+	size := uint64(header[1] & 0x7f)
+
+	switch size {
+	case 126:
+		buf := make([]byte, 2)
+		_, err = io.ReadFull(c.reader, buf)
+		size = uint64(binary.BigEndian.Uint16(buf))
+	case 127:
+		buf := make([]byte, 8)
+		_, err = io.ReadFull(c.reader, buf)
+		size = binary.BigEndian.Uint64(buf)
+	}
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "reading frame length")
+	}
+	if size > maxPacketSize {
+		return 0, nil, errors.New("websocket frame is too big: " + strconv.FormatUint(size, 10))
+	}
+
+	var mask []byte
+	if masked {
+		mask = make([]byte, 4)
+		_, err = io.ReadFull(c.reader, mask)
+		if err != nil {
+			return 0, nil, errors.Wrap(err, "reading frame mask")
+		}
+	}
+
+	payload = make([]byte, size)
+	_, err = io.ReadFull(c.reader, payload)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "reading frame payload")
+	}
+	if masked {
+		wsMask(payload, mask)
+	}
+
+	return opcode, payload, nil
+}
+
+// writeFrame отправляет один фрейм. клиент обязан маскировать все фреймы
+func (c *wsConn) writeFrame(opcode byte, payload []byte) error {
+	buf := []byte{wsFinalBit | opcode}
+	switch {
+	case len(payload) < 126:
+		buf = append(buf, wsMaskBit|byte(len(payload)))
+	case len(payload) <= 0xffff:
+		buf = append(buf, wsMaskBit|126, 0, 0)
+		binary.BigEndian.PutUint16(buf[2:], uint16(len(payload)))
+	default:
+		buf = append(buf, wsMaskBit|127, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.BigEndian.PutUint64(buf[2:], uint64(len(payload)))
+	}
+
+	mask := dry.RandomBytes(4)
+	buf = append(buf, mask...)
+	data := append(buf, payload...)
+	wsMask(data[len(buf):], mask)
+
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+	_, err := c.Conn.Write(data)
+	return err
+}
+
+func wsMask(data, mask []byte) {
+	for i := range data {
+		data[i] ^= mask[i%4]
+	}
+}
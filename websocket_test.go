@@ -0,0 +1,69 @@
+package mtproto
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xelaj/go-dry"
+)
+
+func TestWebSocketConn(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+
+	msg := dry.RandomBytes(300)
+	answer := dry.RandomBytes(100)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		reader := bufio.NewReader(server)
+		req, err := http.ReadRequest(reader)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "/apiws", req.URL.Path)
+		assert.Equal(t, "binary", req.Header.Get("Sec-WebSocket-Protocol"))
+
+		server.Write([]byte("HTTP/1.1 101 Switching Protocols\r\n" +
+			"Upgrade: websocket\r\nConnection: Upgrade\r\n" +
+			"Sec-WebSocket-Accept: " + wsAcceptKey(req.Header.Get("Sec-WebSocket-Key")) + "\r\n\r\n"))
+
+		// сервер видит фрейм клиента так же, как клиент видит фреймы сервера
+		ws := &wsConn{Conn: server, reader: reader}
+		opcode, payload, err := ws.readFrame()
+		assert.NoError(t, err)
+		assert.Equal(t, byte(wsOpBinary), opcode)
+		assert.Equal(t, msg, payload)
+
+		// ping должен вернуться pong'ом, а данные разбиты на два фрейма
+		server.Write([]byte{wsFinalBit | wsOpPing, 2, 'h', 'i'})
+		opcode, payload, err = ws.readFrame()
+		assert.NoError(t, err)
+		assert.Equal(t, byte(wsOpPong), opcode)
+		assert.Equal(t, "hi", string(payload))
+
+		server.Write(append([]byte{wsOpBinary, 50}, answer[:50]...))
+		server.Write(append([]byte{wsFinalBit | wsOpContinuation, 50}, answer[50:]...))
+	}()
+
+	conn, err := dialWebSocket(context.Background(), pipeDialer(client), "ws://example.com/apiws")
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = conn.Write(msg)
+	assert.NoError(t, err)
+
+	got := make([]byte, len(answer))
+	_, err = io.ReadFull(conn, got)
+	assert.NoError(t, err)
+	assert.Equal(t, answer, got)
+	<-done
+}